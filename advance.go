@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// advanceSeparators are the characters that extend a number into a list,
+// range, or step, taken back to the field auto-advance just left
+const advanceSeparators = ",-/"
+
+// numberComplete reports whether a field value ends in a number no further
+// digit could extend, like 45 in minute, 3 in weekday, or 07 in hour
+func numberComplete(value string, fieldIndex int) bool {
+	digits := len(value) - len(strings.TrimRight(value, "0123456789"))
+	if digits == 0 {
+		return false
+	}
+
+	number, err := strconv.Atoi(value[len(value)-digits:])
+	if err != nil {
+		return false
+	}
+
+	maxValue := fieldRanges[fieldIndex].max
+
+	return digits >= len(strconv.Itoa(maxValue)) || number*10 > maxValue
+}
+
+// advanceFocus moves the focus to the next field when a digit typed at the
+// end of the focused one completes a valid value, if auto-advance is on
+func (m *model) advanceFocus(msg tea.KeyMsg) tea.Cmd {
+	if !m.autoAdvance || m.rawMode || msg.Type != tea.KeyRunes || msg.Alt || len(msg.Runes) != 1 || m.focusIndex >= numCronFields-1 {
+		return nil
+	}
+
+	if msg.Runes[0] < '0' || msg.Runes[0] > '9' {
+		return nil
+	}
+
+	input := m.inputs[m.focusIndex]
+
+	value := input.Value()
+	if input.Position() != len(value) || !numberComplete(value, m.focusIndex) || !isValidCronPart(value, m.focusIndex) {
+		return nil
+	}
+
+	m.advanced = true
+
+	return m.setFocus(m.focusIndex + 1)
+}
+
+// handleAdvancedKey handles a character typed right after an auto-advance. A
+// separator goes back to the field that was left, so 15 can still grow into
+// 15,45, and any other character replaces the value of the new field.
+func (m *model) handleAdvancedKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.Type != tea.KeyRunes || msg.Alt || len(msg.Runes) != 1 {
+		return nil, false
+	}
+
+	if !strings.ContainsRune(advanceSeparators, msg.Runes[0]) {
+		m.inputs[m.focusIndex].SetValue("")
+
+		return nil, false
+	}
+
+	previous := m.focusIndex - 1
+	cmd := m.setFocus(previous)
+
+	m.inputs[previous].SetValue(m.inputs[previous].Value() + string(msg.Runes))
+	m.inputs[previous].CursorEnd()
+	m.syncRawFromFields()
+
+	return tea.Batch(cmd, m.scheduleCmd()), true
+}
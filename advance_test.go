@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"testing"
+)
+
+// TestNumberComplete verifies that a number is complete once no further
+// digit would keep it in the field's range.
+func TestNumberComplete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		field    int
+		complete bool
+	}{
+		{"4", fieldIndexMinute, false},
+		{"45", fieldIndexMinute, true},
+		{"6", fieldIndexMinute, true},
+		{"00", fieldIndexMinute, true},
+		{"2", fieldIndexHour, false},
+		{"3", fieldIndexHour, true},
+		{"1-5", fieldIndexWeekday, true},
+		{"*/1", fieldIndexMinute, false},
+		{"1", fieldIndexMonth, false},
+		{"*", fieldIndexMinute, false},
+		{"MON", fieldIndexWeekday, false},
+	}
+
+	for _, test := range tests {
+		if complete := numberComplete(test.value, test.field); complete != test.complete {
+			t.Errorf("numberComplete(%q, %d) = %v, expected %v", test.value, test.field, complete, test.complete)
+		}
+	}
+}
+
+// TestAutoAdvance verifies that typing complete numbers moves through the
+// fields, replacing their values, that a separator typed after a move goes
+// back to extend the number, and that the config turns it on.
+func TestAutoAdvance(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.autoAdvance = true
+	m.setExpression("0 9 * * *")
+	m.inputs[fieldIndexMinute].SetValue("")
+
+	for _, key := range []string{"3", "0", "7", "1", ",", "1", "9"} {
+		m = pressKey(t, m, key)
+	}
+
+	if m.buildCronExpression() != "30 7 1,19 * *" || m.focusIndex != fieldIndexMonth {
+		t.Errorf("Expected 30 7 1,19 * * with the month focused, got %q and field %d", m.buildCronExpression(), m.focusIndex)
+	}
+
+	m = pressKey(t, m, "1")
+
+	if m.buildCronExpression() != "30 7 1,19 1 *" || m.focusIndex != fieldIndexMonth {
+		t.Errorf("Expected to stay in the month after 1, which 10 to 12 could follow, got %q and field %d", m.buildCronExpression(), m.focusIndex)
+	}
+
+	m = pressKey(t, m, "2")
+	m = pressKey(t, m, "-")
+
+	if m.buildCronExpression() != "30 7 1,19 12- *" || m.focusIndex != fieldIndexMonth {
+		t.Errorf("Expected the range separator back in the month, got %q and field %d", m.buildCronExpression(), m.focusIndex)
+	}
+
+	m = initialModel()
+	m.setExpression("0 9 * * *")
+	m.inputs[fieldIndexMinute].SetValue("")
+	m = pressKey(t, m, "4")
+	m = pressKey(t, m, "5")
+
+	if m.focusIndex != fieldIndexMinute {
+		t.Errorf("Expected no advance with auto-advance off, got field %d", m.focusIndex)
+	}
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"auto_advance": true}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m = initialModel()
+	m.applyStartup(opts)
+
+	if !m.autoAdvance {
+		t.Error("Expected auto_advance to turn auto-advance on")
+	}
+}
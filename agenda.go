@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	agendaSpan       = 24 * time.Hour // Period the agenda beside the editor covers
+	agendaGap        = 3              // Columns between the editor and the agenda
+	agendaTimeLayout = "Mon 15:04"    // Layout of the hours and single runs in the agenda
+)
+
+// agendaEntry is one job's runs within an hour of the agenda
+type agendaEntry struct {
+	job   int       // Index into the agenda's jobs
+	first time.Time // First run in the hour
+	count int       // Runs in the hour
+}
+
+// agendaHour is an hour of the agenda with the jobs that run in it
+type agendaHour struct {
+	start   time.Time     // Start of the hour
+	entries []agendaEntry // Jobs in the order they first run in the hour
+}
+
+// liveJobs returns the jobs not in the trash, with the selected job's
+// schedule taken from the open editor while it is valid, and reports whether
+// it was
+func (b *jobBrowser) liveJobs() ([]clashJob, bool) {
+	valid := true
+	jobs := make([]clashJob, 0, len(b.jobs))
+
+	for index, job := range b.jobs {
+		if slices.Contains(b.trash, index) {
+			continue
+		}
+
+		expr := job.expr
+		if index == b.cursor && b.editor != nil {
+			live := b.editor.buildCronExpression()
+			if err := validateStandardFields(strings.Fields(live)); err == nil {
+				expr = live
+			} else {
+				valid = false
+			}
+		}
+
+		jobs = append(jobs, clashJob{name: job.name, expr: expr, source: index})
+	}
+
+	return jobs, valid
+}
+
+// agendaHours groups the runs of jobs between now and until by hour, leaving
+// out hours without runs and jobs that do not parse
+func agendaHours(jobs []clashJob, now, until time.Time) []agendaHour {
+	positions := make(map[time.Time]int)
+
+	var hours []agendaHour
+
+	for index, job := range jobs {
+		schedule, err := standardParser.Parse(job.expr)
+		if err != nil {
+			continue
+		}
+
+		for next := range occurrences(context.Background(), schedule, now) {
+			if !next.Before(until) {
+				break
+			}
+
+			start := time.Date(next.Year(), next.Month(), next.Day(), next.Hour(), 0, 0, 0, next.Location())
+
+			position, ok := positions[start]
+			if !ok {
+				position = len(hours)
+				positions[start] = position
+				hours = append(hours, agendaHour{start: start})
+			}
+
+			entries := hours[position].entries
+			if last := len(entries) - 1; last >= 0 && entries[last].job == index {
+				entries[last].count++
+			} else {
+				hours[position].entries = append(entries, agendaEntry{job: index, first: next, count: 1})
+			}
+		}
+	}
+
+	sort.Slice(hours, func(i, j int) bool { return hours[i].start.Before(hours[j].start) })
+
+	for _, hour := range hours {
+		sort.SliceStable(hour.entries, func(i, j int) bool { return hour.entries[i].first.Before(hour.entries[j].first) })
+	}
+
+	return hours
+}
+
+// renderAgenda renders the runs of every job over the next day by hour and
+// the clashes among them, with the selected job's schedule as it is being
+// edited. Times are in the selected job's time zone.
+func (b *jobBrowser) renderAgenda(now time.Time, width int) string {
+	jobs, valid := b.liveJobs()
+	now = now.In(b.jobs[b.cursor].location)
+	until := now.Add(agendaSpan)
+
+	lines := []string{titleStyle.Render(fmt.Sprintf("agenda: next %s in %s", formatGap(agendaSpan), now.Location()))}
+
+	hours := agendaHours(jobs, now, until)
+	if len(hours) == 0 {
+		lines = append(lines, labelStyle.Render("no runs"))
+	}
+
+	for _, hour := range hours {
+		selected := false
+		items := make([]string, 0, len(hour.entries))
+
+		for _, entry := range hour.entries {
+			job := jobs[entry.job]
+			selected = selected || job.source == b.cursor
+
+			if entry.count == 1 {
+				items = append(items, entry.first.Format("15:04")+" "+job.name)
+			} else {
+				items = append(items, fmt.Sprintf("%s ×%d", job.name, entry.count))
+			}
+		}
+
+		row := hour.start.Format(agendaTimeLayout) + "  " + strings.Join(items, " · ")
+		if notes := b.calendars.notes(hour.start); len(notes) > 0 {
+			row += "  (" + strings.Join(notes, ", ") + ")"
+		}
+		if selected {
+			lines = append(lines, focusedLabelStyle.Render("> "+row))
+		} else {
+			lines = append(lines, labelStyle.Render("  "+row))
+		}
+	}
+
+	if !valid {
+		lines = append(lines, "", conflictStyle.Render("invalid schedule: the agenda shows the saved one"))
+	}
+
+	clashes, err := findClashes(jobs, now, until, defaultClashWindow)
+
+	switch {
+	case err != nil:
+		lines = append(lines, "", conflictStyle.Render(err.Error()))
+	case len(clashes) == 0:
+		lines = append(lines, "", infoStyle.Render("no clashes"))
+	default:
+		lines = append(lines, "")
+
+		for _, found := range clashes {
+			lines = append(lines, conflictStyle.Render("! "+describeClash(found, jobs, defaultClashWindow)))
+		}
+	}
+
+	agenda := strings.Join(lines, "\n")
+	if width > 0 {
+		agenda = lipgloss.NewStyle().Width(width).Render(agenda)
+	}
+
+	return agenda
+}
+
+// splitWidths divides the terminal between the editor and the agenda, 0
+// for both when the width is not known
+func (b *jobBrowser) splitWidths() (int, int) {
+	if b.width <= 0 {
+		return 0, 0
+	}
+
+	editor := b.width / 2
+
+	return editor, max(0, b.width-editor-agendaGap)
+}
+
+// renderSplit renders the editor with the agenda beside it
+func (b *jobBrowser) renderSplit() string {
+	editorWidth, agendaWidth := b.splitWidths()
+
+	left := b.editor.View() + "\n" + labelStyle.Render("esc: back to "+b.title)
+	if editorWidth > 0 {
+		left = lipgloss.NewStyle().Width(editorWidth).Render(left)
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, strings.Repeat(" ", agendaGap),
+		b.renderAgenda(time.Now(), agendaWidth))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestAgendaHours verifies that runs are grouped by hour in the order the
+// jobs first run, with frequent jobs counted instead of listed.
+func TestAgendaHours(t *testing.T) {
+	t.Parallel()
+
+	jobs := []clashJob{
+		{name: "report.sh", expr: "30 2 * * *"},
+		{name: "poll.sh", expr: "*/20 2 * * *"},
+		{name: "backup.sh", expr: "0 4 * * *"},
+		{name: "broken", expr: "61 * * * *"},
+	}
+
+	now := time.Date(2026, time.October, 15, 0, 0, 30, 0, time.UTC)
+
+	hours := agendaHours(jobs, now, now.Add(agendaSpan))
+	if len(hours) != 2 {
+		t.Fatalf("Expected the 02:00 and 04:00 hours, got %+v", hours)
+	}
+
+	two := hours[0]
+	if !two.start.Equal(time.Date(2026, time.October, 15, 2, 0, 0, 0, time.UTC)) || len(two.entries) != 2 ||
+		two.entries[0].job != 1 || two.entries[0].count != 3 || two.entries[1].job != 0 || two.entries[1].count != 1 {
+		t.Errorf("Unexpected 02:00 hour %+v", two)
+	}
+
+	if four := hours[1]; len(four.entries) != 1 || four.entries[0].job != 2 {
+		t.Errorf("Unexpected 04:00 hour %+v", four)
+	}
+}
+
+// TestBrowserSplitAgenda verifies that s opens the editor beside the agenda,
+// that the agenda and clashes follow the schedule as it is edited, and that
+// an invalid schedule leaves the saved one in the agenda.
+func TestBrowserSplitAgenda(t *testing.T) {
+	t.Parallel()
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n5 2 * * * report.sh\n0 4 * * * clean.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for index := range b.jobs {
+		b.jobs[index].location = time.UTC
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+
+	if b.editor == nil || !b.split || !strings.Contains(b.View(), "agenda: next 24h in UTC") {
+		t.Fatalf("Expected the editor beside the agenda, got:\n%s", b.View())
+	}
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	agenda := b.renderAgenda(now, 0)
+	for _, want := range []string{
+		"> Fri 02:00  02:00 backup.sh · 02:05 report.sh",
+		"  Fri 04:00  04:00 clean.sh",
+		"! 02:00: backup.sh, report.sh run within 5m, once",
+	} {
+		if !strings.Contains(agenda, want) {
+			t.Errorf("Agenda missing %q:\n%s", want, agenda)
+		}
+	}
+
+	b.editor.setExpression("30 3 * * *")
+
+	agenda = b.renderAgenda(now, 0)
+	for _, want := range []string{"  Fri 02:00  02:00 backup.sh\n", "> Fri 03:00  03:30 report.sh", "no clashes"} {
+		if !strings.Contains(agenda, want) {
+			t.Errorf("Agenda missing %q after the edit:\n%s", want, agenda)
+		}
+	}
+
+	b.calendars = calendarSet{freezes: []freezeCalendar{freezeWindows{{
+		start: time.Date(2026, time.October, 16, 3, 0, 0, 0, time.UTC), end: now.Add(agendaSpan), reason: "release freeze",
+	}}}}
+
+	if agenda = b.renderAgenda(now, 0); !strings.Contains(agenda, "  Fri 02:00  02:00 backup.sh\n") ||
+		!strings.Contains(agenda, "> Fri 03:00  03:30 report.sh  (during release freeze)") {
+		t.Errorf("Expected the hours in the freeze marked, got:\n%s", agenda)
+	}
+
+	b.calendars = calendarSet{}
+
+	b.editor.setExpression("61 3 * * *")
+
+	if agenda = b.renderAgenda(now, 0); !strings.Contains(agenda, "invalid schedule") ||
+		!strings.Contains(agenda, "02:05 report.sh") {
+		t.Errorf("Expected the saved schedule in the agenda, got:\n%s", agenda)
+	}
+
+	b.editor.setExpression("30 3 * * *")
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if b.editor != nil || !strings.Contains(doc.text(), "30 3 * * * report.sh") {
+		t.Errorf("Expected the edit applied on esc, got %q", doc.text())
+	}
+}
@@ -0,0 +1,447 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	backupDirName     = "backups"                 // Directory under the data directory holding backups
+	backupTimeLayout  = "20060102-150405.000"     // Layout of the time a backup file is named after, in UTC
+	backupExtension   = ".crontab"                // Extension of backup files
+	backupListLayout  = "Mon 2006-01-02 15:04:05" // Layout of the backup times in the picker
+	maxRestorePreview = 20                        // Most changed lines previewed for the selected backup
+	crontabTempPrefix = "crontab."                // Start of the temporary copies crontab -e edits
+)
+
+// crontabBackup is a copy of a crontab taken before it was written
+type crontabBackup struct {
+	path string    // Backup file
+	at   time.Time // When it was taken
+}
+
+// backupSource is a crontab that is backed up before it is replaced
+type backupSource struct {
+	key   string                  // Names the crontab in messages and its backup directory
+	load  func() (string, error)  // Reads the crontab as it is now
+	write func(text string) error // Replaces the crontab
+}
+
+// targetSource is the crontab edit loads and installs through crontab
+func targetSource(target crontabTarget, run commandRunner) backupSource {
+	return backupSource{
+		key:   target.String(),
+		load:  func() (string, error) { return loadCrontab(target, run) },
+		write: func(text string) error { return installCrontab(target, run, text) },
+	}
+}
+
+// fileSource is a crontab file. A missing file reads as an empty crontab.
+func fileSource(path string) backupSource {
+	return backupSource{
+		key: fileBackupKey(path),
+		load: func() (string, error) {
+			text, err := readCrontab(path)
+			if errors.Is(err, fs.ErrNotExist) {
+				return "", nil
+			}
+
+			return text, err
+		},
+		write: func(text string) error {
+			mode := os.FileMode(0o600)
+			if info, err := os.Stat(path); err == nil {
+				mode = info.Mode().Perm()
+			}
+
+			if err := os.WriteFile(path, []byte(text), mode); err != nil {
+				return fmt.Errorf("failed to write %s: %w", path, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// fileBackupKey names the backups of a crontab file by its absolute path.
+// The temporary copy crontab -e hands its editor is your crontab, so its
+// backups are kept with those of edit and can be restored from there.
+func fileBackupKey(path string) string {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		absolute = path
+	}
+
+	if isCrontabTempCopy(absolute, []string{os.TempDir(), "/tmp", "/var/tmp"}) {
+		return crontabTarget{}.String()
+	}
+
+	return "file " + absolute
+}
+
+// isCrontabTempCopy reports whether a path is a temporary copy crontab -e
+// made in one of the temporary directories: a crontab.XXXXXX file, or a
+// file in a crontab.XXXXXX directory. Not every crontab follows $TMPDIR;
+// the one on macOS always uses /tmp.
+func isCrontabTempCopy(path string, tempDirs []string) bool {
+	copyPath := filepath.Clean(path)
+	if !strings.HasPrefix(filepath.Base(copyPath), crontabTempPrefix) {
+		copyPath = filepath.Dir(copyPath)
+	}
+
+	if !strings.HasPrefix(filepath.Base(copyPath), crontabTempPrefix) {
+		return false
+	}
+
+	for _, dir := range tempDirs {
+		if filepath.Dir(copyPath) == filepath.Clean(dir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataDir returns the directory crontab-guru keeps data in, under
+// $XDG_DATA_HOME or ~/.local/share
+func dataDir() (string, error) {
+	data := os.Getenv("XDG_DATA_HOME")
+	if !filepath.IsAbs(data) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find the data directory: %w", err)
+		}
+
+		data = filepath.Join(home, ".local", "share")
+	}
+
+	return filepath.Join(data, configDirName), nil
+}
+
+// backupRoot returns the directory backups are kept in
+func backupRoot() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, backupDirName), nil
+}
+
+// saveBackup keeps a copy of a crontab's text under root, named after now
+func saveBackup(root, key, text string, now time.Time) error {
+	dir := filepath.Join(root, url.PathEscape(key))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to back up %s: %w", key, err)
+	}
+
+	path := filepath.Join(dir, now.UTC().Format(backupTimeLayout)+backupExtension)
+	if err := os.WriteFile(path, []byte(text), 0o600); err != nil {
+		return fmt.Errorf("failed to back up %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// listBackups returns the backups of a crontab, newest first. A crontab
+// never backed up has none.
+func listBackups(root, key string) ([]crontabBackup, error) {
+	dir := filepath.Join(root, url.PathEscape(key))
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the backups of %s: %w", key, err)
+	}
+
+	var backups []crontabBackup
+
+	for _, entry := range entries {
+		stamp, ok := strings.CutSuffix(entry.Name(), backupExtension)
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		at, err := time.Parse(backupTimeLayout, stamp)
+		if err != nil {
+			continue
+		}
+
+		backups = append(backups, crontabBackup{path: filepath.Join(dir, entry.Name()), at: at})
+	}
+
+	slices.SortFunc(backups, func(a, b crontabBackup) int { return b.at.Compare(a.at) })
+
+	return backups, nil
+}
+
+// diffLines lists the lines that turn from into to, "-" for removed and "+"
+// for added, leaving out the lines both share
+func diffLines(from, to string) []string {
+	old, updated := strings.Split(strings.TrimSuffix(from, "\n"), "\n"), strings.Split(strings.TrimSuffix(to, "\n"), "\n")
+	if from == "" {
+		old = nil
+	}
+
+	if to == "" {
+		updated = nil
+	}
+
+	// Longest common subsequence of the lines, filled from the end
+	common := make([][]int, len(old)+1)
+	for index := range common {
+		common[index] = make([]int, len(updated)+1)
+	}
+
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(updated) - 1; j >= 0; j-- {
+			if old[i] == updated[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+
+	i, j := 0, 0
+	for i < len(old) || j < len(updated) {
+		switch {
+		case i < len(old) && j < len(updated) && old[i] == updated[j]:
+			i++
+			j++
+		case j < len(updated) && (i == len(old) || common[i][j+1] > common[i+1][j]):
+			lines = append(lines, "+ "+updated[j])
+			j++
+		default:
+			lines = append(lines, "- "+old[i])
+			i++
+		}
+	}
+
+	return lines
+}
+
+// restoreBackup replaces a crontab with a backup's text, refusing when the
+// crontab changed since it was read as original. The crontab is backed up
+// first, so the restore itself can be undone, and the restored text is
+// committed to history.
+func restoreBackup(root string, history historyRepo, source backupSource, original, text string, now time.Time) error {
+	current, err := source.load()
+	if err != nil {
+		return err
+	}
+
+	if current != original {
+		return fmt.Errorf("%w: %s; nothing was written", ErrCrontabChanged, source.key)
+	}
+
+	if err := saveBackup(root, source.key, current, now); err != nil {
+		return fmt.Errorf("%w; nothing was written", err)
+	}
+
+	if err := source.write(text); err != nil {
+		return err
+	}
+
+	if err := history.record(source.key, text); err != nil {
+		return fmt.Errorf("%s was restored, but not committed to history: %w", source.key, err)
+	}
+
+	return nil
+}
+
+// restorePicker lists the backups of a crontab with a preview of what
+// restoring the selected one would change
+type restorePicker struct {
+	title   string          // Names the crontab
+	backups []crontabBackup // Backups, newest first
+	texts   []string        // Text of each backup
+	current string          // Text of the crontab now
+	cursor  int             // Index of the selected backup
+	restore bool            // Whether enter was pressed to restore the selected backup
+}
+
+// Init starts the picker with nothing to do
+func (p *restorePicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update moves the selection, restores on enter, and quits on q or esc
+func (p *restorePicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		p.cursor = max(0, p.cursor-1)
+	case "down", "j":
+		p.cursor = min(len(p.backups)-1, p.cursor+1)
+	case "enter":
+		p.restore = true
+
+		return p, tea.Quit
+	case "q", "esc", "ctrl+c":
+		return p, tea.Quit
+	}
+
+	return p, nil
+}
+
+// View lists the backups and the changes restoring the selected one makes
+func (p *restorePicker) View() string {
+	var builder strings.Builder
+
+	builder.WriteString(titleStyle.Render("crontab guru: restore "+p.title) + "\n")
+
+	for index, backup := range p.backups {
+		added, removed := 0, 0
+
+		for _, line := range diffLines(p.current, p.texts[index]) {
+			if strings.HasPrefix(line, "+") {
+				added++
+			} else {
+				removed++
+			}
+		}
+
+		summary := fmt.Sprintf("+%d -%d", added, removed)
+		if added+removed == 0 {
+			summary = "same as now"
+		}
+
+		row := fmt.Sprintf("%s  %s", backup.at.Local().Format(backupListLayout), summary)
+		if index == p.cursor {
+			builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+		} else {
+			builder.WriteString(labelStyle.Render("  "+row) + "\n")
+		}
+	}
+
+	changes := diffLines(p.current, p.texts[p.cursor])
+	if len(changes) > 0 {
+		builder.WriteString("\n" + labelStyle.Render("restoring it changes:") + "\n")
+	}
+
+	for index, line := range changes {
+		if index == maxRestorePreview {
+			builder.WriteString(labelStyle.Render(fmt.Sprintf("… %d more", len(changes)-maxRestorePreview)) + "\n")
+
+			break
+		}
+
+		if strings.HasPrefix(line, "+") {
+			builder.WriteString(infoStyle.Render(line) + "\n")
+		} else {
+			builder.WriteString(conflictStyle.Render(line) + "\n")
+		}
+	}
+
+	builder.WriteString("\n" + helpStyle.Render("enter: restore · q: quit"))
+
+	return builder.String()
+}
+
+// runRestore lists the backups of a crontab, or of a crontab file, and
+// restores the one picked after previewing what it changes
+func runRestore(args []string, stdout, stderr io.Writer) error {
+	var target crontabTarget
+
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&target.user, "user", "", "restore this user's crontab through sudo")
+	flags.StringVar(&target.host, "host", "", "restore the crontab on this host through ssh, e.g. deploy@server")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 1 || (len(positional) == 1 && target != (crontabTarget{})) {
+		return fmt.Errorf("%w: crontab-guru restore [--user USER] [--host USER@HOST] [FILE]", ErrUsage)
+	}
+
+	if err := target.validate(); err != nil {
+		return err
+	}
+
+	source := targetSource(target, execRunner)
+	if len(positional) == 1 {
+		source = fileSource(positional[0])
+	}
+
+	root, history, err := openCrontabKeeping()
+	if err != nil {
+		return err
+	}
+
+	backups, err := listBackups(root, source.key)
+	if err != nil {
+		return err
+	}
+
+	if len(backups) == 0 {
+		fmt.Fprintf(stdout, "no backups of %s\n", source.key)
+
+		return nil
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("%w: crontab-guru restore needs a terminal", ErrUsage)
+	}
+
+	current, err := source.load()
+	if err != nil {
+		return err
+	}
+
+	picker := &restorePicker{title: source.key, backups: backups, current: current}
+
+	for _, backup := range backups {
+		text, err := readCrontab(backup.path)
+		if err != nil {
+			return err
+		}
+
+		picker.texts = append(picker.texts, text)
+	}
+
+	if _, err := tea.NewProgram(picker).Run(); err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	if !picker.restore {
+		return nil
+	}
+
+	if err := restoreBackup(root, history, source, current, picker.texts[picker.cursor], time.Now()); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "restored %s from the backup of %s; the replaced crontab was backed up too\n", source.key,
+		picker.backups[picker.cursor].at.Local().Format(backupListLayout))
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestSaveBackup verifies that backups are kept per crontab and listed
+// newest first, and that a crontab never backed up has none.
+func TestSaveBackup(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	first := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	for index, text := range []string{"0 1 * * * a.sh\n", "0 2 * * * b.sh\n"} {
+		if err := saveBackup(root, "your crontab", text, first.Add(time.Duration(index)*time.Minute)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if err := saveBackup(root, "www-data on deploy@server", "", first); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	backups, err := listBackups(root, "your crontab")
+	if err != nil || len(backups) != 2 {
+		t.Fatalf("Expected two backups, got %v, %v", backups, err)
+	}
+
+	if !backups[0].at.Equal(first.Add(time.Minute)) || filepath.Base(backups[0].path) != "20261015-120100.000.crontab" {
+		t.Errorf("Expected the newest backup first, got %+v", backups[0])
+	}
+
+	if data, _ := os.ReadFile(backups[1].path); string(data) != "0 1 * * * a.sh\n" {
+		t.Errorf("Unexpected backup %q", data)
+	}
+
+	if backups, err = listBackups(root, "root"); err != nil || len(backups) != 0 {
+		t.Errorf("Expected no backups, got %v, %v", backups, err)
+	}
+}
+
+// TestFileBackupKey verifies that files are named by their absolute path,
+// except the temporary copy crontab -e hands its editor, which is your
+// crontab.
+func TestFileBackupKey(t *testing.T) {
+	t.Parallel()
+
+	if key := fileBackupKey("/etc/cron.d/backup"); key != "file /etc/cron.d/backup" {
+		t.Errorf("Unexpected key %q", key)
+	}
+
+	if key := fileBackupKey(filepath.Join(os.TempDir(), "crontab.AbC123")); key != "your crontab" {
+		t.Errorf("Expected your crontab, got %q", key)
+	}
+
+	if key := fileBackupKey(filepath.Join(os.TempDir(), "crontab.1", "crontab")); key != "your crontab" {
+		t.Errorf("Expected your crontab, got %q", key)
+	}
+
+	if key := fileBackupKey("/tmp/crontab.AbC123"); key != "your crontab" {
+		t.Errorf("Expected a copy in /tmp to be your crontab whatever $TMPDIR is, got %q", key)
+	}
+}
+
+// TestIsCrontabTempCopy verifies that only crontab.XXXXXX files and
+// directories directly in a temporary directory are taken for the copies
+// crontab -e edits.
+func TestIsCrontabTempCopy(t *testing.T) {
+	t.Parallel()
+
+	tempDirs := []string{"/var/folders/xy/T/", "/tmp"}
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/var/folders/xy/T/crontab.AbC123", true},
+		{"/tmp/crontab.AbC123", true},
+		{"/tmp/crontab.AbC123/crontab", true},
+		{"/tmp/backups/crontab.old", false},
+		{"/home/me/crontab.bak", false},
+		{"/tmp/crontab", false},
+	}
+
+	for _, tt := range tests {
+		if got := isCrontabTempCopy(tt.path, tempDirs); got != tt.expected {
+			t.Errorf("isCrontabTempCopy(%q) = %v, expected %v", tt.path, got, tt.expected)
+		}
+	}
+}
+
+// TestDiffLines verifies that only the lines that differ are listed, in
+// order, marked as removed or added.
+func TestDiffLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from, to string
+		expected []string
+	}{
+		{"a\nb\nc\n", "a\nb\nc\n", nil},
+		{"a\nb\nc\n", "a\nx\nc\n", []string{"- b", "+ x"}},
+		{"", "a\nb\n", []string{"+ a", "+ b"}},
+		{"a\nb\n", "", []string{"- a", "- b"}},
+		{"a\nb\nc\n", "b\nc\nd\n", []string{"- a", "+ d"}},
+	}
+
+	for _, test := range tests {
+		if lines := diffLines(test.from, test.to); strings.Join(lines, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("diffLines(%q, %q) = %q, expected %q", test.from, test.to, lines, test.expected)
+		}
+	}
+}
+
+// TestRestoreBackup verifies that restoring backs up the crontab before
+// replacing it, and that a crontab changed in the meantime is left alone.
+func TestRestoreBackup(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	path := filepath.Join(t.TempDir(), "jobs")
+	source := fileSource(path)
+
+	if err := restoreBackup(root, historyRepo{}, source, "", "0 2 * * * b.sh\n", time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "0 2 * * * b.sh\n" {
+		t.Errorf("Unexpected file %q", data)
+	}
+
+	if backups, _ := listBackups(root, source.key); len(backups) != 1 {
+		t.Errorf("Expected the replaced crontab backed up, got %v", backups)
+	}
+
+	err := restoreBackup(root, historyRepo{}, source, "0 1 * * * a.sh\n", "", time.Now())
+	if !errors.Is(err, ErrCrontabChanged) {
+		t.Errorf("Expected ErrCrontabChanged, got %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "0 2 * * * b.sh\n" {
+		t.Errorf("Expected the file left alone, got %q", data)
+	}
+}
+
+// TestRestorePicker verifies that the picker summarizes each backup against
+// the crontab, previews the selected one, and restores it on enter.
+func TestRestorePicker(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.Local)
+	picker := &restorePicker{
+		title:   "your crontab",
+		backups: []crontabBackup{{at: at.Add(time.Hour)}, {at: at}},
+		texts:   []string{"0 2 * * * b.sh\n", "0 1 * * * a.sh\n0 2 * * * b.sh\n"},
+		current: "0 2 * * * b.sh\n",
+	}
+
+	view := picker.View()
+	if !strings.Contains(view, "> Thu 2026-10-15 13:00:00  same as now") || !strings.Contains(view, "12:00:00  +1 -0") {
+		t.Errorf("Unexpected view:\n%s", view)
+	}
+
+	picker.Update(tea.KeyMsg{Type: tea.KeyDown})
+	picker.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	if view = picker.View(); picker.cursor != 1 || !strings.Contains(view, "+ 0 1 * * * a.sh") {
+		t.Errorf("Expected the older backup previewed, got:\n%s", view)
+	}
+
+	if _, cmd := picker.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || !picker.restore {
+		t.Error("Expected enter to restore and quit")
+	}
+}
+
+// TestRunRestoreUsage verifies that a file cannot be combined with a user or
+// host, and that only one file is restored at a time.
+func TestRunRestoreUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{{"--user", "www-data", "crontab.txt"}, {"a.txt", "b.txt"}} {
+		if err := runRestore(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runRestore(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bellStateVariable tells the sound command whether the expression became
+// "valid" or "invalid", so it can play a different sound for each
+const bellStateVariable = "CRONTAB_GURU_STATE"
+
+// alertPlayer signals that the expression became valid or invalid, returning
+// once the signal is on its way
+type alertPlayer func(command string, valid bool) error
+
+// terminalOutput is the output of the editor's program. Writes are taken one
+// at a time, so the bell goes out between frames rather than in the middle
+// of one the renderer is writing.
+type terminalOutput struct {
+	*os.File
+
+	mu sync.Mutex
+}
+
+// Write writes to the terminal once no other write is in progress
+func (out *terminalOutput) Write(data []byte) (int, error) {
+	out.mu.Lock()
+	defer out.mu.Unlock()
+
+	return out.File.Write(data) //nolint:wrapcheck // The renderer reports its own write errors
+}
+
+// shellCommand returns the command line running a sound command through the
+// shell of an operating system, so it may quote paths with spaces
+func shellCommand(goos, command string) []string {
+	if goos == "windows" {
+		return []string{"cmd", "/C", command}
+	}
+
+	return []string{"sh", "-c", command}
+}
+
+// newAlertPlayer returns a player that rings the bell on the program's
+// output, or runs the sound command instead when one is configured, without
+// waiting for it to finish
+func newAlertPlayer(output io.Writer) alertPlayer {
+	return func(command string, valid bool) error {
+		if command == "" {
+			if _, err := io.WriteString(output, "\a"); err != nil {
+				return fmt.Errorf("failed to ring the bell: %w", err)
+			}
+
+			return nil
+		}
+
+		state := "invalid"
+		if valid {
+			state = "valid"
+		}
+
+		argv := shellCommand(runtime.GOOS, command)
+
+		cmd := exec.Command(argv[0], argv[1:]...) //nolint:gosec // The command comes from the user's own config
+		cmd.Env = append(os.Environ(), bellStateVariable+"="+state)
+
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("failed to run %s: %w", command, err)
+		}
+
+		go cmd.Wait() //nolint:errcheck // A sound that fails to play is not worth interrupting the editor for
+
+		return nil
+	}
+}
+
+// validityAlert signals when the expression turns from valid to invalid or
+// back, if the bell or a sound command is enabled
+func (m *model) validityAlert() tea.Cmd {
+	invalid := m.err != nil
+	if invalid == m.wasInvalid {
+		return nil
+	}
+
+	m.wasInvalid = invalid
+
+	if !m.bell && m.soundCommand == "" || m.alert == nil {
+		return nil
+	}
+
+	if err := m.alert(m.soundCommand, !invalid); err != nil {
+		m.copyMessage = "No sound: " + err.Error()
+
+		return tea.Tick(time.Second, func(time.Time) tea.Msg {
+			return clearCopyMessage{}
+		})
+	}
+
+	return nil
+}
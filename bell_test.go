@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestValidityAlert verifies that the bell rings once each time the
+// expression turns invalid or valid again, and not at all when it is off.
+func TestValidityAlert(t *testing.T) {
+	t.Parallel()
+
+	var rung []bool
+
+	m := initialModel()
+	m.bell = true
+	m.alert = func(command string, valid bool) error {
+		if command != "" {
+			t.Errorf("Expected the bell, got command %q", command)
+		}
+
+		rung = append(rung, valid)
+
+		return nil
+	}
+
+	m.setExpression("0 9 * * *")
+	m.updateDescription()
+	m.setFocus(fieldIndexMinute)
+
+	m = pressKey(t, m, "x")
+	m = pressKey(t, m, "y")
+
+	if len(rung) != 1 || rung[0] {
+		t.Fatalf("Expected one ring for turning invalid, got %v", rung)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+	if len(rung) != 1 {
+		t.Fatalf("Expected no ring before the schedule is computed, got %v", rung)
+	}
+
+	result := computeSchedule(m.lastCronExpr, time.Now())
+	result.cronExpr = m.lastCronExpr
+	m.Update(result)
+
+	if len(rung) != 2 || !rung[1] {
+		t.Errorf("Expected a second ring for turning valid, got %v", rung)
+	}
+
+	m.bell = false
+	m = pressKey(t, m, "x")
+
+	if len(rung) != 2 {
+		t.Errorf("Expected no ring with the bell off, got %v", rung)
+	}
+}
+
+// TestValidityAlertFailure verifies that a sound command that cannot run is
+// reported in the footer.
+func TestValidityAlertFailure(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.soundCommand = "no-such-player"
+	m.alert = func(string, bool) error { return errors.New("failed to run no-such-player") }
+
+	m.setFocus(fieldIndexMinute)
+	m = pressKey(t, m, "x")
+
+	if m.copyMessage != "No sound: failed to run no-such-player" {
+		t.Errorf("Expected the failure in the footer, got %q", m.copyMessage)
+	}
+}
+
+// TestBellOptions verifies that the bell and sound command come from the
+// config and that --bell turns the bell on.
+func TestBellOptions(t *testing.T) {
+	t.Parallel()
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"sound_command": "paplay done.oga"}`), "--bell"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+
+	if !m.bell || m.soundCommand != "paplay done.oga" {
+		t.Errorf("Expected the bell and sound command set, got %v and %q", m.bell, m.soundCommand)
+	}
+
+	if m.wasInvalid {
+		t.Error("Expected the starting expression to count as valid")
+	}
+}
+
+// TestAlertPlayer verifies that the bell goes to the output it was given, and
+// that the sound command runs through the shell, so quoted paths with spaces
+// work, with the new state in its environment.
+func TestAlertPlayer(t *testing.T) {
+	t.Parallel()
+
+	if !slices.Equal(shellCommand("windows", "play"), []string{"cmd", "/C", "play"}) {
+		t.Errorf("Unexpected Windows command %q", shellCommand("windows", "play"))
+	}
+
+	var output strings.Builder
+
+	play := newAlertPlayer(&output)
+	if err := play("", true); err != nil || output.String() != "\a" {
+		t.Errorf("Expected the bell on the output, got %q, %v", output.String(), err)
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "My Sound.txt")
+	if err := play(`printf %s "$CRONTAB_GURU_STATE" > "`+path+`"`, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if data, err := os.ReadFile(path); err == nil && string(data) == "invalid" {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Error("Expected the sound command to write the state to a path with a space")
+}
@@ -0,0 +1,529 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// browserJob is one schedule listed by the job browser
+type browserJob struct {
+	name     string         // What runs, e.g. a command or a CronJob's namespace/name
+	expr     string         // Standard five-field expression
+	command  string         // Command shown under the expression in the editor, "" for none
+	location *time.Location // Time zone the schedule runs in
+}
+
+// jobBrowser lists schedules read from somewhere, such as a crontab or
+// Kubernetes CronJobs, and opens the editor on one. Edited schedules are
+// handed to apply, which writes them into wherever they were read from.
+// Deleted jobs go to a trash they can be restored from until the browser
+// closes, and are handed to remove. The editor can also open beside an
+// agenda of every job that follows the schedule as it is edited. Crontabs
+// can have jobs added, typed as whole lines in the editor's raw input.
+type jobBrowser struct {
+	title        string                                // Heading naming where the jobs were read from
+	action       string                                // What w does on exit, e.g. "write back"
+	jobs         []browserJob                          // Jobs in the order they were read
+	descriptions []string                              // Description of each job's schedule
+	apply        func(index int, expr, command string) // Writes an edited schedule into its source
+	remove       func(index int, deleted bool)         // Drops or restores a job in its source, nil when jobs cannot be deleted
+	cursor       int                                   // Index of the selected job
+	editor       *model                                // Editor open on the selected job, nil while browsing
+	split        bool                                  // Whether the editor is shown beside the agenda of every job
+	edited       map[int]bool                          // Jobs whose schedule was changed
+	trash        []int                                 // Deleted jobs, most recently deleted last
+	showTrash    bool                                  // Whether the trash is listed instead of the jobs
+	trashCursor  int                                   // Index into trash of the selected deleted job
+	save         bool                                  // Whether w was pressed to keep the changes
+	confirmQuit  bool                                  // Whether quitting was asked for once with unsaved changes
+	status       string                                // Result of the last action
+	doc          *crontabDocument                      // Crontab the jobs were read from, nil for other sources
+	checkPaths   bool                                  // Whether commands are looked up in the crontab's PATH on this machine
+	envForm      *envForm                              // Variables form, nil while closed
+	envEdited    bool                                  // Whether MAILTO, SHELL, or PATH was changed
+	commentFixed bool                                  // Whether a description comment was regenerated
+	adding       bool                                  // Whether the open editor is on a new job rather than a listed one
+	added        int                                   // Jobs appended to the crontab
+	width        int                                   // Terminal width
+	height       int                                   // Terminal height
+	calendars    calendarSet                           // Holidays, business hours, and freezes the agenda marks
+}
+
+// newJobBrowser lists jobs under a title
+func newJobBrowser(title, action string, jobs []browserJob, apply func(int, string, string)) *jobBrowser {
+	browser := &jobBrowser{title: title, action: action, jobs: jobs, apply: apply, edited: make(map[int]bool)}
+	for _, job := range jobs {
+		browser.descriptions = append(browser.descriptions, describeJob(job.expr))
+	}
+
+	return browser
+}
+
+// describeJob describes a schedule, or says why it cannot
+func describeJob(expr string) string {
+	result, err := explainSpec(cronSpec{fields: strings.Fields(expr)}, time.Now())
+	if err != nil {
+		return err.Error()
+	}
+
+	return result.description
+}
+
+// nextJobRun formats a job's next run in its time zone, "" when it never runs
+func nextJobRun(job browserJob, now time.Time) string {
+	schedule, err := standardParser.Parse(job.expr)
+	if err != nil {
+		return ""
+	}
+
+	next := schedule.Next(now.In(job.location))
+	if next.IsZero() {
+		return ""
+	}
+
+	return next.Format("Mon 2006-01-02 15:04 MST")
+}
+
+// Init starts the browser with nothing to do
+func (b *jobBrowser) Init() tea.Cmd {
+	return nil
+}
+
+// openEditor opens the editor on the selected job with its command shown
+// read-only under the expression, beside the agenda when split
+func (b *jobBrowser) openEditor(split bool) tea.Cmd {
+	job := b.jobs[b.cursor]
+
+	editor := initialModel()
+	b.split = split
+	b.editor = editor
+	b.sizeEditor()
+	editor.lineCommand = job.command
+	editor.lineRemote = !b.checkPaths
+	editor.calendars = b.calendars
+
+	if b.doc != nil {
+		editor.linePath = b.doc.pathAt(b.doc.sources[b.cursor])
+		editor.openedExpr, editor.openedCommand = job.expr, job.command
+		editor.pendingWrite = b.changed()
+	}
+	editor.setExpression(job.expr)
+	editor.updateDescription()
+
+	b.status = ""
+
+	return editor.Init()
+}
+
+// addJob opens the editor in raw mode on a new job, for its schedule and
+// command to be typed as one line. The job is appended to the crontab when
+// the editor is closed.
+func (b *jobBrowser) addJob() tea.Cmd {
+	editor := initialModel()
+	b.split = false
+	b.adding = true
+	b.editor = editor
+	b.sizeEditor()
+	editor.lineRemote = !b.checkPaths
+	editor.calendars = b.calendars
+	editor.linePath = b.doc.pathAt(len(b.doc.syntax.lines))
+	editor.pendingWrite = b.changed()
+	editor.updateDescription()
+
+	b.status = ""
+
+	return tea.Batch(editor.Init(), editor.toggleRawMode())
+}
+
+// closeNewJob appends the job typed into the editor to the crontab, unless
+// its expression is invalid or it has no command
+func (b *jobBrowser) closeNewJob(editor *model) {
+	b.adding = false
+
+	expr := editor.buildCronExpression()
+	if err := validateStandardFields(strings.Fields(expr)); err != nil {
+		b.status = "not added: " + err.Error()
+
+		return
+	}
+
+	if editor.lineCommand == "" {
+		b.status = "not added: type the command after the schedule"
+
+		return
+	}
+
+	source := b.doc.appendLine(expr + " " + editor.lineCommand)
+
+	job := browserJob{name: editor.lineCommand, expr: expr, command: editor.lineCommand, location: time.Local}
+	if jobs, err := crontabJobs(b.doc.line(source)); err == nil && len(jobs) == 1 {
+		job.name = jobs[0].name
+	}
+
+	b.doc.sources = append(b.doc.sources, source)
+	b.jobs = append(b.jobs, job)
+	b.descriptions = append(b.descriptions, describeJob(expr))
+	b.cursor = len(b.jobs) - 1
+	b.added++
+	b.status = fmt.Sprintf("added %s: %s", job.name, expr)
+}
+
+// sizeEditor gives the editor the whole terminal, or its half of it when split
+func (b *jobBrowser) sizeEditor() {
+	b.editor.width, b.editor.height = b.width, b.height
+	if b.split {
+		b.editor.width, _ = b.splitWidths()
+	}
+}
+
+// closeEditor hands the edited schedule to apply, unless the expression is
+// invalid or nothing changed
+func (b *jobBrowser) closeEditor() {
+	editor := b.editor
+	b.editor = nil
+
+	if b.adding {
+		b.closeNewJob(editor)
+
+		return
+	}
+
+	job := &b.jobs[b.cursor]
+
+	expr := editor.buildCronExpression()
+	if err := validateStandardFields(strings.Fields(expr)); err != nil {
+		b.status = "not applied: " + err.Error()
+
+		return
+	}
+
+	if expr == job.expr && editor.lineCommand == job.command {
+		return
+	}
+
+	job.expr, job.command = expr, editor.lineCommand
+	b.apply(b.cursor, job.expr, job.command)
+	b.descriptions[b.cursor] = describeJob(expr)
+	b.edited[b.cursor] = true
+	b.status = fmt.Sprintf("changed %s: %s", job.name, expr)
+}
+
+// changed reports whether any job or variable was edited, or a job deleted
+func (b *jobBrowser) changed() bool {
+	return len(b.edited) > 0 || len(b.trash) > 0 || b.added > 0 || b.envEdited || b.commentFixed
+}
+
+// summary counts the changes for the message written once they are kept
+func (b *jobBrowser) summary() string {
+	summary := fmt.Sprintf("%d changed and %d deleted jobs", len(b.edited), len(b.trash))
+	if b.added > 0 {
+		summary = fmt.Sprintf("%d added, %s", b.added, summary)
+	}
+
+	if b.envEdited {
+		summary += " and changed variables"
+	}
+
+	if b.commentFixed {
+		summary += " and regenerated comments"
+	}
+
+	return summary
+}
+
+// step moves the cursor to the next job in the direction of delta that is
+// not in the trash, staying put when there is none
+func (b *jobBrowser) step(delta int) {
+	for index := b.cursor + delta; index >= 0 && index < len(b.jobs); index += delta {
+		if !slices.Contains(b.trash, index) {
+			b.cursor = index
+
+			return
+		}
+	}
+}
+
+// deleteJob moves the selected job to the trash
+func (b *jobBrowser) deleteJob() {
+	if b.remove == nil {
+		b.status = "jobs cannot be deleted here"
+
+		return
+	}
+
+	if slices.Contains(b.trash, b.cursor) {
+		return
+	}
+
+	b.trash = append(b.trash, b.cursor)
+	b.remove(b.cursor, true)
+	b.status = fmt.Sprintf("deleted %s: t to view the trash", b.jobs[b.cursor].name)
+
+	// Select the next job, or the previous one after deleting the last
+	previous := b.cursor
+
+	b.step(1)
+
+	if b.cursor == previous {
+		b.step(-1)
+	}
+}
+
+// restoreJob takes the selected job out of the trash
+func (b *jobBrowser) restoreJob() {
+	if len(b.trash) == 0 {
+		return
+	}
+
+	index := b.trash[b.trashCursor]
+	b.trash = slices.Delete(b.trash, b.trashCursor, b.trashCursor+1)
+	b.remove(index, false)
+	b.cursor = index
+	b.trashCursor = min(b.trashCursor, max(0, len(b.trash)-1))
+	b.status = "restored " + b.jobs[index].name
+
+	if len(b.trash) == 0 {
+		b.showTrash = false
+	}
+}
+
+// updateTrash handles keys while the trash is listed
+func (b *jobBrowser) updateTrash(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The trash is listed newest first, so up moves toward later deletions
+	switch key.String() {
+	case "up", "k":
+		b.trashCursor = min(len(b.trash)-1, b.trashCursor+1)
+	case "down", "j":
+		b.trashCursor = max(0, b.trashCursor-1)
+	case "u", "enter":
+		b.restoreJob()
+	case "t", "esc":
+		b.showTrash = false
+	case "ctrl+c":
+		return b, tea.Quit
+	}
+
+	return b, nil
+}
+
+// Update handles browsing keys, or passes messages to the open editor
+func (b *jobBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		b.width, b.height = size.Width, size.Height
+	}
+
+	if b.editor != nil {
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "esc" {
+			b.closeEditor()
+
+			return b, nil
+		}
+
+		_, cmd := b.editor.Update(msg)
+		b.sizeEditor()
+
+		return b, cmd
+	}
+
+	if b.envForm != nil {
+		return b.updateEnvForm(msg)
+	}
+
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return b, nil
+	}
+
+	if b.showTrash {
+		return b.updateTrash(key)
+	}
+
+	if key.String() != "q" && key.String() != "esc" {
+		b.confirmQuit = false
+	}
+
+	// With every job in the trash, only the trash and quitting are left
+	allDeleted := len(b.trash) == len(b.jobs)
+
+	switch key.String() {
+	case "up", "k":
+		b.step(-1)
+	case "down", "j":
+		b.step(1)
+	case "enter", "s":
+		if !allDeleted {
+			return b, b.openEditor(key.String() == "s")
+		}
+	case "d":
+		if !allDeleted {
+			b.deleteJob()
+		}
+	case "t":
+		if len(b.trash) == 0 {
+			b.status = "the trash is empty"
+
+			return b, nil
+		}
+
+		b.showTrash = true
+		b.trashCursor = len(b.trash) - 1
+	case "a":
+		if b.doc == nil {
+			b.status = "jobs cannot be added here"
+
+			return b, nil
+		}
+
+		return b, b.addJob()
+	case "v":
+		if b.doc == nil {
+			b.status = "variables cannot be edited here"
+
+			return b, nil
+		}
+
+		return b, b.openEnvForm()
+	case "c":
+		if b.doc == nil || allDeleted {
+			return b, nil
+		}
+
+		b.regenerateComment()
+	case "w":
+		if !b.changed() {
+			b.status = "no changes to " + b.action
+
+			return b, nil
+		}
+
+		b.save = true
+
+		return b, tea.Quit
+	case "ctrl+c":
+		return b, tea.Quit
+	case "q", "esc":
+		if b.changed() && !b.confirmQuit {
+			b.confirmQuit = true
+			b.status = fmt.Sprintf("unsaved changes: w to %s them, q again discards them", b.action)
+
+			return b, nil
+		}
+
+		return b, tea.Quit
+	}
+
+	return b, nil
+}
+
+// View renders the job list, or the editor with a way back to it and the
+// agenda beside it when split
+func (b *jobBrowser) View() string {
+	if b.editor != nil && b.split {
+		return b.renderSplit()
+	}
+
+	if b.editor != nil {
+		return b.editor.View() + "\n" + labelStyle.Render("esc: back to "+b.title)
+	}
+
+	if b.envForm != nil {
+		return b.renderEnvForm()
+	}
+
+	var builder strings.Builder
+
+	if b.showTrash {
+		builder.WriteString(titleStyle.Render("crontab guru: deleted from "+b.title) + "\n")
+
+		for index, job := range slices.Backward(b.trash) {
+			row := fmt.Sprintf("%s  %s", b.jobs[job].expr, b.jobs[job].name)
+			if index == b.trashCursor {
+				builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+			} else {
+				builder.WriteString(labelStyle.Render("  "+row) + "\n")
+			}
+		}
+
+		builder.WriteString("\n" + helpStyle.Render("u: restore · t: back to the jobs"))
+
+		return builder.String()
+	}
+
+	builder.WriteString(titleStyle.Render("crontab guru: "+b.title) + "\n")
+
+	if b.doc != nil {
+		builder.WriteString(b.renderVariables() + "\n")
+	}
+
+	now := time.Now()
+	rows := make([][]string, len(b.jobs))
+	widths := make([]int, 3)
+
+	for index, job := range b.jobs {
+		rows[index] = []string{job.expr, nextJobRun(job, now), b.descriptions[index]}
+		for column, cell := range rows[index] {
+			widths[column] = max(widths[column], len(cell))
+		}
+	}
+
+	for index, job := range b.jobs {
+		if slices.Contains(b.trash, index) {
+			continue
+		}
+
+		row := fmt.Sprintf("%-*s  %-*s  %-*s  %s", widths[0], rows[index][0], widths[1], rows[index][1],
+			widths[2], rows[index][2], job.name)
+		if index == b.cursor {
+			builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+		} else {
+			builder.WriteString(labelStyle.Render("  "+row) + "\n")
+		}
+	}
+
+	if len(b.jobs) == 0 && b.doc != nil {
+		builder.WriteString(labelStyle.Render("  no jobs yet: a to add one") + "\n")
+	}
+
+	if duplicates := b.renderDuplicates(); duplicates != "" {
+		builder.WriteString("\n" + duplicates)
+	}
+
+	builder.WriteString(b.renderDrift())
+
+	for _, warning := range b.pathWarnings() {
+		builder.WriteString(conflictStyle.Render("command: "+warning) + "\n")
+	}
+
+	if b.status != "" {
+		builder.WriteString("\n" + conflictStyle.Render(b.status) + "\n")
+	}
+
+	help := "enter: edit · s: edit beside the agenda · "
+	if b.doc != nil {
+		help += "a: add · "
+	}
+
+	if b.remove != nil {
+		help += fmt.Sprintf("d: delete · t: trash (%d) · ", len(b.trash))
+	}
+
+	if b.doc != nil {
+		help += "v: variables · "
+	}
+
+	if len(b.driftedComments()) > 0 {
+		help += "c: regenerate comment · "
+	}
+
+	builder.WriteString("\n" + helpStyle.Render(help+fmt.Sprintf("w: %s and quit · q: quit", b.action)))
+
+	return builder.String()
+}
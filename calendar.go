@@ -0,0 +1,367 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	calendarDateLayout  = "2006-01-02"        // Layout of holiday dates and whole-day freezes
+	calendarRunLayout   = "Mon Jan 2 15:04"   // Layout of the runs named in calendar warnings
+	calendarLookahead   = 31 * 24 * time.Hour // Period the editor checks the runs of against the calendars
+	calendarWarningRuns = 1000                // Most runs the editor checks against the calendars
+)
+
+// holidayCalendar knows the holidays of a country, region, or company
+type holidayCalendar interface {
+	// holiday returns the name of the holiday on the day of at, in at's zone
+	holiday(at time.Time) (string, bool)
+}
+
+// businessHoursCalendar knows when people are at work
+type businessHoursCalendar interface {
+	// businessHours reports whether at is within business hours
+	businessHours(at time.Time) bool
+}
+
+// freezeCalendar knows the windows in which changes are frozen
+type freezeCalendar interface {
+	// freeze returns the reason of the freeze at falls in
+	freeze(at time.Time) (string, bool)
+}
+
+// calendarSet is every calendar the warnings and the agenda consult. A
+// source that knows only holidays, say, adds itself to that list alone.
+type calendarSet struct {
+	holidays      []holidayCalendar       // Holiday sources, any of which may name a day
+	businessHours []businessHoursCalendar // Business hours, a time being within them if within any
+	freezes       []freezeCalendar        // Change freeze sources, any of which may freeze a time
+}
+
+// calendarLoaders read calendar files by extension into the calendars they
+// hold. A company's own format, such as an HR system export, is supported
+// by adding its loader here.
+var calendarLoaders = map[string]func(data []byte) (calendarSet, error){ //nolint:gochecknoglobals
+	".json": parseCalendarFile,
+	".ics":  parseHolidayICS,
+}
+
+// add merges the calendars of another set into this one
+func (set *calendarSet) add(other calendarSet) {
+	set.holidays = append(set.holidays, other.holidays...)
+	set.businessHours = append(set.businessHours, other.businessHours...)
+	set.freezes = append(set.freezes, other.freezes...)
+}
+
+// notes says what the calendars know about a run: the holidays it falls on,
+// the freezes it falls in, and whether it is outside business hours
+func (set *calendarSet) notes(run time.Time) []string {
+	var notes []string
+
+	for _, calendar := range set.holidays {
+		if name, ok := calendar.holiday(run); ok {
+			notes = append(notes, "on "+name)
+		}
+	}
+
+	for _, calendar := range set.freezes {
+		if reason, ok := calendar.freeze(run); ok {
+			notes = append(notes, "during "+reason)
+		}
+	}
+
+	if len(set.businessHours) > 0 {
+		within := false
+		for _, calendar := range set.businessHours {
+			within = within || calendar.businessHours(run)
+		}
+
+		if !within {
+			notes = append(notes, "outside business hours")
+		}
+	}
+
+	return notes
+}
+
+// holidayDates maps dates in calendarDateLayout to holiday names
+type holidayDates map[string]string
+
+// holiday looks up the date of at
+func (dates holidayDates) holiday(at time.Time) (string, bool) {
+	name, ok := dates[at.Format(calendarDateLayout)]
+
+	return name, ok
+}
+
+// workingHours is business hours as cron fields: the weekdays and hours that
+// are within them, read in a time zone
+type workingHours struct {
+	weekdays fieldSet       // Working days, 0 for Sunday
+	hours    fieldSet       // Working hours, 9-17 covering 09:00 up to 18:00
+	location *time.Location // Time zone the hours are kept in
+}
+
+// businessHours checks the weekday and hour of at in the hours' zone
+func (hours workingHours) businessHours(at time.Time) bool {
+	at = at.In(hours.location)
+
+	return hours.weekdays.Has(int(at.Weekday())) && hours.hours.Has(at.Hour())
+}
+
+// freezeWindow is a period in which changes are frozen
+type freezeWindow struct {
+	start  time.Time // First moment of the freeze
+	end    time.Time // Moment the freeze ends
+	reason string    // Why changes are frozen, e.g. "year-end freeze"
+}
+
+// freezeWindows is a list of freezes
+type freezeWindows []freezeWindow
+
+// freeze finds the first freeze containing at
+func (windows freezeWindows) freeze(at time.Time) (string, bool) {
+	for _, window := range windows {
+		if !at.Before(window.start) && at.Before(window.end) {
+			return window.reason, true
+		}
+	}
+
+	return "", false
+}
+
+// calendarFile is a JSON calendar file. Any of its parts may be left out.
+type calendarFile struct {
+	Timezone      string                 `json:"timezone,omitempty"`       // Zone of business hours and freezes, local by default
+	Holidays      []calendarHoliday      `json:"holidays,omitempty"`       // Holidays by date
+	BusinessHours *calendarBusinessHours `json:"business_hours,omitempty"` // When people are at work
+	Freezes       []calendarFreeze       `json:"freezes,omitempty"`        // Change freezes
+}
+
+// calendarHoliday is a holiday of a calendar file
+type calendarHoliday struct {
+	Date string `json:"date"` // Day of the holiday, e.g. "2026-12-25"
+	Name string `json:"name"` // Name of the holiday
+}
+
+// calendarBusinessHours is the business hours of a calendar file
+type calendarBusinessHours struct {
+	Weekdays string `json:"weekdays"` // Working days as a weekday field, e.g. "MON-FRI"
+	Hours    string `json:"hours"`    // Working hours as an hour field, e.g. "9-17"
+}
+
+// calendarFreeze is a change freeze of a calendar file
+type calendarFreeze struct {
+	Start  string `json:"start"`  // First day or time of the freeze
+	End    string `json:"end"`    // Last day of the freeze, or the time it ends
+	Reason string `json:"reason"` // Why changes are frozen, e.g. "year-end freeze"
+}
+
+// parseCalendarFile reads a JSON calendar file into the calendars it defines
+func parseCalendarFile(data []byte) (calendarSet, error) {
+	var (
+		file calendarFile
+		set  calendarSet
+	)
+
+	if err := json.Unmarshal(data, &file); err != nil {
+		return set, err
+	}
+
+	location := time.Local
+
+	if file.Timezone != "" {
+		loaded, err := time.LoadLocation(file.Timezone)
+		if err != nil {
+			return set, fmt.Errorf("timezone %q: %w", file.Timezone, err)
+		}
+
+		location = loaded
+	}
+
+	if len(file.Holidays) > 0 {
+		dates := make(holidayDates, len(file.Holidays))
+
+		for _, holiday := range file.Holidays {
+			if _, err := time.Parse(calendarDateLayout, holiday.Date); err != nil {
+				return set, fmt.Errorf("holiday %q: date %q is not like 2006-01-02", holiday.Name, holiday.Date)
+			}
+
+			dates[holiday.Date] = holiday.Name
+		}
+
+		set.holidays = append(set.holidays, dates)
+	}
+
+	if file.BusinessHours != nil {
+		weekdays, err := expandField(file.BusinessHours.Weekdays, fieldIndexWeekday)
+		if err != nil {
+			return set, fmt.Errorf("business hours: %w", err)
+		}
+
+		hours, err := expandField(file.BusinessHours.Hours, fieldIndexHour)
+		if err != nil {
+			return set, fmt.Errorf("business hours: %w", err)
+		}
+
+		set.businessHours = append(set.businessHours, workingHours{weekdays: weekdays, hours: hours, location: location})
+	}
+
+	var windows freezeWindows
+
+	for _, freeze := range file.Freezes {
+		start, ok := parseLayoutTime(freeze.Start, location)
+		if !ok {
+			return set, fmt.Errorf("freeze %q: start %q is not a time like 2006-01-02 15:04", freeze.Reason, freeze.Start)
+		}
+
+		end, ok := parseLayoutTime(freeze.End, location)
+		if !ok {
+			return set, fmt.Errorf("freeze %q: end %q is not a time like 2006-01-02 15:04", freeze.Reason, freeze.End)
+		}
+
+		// A freeze ending on a day includes that day
+		if len(freeze.End) == len(calendarDateLayout) {
+			end = end.AddDate(0, 0, 1)
+		}
+
+		windows = append(windows, freezeWindow{start: start, end: end, reason: freeze.Reason})
+	}
+
+	if len(windows) > 0 {
+		set.freezes = append(set.freezes, windows)
+	}
+
+	return set, nil
+}
+
+// parseHolidayICS reads the all-day events of an iCalendar file, as holiday
+// calendars published by governments and calendar apps are, as holidays.
+// Events with a time of day are skipped.
+func parseHolidayICS(data []byte) (calendarSet, error) {
+	dates := make(holidayDates)
+
+	var (
+		start, end time.Time
+		summary    string
+	)
+
+	// Continuation lines start with a space or tab and belong to the line before
+	text := strings.NewReplacer("\r\n ", "", "\r\n\t", "", "\n ", "", "\n\t", "").Replace(string(data))
+
+	for line := range strings.Lines(text) {
+		name, value, _ := strings.Cut(strings.TrimRight(line, "\r\n"), ":")
+		property, parameters, _ := strings.Cut(name, ";")
+
+		switch property {
+		case "BEGIN":
+			start, end, summary = time.Time{}, time.Time{}, ""
+		case "DTSTART", "DTEND":
+			if !strings.Contains(parameters, "VALUE=DATE") && len(value) != len("20060102") {
+				continue
+			}
+
+			day, err := time.Parse("20060102", value)
+			if err != nil {
+				return calendarSet{}, fmt.Errorf("%s %q: %w", property, value, err)
+			}
+
+			if property == "DTSTART" {
+				start = day
+			} else {
+				end = day
+			}
+		case "SUMMARY":
+			summary = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\\`, `\`).Replace(value)
+		case "END":
+			if value != "VEVENT" || start.IsZero() {
+				continue
+			}
+
+			// The end of an all-day event is the day after its last day
+			for day := start; day.Equal(start) || day.Before(end); day = day.AddDate(0, 0, 1) {
+				dates[day.Format(calendarDateLayout)] = summary
+			}
+		}
+	}
+
+	return calendarSet{holidays: []holidayCalendar{dates}}, nil
+}
+
+// loadCalendars reads the calendar files named in the config, relative to
+// dir when not absolute, picking the loader by extension
+func loadCalendars(paths []string, dir string) (calendarSet, error) {
+	var set calendarSet
+
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		load, ok := calendarLoaders[strings.ToLower(filepath.Ext(path))]
+		if !ok {
+			return set, fmt.Errorf("%w: calendar %s is neither .json nor .ics", ErrInvalidConfig, path)
+		}
+
+		data, err := os.ReadFile(path) //nolint:gosec // A calendar the user's config names
+		if err != nil {
+			return set, fmt.Errorf("%w: calendar %w", ErrInvalidConfig, err)
+		}
+
+		calendars, err := load(data)
+		if err != nil {
+			return set, fmt.Errorf("%w: calendar %s: %w", ErrInvalidConfig, path, err)
+		}
+
+		set.add(calendars)
+	}
+
+	return set, nil
+}
+
+// configCalendars reads the calendars named in the config file at path, for
+// the commands that do not read the rest of it
+func configCalendars(path string) (calendarSet, error) {
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return calendarSet{}, err
+	}
+
+	return loadCalendars(cfg.Calendars, filepath.Dir(path))
+}
+
+// calendarWarnings names the first run over the next calendarLookahead that
+// falls on each holiday, in each freeze, or outside business hours
+func (m *model) calendarWarnings(now time.Time) []string {
+	if len(m.calendars.holidays)+len(m.calendars.businessHours)+len(m.calendars.freezes) == 0 {
+		return nil
+	}
+
+	schedule, err := standardParser.Parse(m.buildCronExpression())
+	if err != nil {
+		return nil
+	}
+
+	var warnings []string
+
+	seen := make(map[string]bool)
+
+	for run := range upcomingRuns(schedule, now, now.Add(calendarLookahead), calendarWarningRuns) {
+		for _, note := range m.calendars.notes(run) {
+			if !seen[note] {
+				seen[note] = true
+				warnings = append(warnings, fmt.Sprintf("runs %s %s", run.Format(calendarRunLayout), note))
+			}
+		}
+	}
+
+	return warnings
+}
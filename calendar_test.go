@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// testCalendar has a holiday, business hours, and a freeze ending on a day.
+const testCalendar = `{
+  "timezone": "UTC",
+  "holidays": [{"date": "2026-12-25", "name": "Christmas Day"}],
+  "business_hours": {"weekdays": "MON-FRI", "hours": "9-17"},
+  "freezes": [{"start": "2026-12-18", "end": "2027-01-03", "reason": "year-end freeze"}]
+}`
+
+// TestCalendarNotes verifies what a JSON calendar file says about runs, with
+// a freeze ending on a day including that day.
+func TestCalendarNotes(t *testing.T) {
+	t.Parallel()
+
+	set, err := parseCalendarFile([]byte(testCalendar))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		run      time.Time
+		expected []string
+	}{
+		{time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC), nil},
+		{time.Date(2026, time.October, 15, 18, 0, 0, 0, time.UTC), []string{"outside business hours"}},
+		{time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC), []string{"outside business hours"}},
+		{time.Date(2026, time.December, 25, 10, 0, 0, 0, time.UTC), []string{"on Christmas Day", "during year-end freeze"}},
+		{time.Date(2027, time.January, 3, 23, 59, 0, 0, time.UTC), []string{"during year-end freeze", "outside business hours"}},
+		{time.Date(2027, time.January, 4, 10, 0, 0, 0, time.UTC), nil},
+	}
+
+	for _, test := range tests {
+		if notes := set.notes(test.run); !slices.Equal(notes, test.expected) {
+			t.Errorf("notes(%s) = %q, expected %q", test.run, notes, test.expected)
+		}
+	}
+
+	for _, bad := range []string{
+		`{"holidays": [{"date": "25/12/2026", "name": "Christmas Day"}]}`,
+		`{"business_hours": {"weekdays": "MON-FRI", "hours": "9-25"}}`,
+		`{"freezes": [{"start": "soon", "end": "2027-01-03"}]}`,
+		`{"timezone": "Mars/Base"}`,
+	} {
+		if _, err := parseCalendarFile([]byte(bad)); err == nil {
+			t.Errorf("Expected an error for %s", bad)
+		}
+	}
+}
+
+// TestParseHolidayICS verifies that all-day events become holidays, over
+// every day they span, with folded lines and escapes read.
+func TestParseHolidayICS(t *testing.T) {
+	t.Parallel()
+
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20261225\r\nSUMMARY:Christmas\r\n  Day\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260403\r\nDTEND;VALUE=DATE:20260405\r\nSUMMARY:Easter\\, long weekend\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART:20261015T090000Z\r\nSUMMARY:Standup\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	set, err := parseHolidayICS([]byte(ics))
+	if err != nil || len(set.holidays) != 1 {
+		t.Fatalf("Unexpected result %+v, %v", set, err)
+	}
+
+	tests := map[string]string{
+		"2026-12-25": "Christmas Day",
+		"2026-04-03": "Easter, long weekend",
+		"2026-04-04": "Easter, long weekend",
+		"2026-04-05": "",
+		"2026-10-15": "",
+	}
+
+	for date, expected := range tests {
+		day, _ := time.Parse(calendarDateLayout, date)
+		if name, _ := set.holidays[0].holiday(day); name != expected {
+			t.Errorf("holiday(%s) = %q, expected %q", date, name, expected)
+		}
+	}
+}
+
+// TestLoadCalendars verifies that calendars are read relative to the
+// config's directory by extension, and that other files are rejected.
+func TestLoadCalendars(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "company.json"), []byte(testCalendar), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "holidays.ics"), []byte("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261015\nSUMMARY:Founders Day\nEND:VEVENT\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	set, err := loadCalendars([]string{"company.json", filepath.Join(dir, "holidays.ics")}, dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(set.holidays) != 2 || len(set.businessHours) != 1 || len(set.freezes) != 1 {
+		t.Errorf("Expected every calendar loaded, got %+v", set)
+	}
+
+	for _, paths := range [][]string{{"holidays.csv"}, {"missing.json"}} {
+		if _, err := loadCalendars(paths, dir); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("loadCalendars(%q) = %v, expected ErrInvalidConfig", paths, err)
+		}
+	}
+}
+
+// TestCalendarWarnings verifies that the editor names the first run on a
+// holiday and outside business hours, once each, and renders them each frame.
+func TestCalendarWarnings(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	holiday := holidayDates{"2026-10-16": "Founders Day"}
+	hours := workingHours{weekdays: 0b0111110, hours: 0b111111111 << 9, location: time.UTC}
+
+	m := initialModel()
+	m.calendars = calendarSet{holidays: []holidayCalendar{holiday}, businessHours: []businessHoursCalendar{hours}}
+	m.setExpression("0 20 * * *")
+
+	expected := []string{"runs Thu Oct 15 20:00 outside business hours", "runs Fri Oct 16 20:00 on Founders Day"}
+	if warnings := m.calendarWarnings(now); !slices.Equal(warnings, expected) {
+		t.Errorf("Expected %q, got %q", expected, warnings)
+	}
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "warning: runs ") {
+		t.Errorf("Expected calendar warnings in plain mode:\n%s", plain)
+	}
+	// The warnings change with the time, not only the expression, so they
+	// are not kept in the render cache
+	calendars := m.calendars
+	m.calendars = calendarSet{}
+	m.width = 120
+	m.updateDescription()
+
+	if view := m.View(); strings.Contains(view, "outside business hours") {
+		t.Fatalf("Expected no calendar warnings without calendars:\n%s", view)
+	}
+
+	m.calendars = calendars
+
+	if view := m.View(); !strings.Contains(view, "outside business hours") {
+		t.Errorf("Expected calendar warnings rendered afresh with the same expression:\n%s", view)
+	}
+}
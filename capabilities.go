@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	capabilityValueWidth = 20 // Width of the value column in the dialects text output
+
+	dayMatchingEither    = "either"    // Runs when either restricted day field matches
+	dayMatchingExclusive = "exclusive" // One of the day fields must be "?"
+)
+
+// fieldCapability is what a dialect accepts in one field
+type fieldCapability struct {
+	Name     string   `json:"name"`               // Field name, e.g. "minute" or "year"
+	Min      int      `json:"min"`                // Smallest value
+	Max      int      `json:"max"`                // Largest value
+	Names    []string `json:"names,omitempty"`    // Names accepted for the values from Min on
+	Tokens   []string `json:"tokens"`             // Syntax accepted besides numbers and names
+	Optional bool     `json:"optional,omitempty"` // Whether the field may be left out at the end
+}
+
+// dialectCapability is the syntax and semantics of a dialect, as the
+// converter reads and writes it
+type dialectCapability struct {
+	Name        dialect           `json:"name"`              // Dialect name passed to --dialect, --from, and --to
+	Summary     string            `json:"summary"`           // One-line description
+	Fields      []fieldCapability `json:"fields"`            // Fields in order
+	MinFields   int               `json:"minFields"`         // Fewest fields an expression has
+	MaxFields   int               `json:"maxFields"`         // Most fields an expression has
+	Wrapper     string            `json:"wrapper,omitempty"` // Optional text around the fields, e.g. "cron(...)"
+	Sunday      int               `json:"sunday"`            // Weekday number of Sunday
+	DayMatching string            `json:"dayMatching"`       // How the day and weekday fields combine
+	Hash        bool              `json:"hash"`              // Whether H spreads jobs by a hash of their name
+	Macros      map[string]string `json:"macros,omitempty"`  // Macros and the expressions they stand for
+}
+
+// withTokens copies a field, adding syntax it accepts
+func (field fieldCapability) withTokens(tokens ...string) fieldCapability {
+	field.Tokens = append(slices.Clone(field.Tokens), tokens...)
+
+	return field
+}
+
+// layout lists the dialect's field names in order
+func (capability dialectCapability) layout() []string {
+	names := make([]string, 0, len(capability.Fields))
+	for _, field := range capability.Fields {
+		names = append(names, field.Name)
+	}
+
+	return names
+}
+
+// dialectCapabilities is the registry of what each dialect accepts, in the
+// order dialects are listed to users. Its tests check it against the
+// parsers, so it cannot drift from what they read.
+func dialectCapabilities() []dialectCapability {
+	tokens := []string{"*", ",", "-", "/"}
+	seconds := fieldCapability{Name: "seconds", Min: 0, Max: 59, Tokens: tokens}
+	minute := fieldCapability{Name: "minute", Min: 0, Max: 59, Tokens: tokens}
+	hour := fieldCapability{Name: "hour", Min: 0, Max: 23, Tokens: tokens}
+	day := fieldCapability{Name: "day", Min: 1, Max: 31, Tokens: tokens}
+	month := fieldCapability{Name: "month", Min: 1, Max: 12, Names: monthNames, Tokens: tokens}
+	weekday := fieldCapability{Name: "weekday", Min: 0, Max: 6, Names: weekdayNames, Tokens: tokens}
+	quartzDay := day.withTokens("?", "L", "W")
+	quartzWeekday := fieldCapability{Name: "weekday", Min: 1, Max: 7, Names: weekdayNames, Tokens: tokens}.withTokens("?", "L", "#")
+
+	capabilities := []dialectCapability{
+		{
+			Name:        dialectStandard,
+			Summary:     "five fields, Sunday is 0",
+			Fields:      []fieldCapability{minute, hour, day, month, weekday},
+			DayMatching: dayMatchingEither,
+			Macros:      cronMacros,
+		},
+		{
+			Name:        dialectSeconds,
+			Summary:     "six fields with seconds first",
+			Fields:      []fieldCapability{seconds, minute, hour, day, month, weekday},
+			DayMatching: dayMatchingEither,
+		},
+		{
+			Name:        dialectAzure,
+			Summary:     "Azure Functions NCRONTAB: six fields with seconds first",
+			Fields:      []fieldCapability{seconds, minute, hour, day, month, weekday},
+			DayMatching: dayMatchingEither,
+		},
+		{
+			Name:    dialectQuartz,
+			Summary: `seconds first, optional year, Sunday is 1, "?" required`,
+			Fields: []fieldCapability{
+				seconds, minute, hour, quartzDay, month, quartzWeekday,
+				{Name: "year", Min: 1970, Max: 2099, Tokens: tokens, Optional: true},
+			},
+			Sunday:      1,
+			DayMatching: dayMatchingExclusive,
+		},
+		{
+			Name:    dialectAWS,
+			Summary: "EventBridge cron(...) with a year field, Sunday is 1",
+			Fields: []fieldCapability{
+				minute, hour, quartzDay, month, quartzWeekday,
+				{Name: "year", Min: 1970, Max: 2199, Tokens: tokens},
+			},
+			Wrapper:     "cron(...)",
+			Sunday:      1,
+			DayMatching: dayMatchingExclusive,
+		},
+		{
+			Name:    dialectJenkins,
+			Summary: "five fields plus H hashing",
+			Fields: []fieldCapability{
+				minute.withTokens("H", "H(a-b)"), hour.withTokens("H", "H(a-b)"),
+				day.withTokens("H", "H(a-b)"), month.withTokens("H", "H(a-b)"), weekday.withTokens("H", "H(a-b)"),
+			},
+			DayMatching: dayMatchingEither,
+			Hash:        true,
+			Macros:      jenkinsMacros,
+		},
+	}
+
+	for index := range capabilities {
+		capability := &capabilities[index]
+		capability.MaxFields = len(capability.Fields)
+
+		for _, field := range capability.Fields {
+			if !field.Optional {
+				capability.MinFields++
+			}
+		}
+	}
+
+	return capabilities
+}
+
+// lookupCapability finds a dialect in the registry
+func lookupCapability(d dialect) (dialectCapability, bool) {
+	for _, capability := range dialectCapabilities() {
+		if capability.Name == d {
+			return capability, true
+		}
+	}
+
+	return dialectCapability{}, false
+}
+
+// renderCapabilityText describes a dialect with a line per field
+func renderCapabilityText(capability dialectCapability) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s: %s\n", capability.Name, capability.Summary)
+
+	for _, field := range capability.Fields {
+		values := fmt.Sprintf("%d-%d", field.Min, field.Max)
+		if len(field.Names) > 0 {
+			values += fmt.Sprintf(" or %s-%s", field.Names[0], field.Names[len(field.Names)-1])
+		}
+
+		if field.Optional {
+			values += " (optional)"
+		}
+
+		fmt.Fprintf(&builder, "  %-*s %-*s %s\n", reportFieldWidth, field.Name, capabilityValueWidth, values,
+			strings.Join(field.Tokens, " "))
+	}
+
+	count := fmt.Sprintf("%d fields", capability.MaxFields)
+	if capability.MinFields != capability.MaxFields {
+		count = fmt.Sprintf("%d-%d fields", capability.MinFields, capability.MaxFields)
+	}
+
+	if capability.Wrapper != "" {
+		count += ", optionally wrapped in " + capability.Wrapper
+	}
+
+	days := "runs when either the day or the weekday matches"
+	if capability.DayMatching == dayMatchingExclusive {
+		days = `"?" in exactly one of the day and weekday fields`
+	}
+
+	fmt.Fprintf(&builder, "  %s; Sunday is %d; %s\n", count, capability.Sunday, days)
+
+	if len(capability.Macros) > 0 {
+		macros := make([]string, 0, len(capability.Macros))
+		for macro := range capability.Macros {
+			macros = append(macros, macro)
+		}
+
+		slices.Sort(macros)
+		fmt.Fprintf(&builder, "  macros: %s\n", strings.Join(macros, " "))
+	}
+
+	return builder.String()
+}
+
+// runDialects prints the capability registry, for every dialect or the ones
+// named, as text or as JSON for other tools
+func runDialects(args []string, stdout, stderr io.Writer) error {
+	var output string
+
+	flags := flag.NewFlagSet("dialects", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&output, "output", "text", "output format: text or json")
+
+	names, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if output != "text" && output != "json" {
+		return fmt.Errorf("%w: crontab-guru dialects [--output text|json] [DIALECT...]", ErrUsage)
+	}
+
+	capabilities := dialectCapabilities()
+
+	if len(names) > 0 {
+		capabilities = capabilities[:0:0]
+
+		for _, name := range names {
+			d, err := parseDialect(name)
+			if err != nil {
+				return err
+			}
+
+			capability, _ := lookupCapability(d)
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	if output == "json" {
+		data, err := json.MarshalIndent(capabilities, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to write the registry: %w", err)
+		}
+
+		fmt.Fprintln(stdout, string(data))
+
+		return nil
+	}
+
+	for index, capability := range capabilities {
+		if index > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		fmt.Fprint(stdout, renderCapabilityText(capability))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// capabilityExpression builds an expression with every field set by value,
+// putting "?" in the skipped day field where the dialect needs one
+func capabilityExpression(capability dialectCapability, count int, skipped string, value func(fieldCapability) string) string {
+	parts := make([]string, 0, count)
+
+	for _, field := range capability.Fields[:count] {
+		if capability.DayMatching == dayMatchingExclusive && field.Name == skipped {
+			parts = append(parts, "?")
+		} else {
+			parts = append(parts, value(field))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// TestCapabilitiesMatchParsers verifies that every dialect's parser accepts
+// the field counts and the smallest and largest values the registry lists,
+// and rejects one past either end, so the registry cannot drift from the code.
+func TestCapabilitiesMatchParsers(t *testing.T) {
+	t.Parallel()
+
+	capabilities := dialectCapabilities()
+	if len(capabilities) != len(dialects) {
+		t.Fatalf("Expected a capability per dialect, got %d for %d", len(capabilities), len(dialects))
+	}
+
+	star := func(fieldCapability) string { return "*" }
+
+	for index, capability := range capabilities {
+		if capability.Name != dialects[index] {
+			t.Errorf("Capability %d is %s, expected %s", index, capability.Name, dialects[index])
+		}
+
+		for _, skipped := range []string{"day", "weekday"} {
+			for _, count := range []int{capability.MinFields, capability.MaxFields} {
+				for _, end := range []func(fieldCapability) int{
+					func(field fieldCapability) int { return field.Min },
+					func(field fieldCapability) int { return field.Max },
+				} {
+					expr := capabilityExpression(capability, count, skipped, func(field fieldCapability) string {
+						return strconv.Itoa(end(field))
+					})
+					if _, _, err := parseSpec(expr, capability.Name, "job"); err != nil {
+						t.Errorf("%s rejects %q: %v", capability.Name, expr, err)
+					}
+				}
+			}
+
+			for fieldIndex, field := range capability.Fields[:capability.MinFields] {
+				// Years are carried over as written, without range checks
+				if field.Name == "year" || (capability.DayMatching == dayMatchingExclusive && field.Name == skipped) {
+					continue
+				}
+
+				for _, outside := range []int{field.Min - 1, field.Max + 1} {
+					parts := strings.Fields(capabilityExpression(capability, capability.MinFields, skipped, star))
+					parts[fieldIndex] = strconv.Itoa(outside)
+
+					if _, _, err := parseSpec(strings.Join(parts, " "), capability.Name, "job"); err == nil {
+						t.Errorf("%s accepts %s %d", capability.Name, field.Name, outside)
+					}
+				}
+			}
+		}
+
+		tooMany := capabilityExpression(capability, capability.MaxFields, "weekday", star) + " *"
+		if _, _, err := parseSpec(tooMany, capability.Name, "job"); err == nil {
+			t.Errorf("%s accepts %d fields", capability.Name, capability.MaxFields+1)
+		}
+	}
+}
+
+// TestRunDialects verifies the text and JSON output and the dialect filter.
+func TestRunDialects(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	if err := runDialects([]string{"quartz"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := stdout.String()
+	if !strings.HasPrefix(text, "quartz: ") || !strings.Contains(text, "1970-2099 (optional)") ||
+		!strings.Contains(text, "6-7 fields; Sunday is 1") || strings.Contains(text, "standard:") {
+		t.Errorf("Unexpected text output:\n%s", text)
+	}
+
+	stdout.Reset()
+
+	if err := runDialects([]string{"--output", "json"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var registry []dialectCapability
+	if err := json.Unmarshal(stdout.Bytes(), &registry); err != nil {
+		t.Fatalf("Output is not JSON: %v\n%s", err, stdout.String())
+	}
+
+	if len(registry) != len(dialects) || registry[4].Wrapper != "cron(...)" || registry[0].Macros["@daily"] != "0 0 * * *" ||
+		!registry[5].Hash || registry[0].MinFields != numCronFields {
+		t.Errorf("Unexpected registry %+v", registry)
+	}
+
+	if err := runDialects([]string{"--output", "yaml"}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+
+	if err := runDialects([]string{"cobol"}, io.Discard, io.Discard); !errors.Is(err, ErrUnknownDialect) {
+		t.Errorf("Expected ErrUnknownDialect, got %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	defaultCardOutput = "card.png"                 // File the card is written to
+	cardWidth         = 960                        // Card width in pixels
+	cardPadding       = 32                         // Space around the card's contents
+	cardTextScale     = 2                          // Pixel size of body text glyphs
+	cardTitleScale    = 4                          // Pixel size of expression glyphs
+	cardNextRuns      = 5                          // Upcoming runs listed
+	cardTimelineTicks = 500                        // Most runs marked on the timeline
+	cardTimelineSize  = 24                         // Height of the timeline bar
+	cardTimeLayout    = "Mon 2006-01-02 15:04 MST" // Layout of the listed runs
+)
+
+//nolint:gochecknoglobals
+var (
+	cardBackground = color.RGBA{R: 0x1E, G: 0x1E, B: 0x2E, A: 0xFF} // Card background
+	cardTrack      = color.RGBA{R: 0x3A, G: 0x3A, B: 0x4E, A: 0xFF} // Timeline bar background
+	cardYellow     = color.RGBA{R: 0xFF, G: 0xFF, B: 0x00, A: 0xFF} // Expression, as colorYellow
+	cardWhite      = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF} // Description, as colorWhite
+	cardGray       = color.RGBA{R: 0x88, G: 0x88, B: 0x88, A: 0xFF} // Headings, as colorGray
+	cardCyan       = color.RGBA{R: 0x00, G: 0xFF, B: 0xFF, A: 0xFF} // Runs, as colorCyan
+
+	// Timeline spans tried in order; the first holding every listed run is used
+	cardSpans = []struct {
+		length time.Duration
+		label  string
+	}{
+		{24 * time.Hour, "next 24 hours"},
+		{7 * 24 * time.Hour, "next 7 days"},
+		{31 * 24 * time.Hour, "next 31 days"},
+		{366 * 24 * time.Hour, "next year"},
+	}
+)
+
+// cardText is one line of text on the card
+type cardText struct {
+	text  string      // Text to draw
+	color color.Color // Glyph color
+	scale int         // Pixel size of each font pixel
+}
+
+// cardLine returns a line of text drawn at the given scale
+func cardLine(text string, textColor color.Color, scale int) cardText {
+	return cardText{text: text, color: textColor, scale: scale}
+}
+
+// wrapCardText splits text into lines of at most width characters, breaking
+// between words
+func wrapCardText(text string, width int) []string {
+	var (
+		lines   []string
+		current string
+	)
+
+	for _, word := range strings.Fields(text) {
+		if current != "" && len(current)+1+len(word) > width {
+			lines = append(lines, current)
+			current = ""
+		}
+
+		if current != "" {
+			current += " "
+		}
+
+		current += word
+	}
+
+	if current != "" {
+		lines = append(lines, current)
+	}
+
+	return lines
+}
+
+// drawCardText draws a line of text with its top-left corner at x, y,
+// scaling the bitmap font up so it stays sharp
+func drawCardText(dst draw.Image, x, y int, line cardText) {
+	face := basicfont.Face7x13
+	glyphs := image.NewAlpha(image.Rect(0, 0, face.Advance*len(line.text), face.Height))
+
+	drawer := font.Drawer{Dst: glyphs, Src: image.Opaque, Face: face, Dot: fixed.P(0, face.Ascent)}
+	drawer.DrawString(line.text)
+
+	bounds := glyphs.Bounds()
+	for row := range bounds.Dy() {
+		for column := range bounds.Dx() {
+			if glyphs.AlphaAt(column, row).A == 0 {
+				continue
+			}
+
+			pixel := image.Rect(x+column*line.scale, y+row*line.scale, x+(column+1)*line.scale, y+(row+1)*line.scale)
+			draw.Draw(dst, pixel, image.NewUniform(line.color), image.Point{}, draw.Src)
+		}
+	}
+}
+
+// cardSpan picks the shortest timeline span holding every listed run
+func cardSpan(now time.Time, runs []time.Time) (time.Duration, string) {
+	for _, span := range cardSpans {
+		if len(runs) > 0 && runs[len(runs)-1].Sub(now) <= span.length {
+			return span.length, span.label
+		}
+	}
+
+	last := cardSpans[len(cardSpans)-1]
+
+	return last.length, last.label
+}
+
+// renderCard draws a shareable card with the expression, its description,
+// the next runs, and a timeline of the runs ahead
+func renderCard(job exportJob, now time.Time) (*image.RGBA, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	now = now.In(location)
+
+	result, err := explainSpec(cronSpec{fields: job.fields}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(job.fields, " "))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	runs := make([]time.Time, 0, cardNextRuns)
+	for next := schedule.Next(now); !next.IsZero() && len(runs) < cardNextRuns; next = schedule.Next(next) {
+		runs = append(runs, next)
+	}
+
+	span, spanLabel := cardSpan(now, runs)
+	lineHeight := basicfont.Face7x13.Height
+	textWidth := (cardWidth - 2*cardPadding) / (basicfont.Face7x13.Advance * cardTextScale)
+
+	lines := []cardText{
+		cardLine("crontab-guru", cardGray, cardTextScale),
+		cardLine(strings.Join(job.fields, " "), cardYellow, cardTitleScale),
+	}
+
+	for _, text := range wrapCardText(result.description, textWidth) {
+		lines = append(lines, cardLine(text, cardWhite, cardTextScale))
+	}
+
+	lines = append(lines, cardLine("", cardGray, cardTextScale), cardLine("NEXT RUNS ("+job.timezone+")", cardGray, cardTextScale))
+	for _, run := range runs {
+		lines = append(lines, cardLine(run.Format(cardTimeLayout), cardCyan, cardTextScale))
+	}
+
+	lines = append(lines, cardLine("", cardGray, cardTextScale), cardLine(strings.ToUpper(spanLabel), cardGray, cardTextScale))
+
+	height := 2*cardPadding + cardTimelineSize
+	for _, line := range lines {
+		height += lineHeight * line.scale
+	}
+
+	card := image.NewRGBA(image.Rect(0, 0, cardWidth, height))
+	draw.Draw(card, card.Bounds(), image.NewUniform(cardBackground), image.Point{}, draw.Src)
+
+	y := cardPadding
+	for _, line := range lines {
+		drawCardText(card, cardPadding, y, line)
+		y += lineHeight * line.scale
+	}
+
+	// Mark each run in the span on a bar running from now to the span's end
+	track := image.Rect(cardPadding, y, cardWidth-cardPadding, y+cardTimelineSize)
+	draw.Draw(card, track, image.NewUniform(cardTrack), image.Point{}, draw.Src)
+
+	end := now.Add(span)
+	ticks := 0
+
+	for next := schedule.Next(now); !next.IsZero() && !next.After(end) && ticks < cardTimelineTicks; next = schedule.Next(next) {
+		x := track.Min.X + int(float64(track.Dx()-2)*float64(next.Sub(now))/float64(span))
+		draw.Draw(card, image.Rect(x, track.Min.Y, x+2, track.Max.Y), image.NewUniform(cardCyan), image.Point{}, draw.Src)
+		ticks++
+	}
+
+	return card, nil
+}
+
+// runCard renders a schedule card as a PNG file, or to stdout with -o -
+func runCard(args []string, stdout, stderr io.Writer) error {
+	var output, timezone string
+
+	flags := flag.NewFlagSet("card", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&output, "o", defaultCardOutput, "PNG file to write, or - for stdout")
+	flags.StringVar(&timezone, "timezone", defaultExportZone, "IANA time zone the schedule is read in")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru card [-o FILE] [--timezone ZONE] EXPRESSION", ErrUsage)
+	}
+
+	job, err := newExportJob(strings.Join(positional, " "), defaultExportName, defaultExportCommand, timezone)
+	if err != nil {
+		return err
+	}
+
+	card, err := renderCard(job, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if output == "-" {
+		if err := png.Encode(stdout, card); err != nil {
+			return fmt.Errorf("failed to encode card: %w", err)
+		}
+
+		return nil
+	}
+
+	file, err := os.Create(output) //nolint:gosec // Writing to the path the user names is the point
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	if err := png.Encode(file, card); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to encode card: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	fmt.Fprintf(stdout, "wrote %s\n", output)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestWrapCardText verifies that text is broken between words at the width.
+func TestWrapCardText(t *testing.T) {
+	t.Parallel()
+
+	got := wrapCardText("At 02:30 AM, Monday through Friday", 16)
+	expected := []string{"At 02:30 AM,", "Monday through", "Friday"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("wrapCardText = %q, expected %q", got, expected)
+	}
+}
+
+// TestCardSpan verifies that the timeline covers the listed runs with the
+// shortest span.
+func TestCardSpan(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		last     time.Duration
+		expected string
+	}{
+		{time.Hour, "next 24 hours"},
+		{5 * 24 * time.Hour, "next 7 days"},
+		{20 * 24 * time.Hour, "next 31 days"},
+		{4 * 365 * 24 * time.Hour, "next year"},
+	}
+
+	for _, tt := range tests {
+		if _, label := cardSpan(now, []time.Time{now.Add(tt.last)}); label != tt.expected {
+			t.Errorf("cardSpan(%v) = %q, expected %q", tt.last, label, tt.expected)
+		}
+	}
+}
+
+// TestRenderCard verifies the card size and that the expression is drawn in
+// the editor's highlight color.
+func TestRenderCard(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "job", "run.sh", "UTC")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	card, err := renderCard(job, time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if card.Bounds().Dx() != cardWidth || card.Bounds().Dy() <= 2*cardPadding {
+		t.Errorf("Unexpected card size %v", card.Bounds())
+	}
+
+	yellow := 0
+
+	for y := card.Bounds().Min.Y; y < card.Bounds().Max.Y; y++ {
+		for x := card.Bounds().Min.X; x < card.Bounds().Max.X; x++ {
+			if card.RGBAAt(x, y) == cardYellow {
+				yellow++
+			}
+		}
+	}
+
+	if yellow == 0 {
+		t.Error("Expected the expression to be drawn in yellow")
+	}
+}
+
+// TestRunCard verifies that the card is written as a PNG file or to stdout.
+func TestRunCard(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "card.png")
+
+	var stdout bytes.Buffer
+	if err := runCard([]string{"-o", path, "0 9 * * MON"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	if _, err := png.Decode(file); err != nil {
+		t.Errorf("Expected a PNG file, got %v", err)
+	}
+
+	stdout.Reset()
+
+	if err := runCard([]string{"0 9 * * MON", "-o", "-"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := png.Decode(&stdout); err != nil {
+		t.Errorf("Expected a PNG on stdout, got %v", err)
+	}
+}
+
+// TestRunCardErrors verifies the usage and expression errors.
+func TestRunCardErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{nil, ErrUsage},
+		{[]string{"-o", "-", "61 * * * *"}, ErrInvalidValue},
+		{[]string{"-o", "-", "--timezone", "Mars/Base", "0 9 * * *"}, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		if err := runCard(tt.args, io.Discard, io.Discard); !errors.Is(err, tt.expected) {
+			t.Errorf("runCard(%q) = %v, expected %v", tt.args, err, tt.expected)
+		}
+	}
+}
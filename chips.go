@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	chipWidth = 5 // Columns of one chip, including its padding
+	chipGap   = 1 // Columns between neighbouring chips
+)
+
+// chipState is how a chip is drawn. Besides on and off, a field left at "*"
+// shows every chip as implied so "any" stays distinct from "all selected".
+type chipState int
+
+const (
+	chipAny chipState = iota // The field is "*", so every value is implied
+	chipOn                   // The value is selected
+	chipOff                  // The value is not selected
+)
+
+//nolint:gochecknoglobals
+var (
+	// Values in chip order: weekdays run Monday to Sunday, months January to December
+	weekdayChips = []int{1, 2, 3, 4, 5, 6, 0}
+	monthChips   = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+
+	// Keys that flip each chip, in chip order; months continue along the number row
+	chipKeys = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "-", "="}
+
+	chipStyles = map[chipState]lipgloss.Style{
+		chipAny: lipgloss.NewStyle().Foreground(colorLightGray),
+		chipOn:  lipgloss.NewStyle().Foreground(lipgloss.Color("#000000")).Background(colorYellow).Bold(true),
+		chipOff: lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")),
+	}
+)
+
+// chipValues returns the values of a field's chips in display order, or nil
+// for fields without chips
+func chipValues(fieldIndex int) []int {
+	switch fieldIndex {
+	case fieldIndexWeekday:
+		return weekdayChips
+	case fieldIndexMonth:
+		return monthChips
+	default:
+		return nil
+	}
+}
+
+// chipLabel returns the title-case abbreviation of a month or weekday value
+func chipLabel(fieldIndex int, value int) string {
+	var name string
+
+	if fieldIndex == fieldIndexMonth {
+		name = monthNames[value-1]
+	} else {
+		name = weekdayNames[value]
+	}
+
+	return name[:1] + strings.ToLower(name[1:])
+}
+
+// chipsActive reports whether the chips are shown for the focused field
+func (m *model) chipsActive() bool {
+	return m.showChips && !m.rawMode && chipValues(m.focusIndex) != nil
+}
+
+// chipStates returns the state of each chip of the focused field. It reports
+// false when the value cannot be expanded, such as one using H or Quartz syntax.
+func (m *model) chipStates() ([]chipState, bool) {
+	value := m.inputs[m.focusIndex].Value()
+	values := chipValues(m.focusIndex)
+	states := make([]chipState, len(values))
+
+	if value == "" || value == "*" {
+		return states, true
+	}
+
+	set, err := expandField(value, m.focusIndex)
+	if err != nil {
+		return nil, false
+	}
+
+	for index, chip := range values {
+		states[index] = chipOff
+		if set.Has(chip) {
+			states[index] = chipOn
+		}
+	}
+
+	return states, true
+}
+
+// toggleChip flips the chip at position in the focused field and rewrites the
+// field as the shortest list or range. Flipping a chip while the field is "*"
+// selects only that value; selecting none or every value goes back to "*".
+func (m *model) toggleChip(position int) tea.Cmd {
+	values := chipValues(m.focusIndex)
+	if position < 0 || position >= len(values) {
+		return nil
+	}
+
+	value := m.inputs[m.focusIndex].Value()
+	bit := fieldSet(1) << uint(values[position])
+
+	var set fieldSet
+
+	if value != "" && value != "*" {
+		expanded, err := expandField(value, m.focusIndex)
+		if err != nil {
+			return nil
+		}
+
+		set = expanded ^ bit
+	} else {
+		set = bit
+	}
+
+	composed := set.compactString()
+	if set == 0 || set == fullFieldSet(m.focusIndex) {
+		composed = "*"
+	}
+
+	m.inputs[m.focusIndex].SetValue(composed)
+	m.inputs[m.focusIndex].CursorEnd()
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
+
+// handleChipKey flips a chip when its number-row key is pressed, reporting
+// whether the key was consumed
+func (m *model) handleChipKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if !m.chipsActive() {
+		return nil, false
+	}
+
+	for position := range chipValues(m.focusIndex) {
+		if msg.String() == chipKeys[position] {
+			return m.toggleChip(position), true
+		}
+	}
+
+	return nil, false
+}
+
+// chipAt returns the position of the chip covering the given column of the
+// chip row, or -1 when the column falls between chips or outside the row
+func (m *model) chipAt(column int) int {
+	if column < 0 || column%(chipWidth+chipGap) >= chipWidth {
+		return -1
+	}
+
+	position := column / (chipWidth + chipGap)
+	if position >= len(chipValues(m.focusIndex)) {
+		return -1
+	}
+
+	return position
+}
+
+// renderChips draws the toggle chips for the focused weekday or month field
+// with the key that flips each chip underneath
+func (m *model) renderChips() string {
+	if !m.chipsActive() {
+		return ""
+	}
+
+	states, ok := m.chipStates()
+	if !ok {
+		note := labelStyle.Render("Chips need a plain list or range; clear the field to use them")
+
+		return m.place(note) + "\n"
+	}
+
+	values := chipValues(m.focusIndex)
+	chips := make([]string, 0, len(values))
+	keys := make([]string, 0, len(values))
+	cell := lipgloss.NewStyle().Width(chipWidth).Align(lipgloss.Center)
+
+	for position, value := range values {
+		chips = append(chips, chipStyles[states[position]].Render(cell.Render(chipLabel(m.focusIndex, value))))
+		keys = append(keys, labelStyle.Render(cell.Render(chipKeys[position])))
+	}
+
+	gap := strings.Repeat(" ", chipGap)
+	row := strings.Join(chips, gap)
+
+	m.chipsCol = 0
+	if !m.compactLayout() {
+		m.chipsCol = max(0, (m.width-lipgloss.Width(row))/2)
+	}
+
+	return m.place(row) + "\n" + m.place(strings.Join(keys, gap)) + "\n"
+}
+
+// renderPlainChips lists the chips of the focused field for the plain view
+func (m *model) renderPlainChips() string {
+	states, ok := m.chipStates()
+	if !ok {
+		return "chips: unavailable for this value\n"
+	}
+
+	values := chipValues(m.focusIndex)
+	items := make([]string, 0, len(values))
+	marks := map[chipState]string{chipAny: "any", chipOn: "on", chipOff: "off"}
+
+	for position, value := range values {
+		items = append(items, chipKeys[position]+" "+chipLabel(m.focusIndex, value)+" "+marks[states[position]])
+	}
+
+	return "chips: " + strings.Join(items, ", ") + "\n"
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// chipsModel returns a model with the chips shown and the given field focused
+func chipsModel(t *testing.T, fieldIndex int) *model {
+	t.Helper()
+
+	m := initialModel()
+	m.width = 100
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	m = assertModelType(t, newModel)
+	m.setFocus(fieldIndex)
+
+	return m
+}
+
+// TestChipKeysComposeMinimalList verifies that number keys flip weekday chips
+// and the field is rewritten as the shortest list or range.
+func TestChipKeysComposeMinimalList(t *testing.T) {
+	t.Parallel()
+
+	m := chipsModel(t, fieldIndexWeekday)
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"1", "1"},     // From "*" a chip selects only its value
+		{"2", "1,2"},   // Two values stay a list
+		{"3", "1-3"},   // Three consecutive values become a range
+		{"5", "1-3,5"}, // Gaps split the range
+		{"4", "1-5"},   // Filling the gap merges it
+		{"7", "0-5"},   // Sunday is value 0
+		{"6", "*"},     // Every day selected is "*"
+		{"3", "3"},     // Back at "*", a chip again selects only its value
+		{"4", "3,4"},
+	}
+
+	for _, tt := range tests {
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+		m = assertModelType(t, newModel)
+
+		if got := m.inputs[fieldIndexWeekday].Value(); got != tt.expected {
+			t.Errorf("After key %q expected %q, got %q", tt.key, tt.expected, got)
+		}
+	}
+
+	if got := m.rawInput.Value(); got != "20 4 * * 3,4" {
+		t.Errorf("Expected the raw expression to follow the chips, got %q", got)
+	}
+}
+
+// TestChipKeysMonths verifies the number-row keys past 9 flip the last months
+// and clearing the only selected month returns the field to "*".
+func TestChipKeysMonths(t *testing.T) {
+	t.Parallel()
+
+	m := chipsModel(t, fieldIndexMonth)
+
+	for _, key := range []string{"0", "-", "="} {
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = assertModelType(t, newModel)
+	}
+
+	if got := m.inputs[fieldIndexMonth].Value(); got != "10-12" {
+		t.Errorf("Expected 10-12, got %q", got)
+	}
+
+	m.inputs[fieldIndexMonth].SetValue("JAN")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m = assertModelType(t, newModel)
+
+	if got := m.inputs[fieldIndexMonth].Value(); got != "*" {
+		t.Errorf("Expected deselecting the only month to give *, got %q", got)
+	}
+}
+
+// TestChipsOnlyForWeekdayAndMonth verifies that digits are typed normally in
+// other fields and when the chips are hidden.
+func TestChipsOnlyForWeekdayAndMonth(t *testing.T) {
+	t.Parallel()
+
+	m := chipsModel(t, 1)
+	m.inputs[1].SetValue("")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m = assertModelType(t, newModel)
+
+	if got := m.inputs[1].Value(); got != "1" {
+		t.Errorf("Expected the hour field to receive the digit, got %q", got)
+	}
+
+	if m.renderChips() != "" {
+		t.Error("Expected no chips for the hour field")
+	}
+
+	m.showChips = false
+	m.setFocus(fieldIndexWeekday)
+	m.inputs[fieldIndexWeekday].SetValue("")
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m = assertModelType(t, newModel)
+
+	if got := m.inputs[fieldIndexWeekday].Value(); got != "1" {
+		t.Errorf("Expected hidden chips to leave typing alone, got %q", got)
+	}
+}
+
+// TestChipStates verifies the tri-state rendering: implied for "*", on and
+// off for a list, and unavailable for values the chips cannot show.
+func TestChipStates(t *testing.T) {
+	t.Parallel()
+
+	m := chipsModel(t, fieldIndexWeekday)
+
+	states, ok := m.chipStates()
+	if !ok || states[0] != chipAny || states[6] != chipAny {
+		t.Errorf("Expected every chip implied for *, got %v", states)
+	}
+
+	m.inputs[fieldIndexWeekday].SetValue("MON-FRI")
+
+	states, _ = m.chipStates()
+	if states[0] != chipOn || states[4] != chipOn || states[5] != chipOff || states[6] != chipOff {
+		t.Errorf("Expected Mon-Fri on and the weekend off, got %v", states)
+	}
+
+	if got := m.renderPlainChips(); !strings.Contains(got, "1 Mon on") || !strings.Contains(got, "7 Sun off") {
+		t.Errorf("Expected plain chips to list each state, got %q", got)
+	}
+
+	m.inputs[fieldIndexWeekday].SetValue("H")
+
+	if _, ok := m.chipStates(); ok {
+		t.Error("Expected H to be unavailable for chips")
+	}
+
+	if !strings.Contains(m.renderChips(), "plain list") {
+		t.Error("Expected a note when the chips cannot show the value")
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m = assertModelType(t, newModel)
+
+	if got := m.inputs[fieldIndexWeekday].Value(); got != "H" {
+		t.Errorf("Expected the value to be left alone, got %q", got)
+	}
+}
+
+// TestChipClick verifies that clicking a chip flips it and clicks between
+// chips are ignored.
+func TestChipClick(t *testing.T) {
+	t.Parallel()
+
+	m := chipsModel(t, fieldIndexWeekday)
+	m.View()
+
+	if got := m.chipAt(chipWidth); got != -1 {
+		t.Errorf("Expected the gap after the first chip to be -1, got %d", got)
+	}
+
+	click := tea.MouseMsg{
+		X:      m.chipsCol + 2*(chipWidth+chipGap) + 1,
+		Y:      m.chipsRow,
+		Action: tea.MouseActionPress,
+		Button: tea.MouseButtonLeft,
+	}
+
+	newModel, _ := m.Update(click)
+	m = assertModelType(t, newModel)
+
+	if got := m.inputs[fieldIndexWeekday].Value(); got != "3" {
+		t.Errorf("Expected a click on Wed to select 3, got %q", got)
+	}
+
+	if m.focusIndex != fieldIndexWeekday {
+		t.Errorf("Expected focus to stay on the weekday field, got %d", m.focusIndex)
+	}
+}
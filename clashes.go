@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultClashWindow = 5 * time.Minute        // Runs this close together are reported as a clash
+	defaultClashDays   = 7                      // Days ahead checked for clashes
+	maxClashRuns       = 7 * 24 * 60            // Most runs sampled per job, a week of every minute
+	maxClashNameLength = 40                     // Longest job name shown before it is shortened
+	clashTimeLayout    = "Mon 2006-01-02 15:04" // Layout of the first clash time
+)
+
+// ErrClashes is returned when clashes finds jobs running close together
+var ErrClashes = errors.New("jobs run close together") //nolint:gochecknoglobals
+
+// clashJob is one schedule checked for clashes
+type clashJob struct {
+	name   string // Shown in the report, e.g. the command or "tab 2"
+	expr   string // Five-field expression with H tokens already resolved
+	source int    // Index of the crontab line or tab the job was read from
+}
+
+// clash is a set of jobs that run within the window of each other
+type clash struct {
+	jobs  []int     // Indexes of the jobs that run together, in job order
+	first time.Time // Earliest time they run together
+	count int       // Times they run together within the period checked
+}
+
+// clashRun is one run of a job in the period checked
+type clashRun struct {
+	at  time.Time // When the job runs
+	job int       // Index of the job
+}
+
+// findClashes reports sets of jobs whose runs fall within window of each
+// other between now and until, such as three backups all at 02:00. Each set
+// is reported once with the first time and how often it happens.
+func findClashes(jobs []clashJob, now, until time.Time, window time.Duration) ([]clash, error) {
+	var runs []clashRun
+
+	for index, job := range jobs {
+		schedule, err := standardParser.Parse(job.expr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrCronParse, job.name, err)
+		}
+
+		sampled := 0
+
+		for next := range occurrences(context.Background(), schedule, now) {
+			if !next.Before(until) || sampled == maxClashRuns {
+				break
+			}
+
+			runs = append(runs, clashRun{at: next, job: index})
+			sampled++
+		}
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].at.Before(runs[j].at) })
+
+	var clashes []clash
+
+	found := make(map[string]int)
+
+	for start := 0; start < len(runs); {
+		end := start + 1
+		for end < len(runs) && runs[end].at.Sub(runs[start].at) <= window {
+			end++
+		}
+
+		together := make(map[int]bool)
+		for _, run := range runs[start:end] {
+			together[run.job] = true
+		}
+
+		if len(together) < 2 {
+			start++
+
+			continue
+		}
+
+		indexes := make([]int, 0, len(together))
+		for job := range together {
+			indexes = append(indexes, job)
+		}
+
+		sort.Ints(indexes)
+
+		key := fmt.Sprint(indexes)
+		if position, ok := found[key]; ok {
+			clashes[position].count++
+		} else {
+			found[key] = len(clashes)
+			clashes = append(clashes, clash{jobs: indexes, first: runs[start].at, count: 1})
+		}
+
+		start = end
+	}
+
+	return clashes, nil
+}
+
+// describeClash explains a clash, e.g. "02:00: a, b run within 5m, 7 times (first Mon 2025-03-10 02:00)"
+func describeClash(found clash, jobs []clashJob, window time.Duration) string {
+	names := make([]string, 0, len(found.jobs))
+	for _, index := range found.jobs {
+		names = append(names, jobs[index].name)
+	}
+
+	together := "run within " + formatGap(window)
+	if window < time.Minute {
+		together = "run at the same time"
+	}
+
+	times := "once"
+	if found.count > 1 {
+		times = fmt.Sprintf("%d times", found.count)
+	}
+
+	return fmt.Sprintf("%s: %s %s, %s (first %s)", found.first.Format("15:04"), strings.Join(names, ", "),
+		together, times, found.first.Format(clashTimeLayout))
+}
+
+// crontabJobs reads the jobs of a crontab, naming each after its command.
+// Comments, blank lines, variable assignments, and @reboot are skipped.
+func crontabJobs(text string) ([]clashJob, error) {
+	var jobs []clashJob
+
+	for index, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "@reboot") {
+			continue
+		}
+
+		tokens := strings.Fields(trimmed)
+		if isEnvAssignment(tokens[0]) {
+			continue
+		}
+
+		count := numCronFields
+		if strings.HasPrefix(tokens[0], "@") {
+			count = 1
+		}
+
+		if len(tokens) <= count {
+			return nil, fmt.Errorf("line %d: %w: expected a schedule followed by a command", index+1, ErrFieldCount)
+		}
+
+		fields, err := splitRawExpression(strings.Join(tokens[:count], " "))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", index+1, err)
+		}
+
+		if err := validateStandardFields(fields); err != nil {
+			return nil, fmt.Errorf("line %d: %w", index+1, err)
+		}
+
+		name := strings.Join(tokens[count:], " ")
+		if len(name) > maxClashNameLength {
+			name = name[:maxClashNameLength-3] + "..."
+		}
+
+		jobs = append(jobs, clashJob{name: name, expr: strings.Join(fields, " "), source: index})
+	}
+
+	return jobs, nil
+}
+
+// readCrontab reads a crontab file, or stdin when path is "-"
+func readCrontab(path string) (string, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path) //nolint:gosec // Reading the crontab the user names is the point
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return string(data), nil
+}
+
+// tabClashes returns the clashes between the open tabs in the coming week.
+// Tabs whose expressions do not parse are left out.
+func (m *model) tabClashes(now time.Time) ([]clash, []clashJob) {
+	var jobs []clashJob
+
+	for index, expr := range m.tabExpressions() {
+		resolved, err := m.resolveTabExpression(expr)
+		if err != nil || validateStandardFields(strings.Fields(resolved)) != nil {
+			continue
+		}
+
+		jobs = append(jobs, clashJob{name: fmt.Sprintf("tab %d", index+1), expr: resolved, source: index})
+	}
+
+	clashes, err := findClashes(jobs, now, now.AddDate(0, 0, defaultClashDays), m.clashWindow)
+	if err != nil {
+		return nil, nil
+	}
+
+	return clashes, jobs
+}
+
+// renderClashes warns about tabs that run close together
+func (m *model) renderClashes() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+
+	clashes, jobs := m.tabClashes(time.Now())
+	if len(clashes) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(clashes))
+	for _, found := range clashes {
+		lines = append(lines, conflictStyle.Render("clash "+describeClash(found, jobs, m.clashWindow)))
+	}
+
+	lines = append(lines, labelStyle.Render("alt+s to stagger"))
+
+	return m.place(strings.Join(lines, "\n")) + "\n"
+}
+
+// runClashes reports the jobs of a crontab that run close together
+func runClashes(args []string, stdout, stderr io.Writer) error {
+	var (
+		window   time.Duration
+		days     int
+		timezone string
+	)
+
+	flags := flag.NewFlagSet("clashes", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.DurationVar(&window, "window", defaultClashWindow, "runs this close together clash")
+	flags.IntVar(&days, "days", defaultClashDays, "days ahead to check")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the crontab is read in")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) != 1 {
+		return fmt.Errorf("%w: crontab-guru clashes [--window 5m] [--days 7] CRONTAB", ErrUsage)
+	}
+
+	if window < 0 || days < 1 {
+		return fmt.Errorf("%w: --window must not be negative and --days must be at least 1", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	text, err := readCrontab(paths[0])
+	if err != nil {
+		return err
+	}
+
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", paths[0], err)
+	}
+
+	now := time.Now().In(location)
+
+	clashes, err := findClashes(jobs, now, now.AddDate(0, 0, days), window)
+	if err != nil {
+		return err
+	}
+
+	if len(clashes) == 0 {
+		fmt.Fprintf(stdout, "no jobs run within %s of each other in the next %d days\n", window, days)
+
+		return nil
+	}
+
+	for _, found := range clashes {
+		fmt.Fprintln(stdout, describeClash(found, jobs, window))
+	}
+
+	return fmt.Errorf("%w: %d sets of jobs", ErrClashes, len(clashes))
+}
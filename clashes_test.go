@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestFindClashes verifies that jobs running within the window are grouped,
+// that each set of jobs is reported once with a count, and that jobs just
+// outside the window do not clash.
+func TestFindClashes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+	jobs := []clashJob{
+		{name: "backup-db", expr: "0 2 * * *"},
+		{name: "backup-files", expr: "3 2 * * *"},
+		{name: "backup-logs", expr: "5 2 * * 0"},
+		{name: "rotate", expr: "10 2 * * *"},
+	}
+
+	clashes, err := findClashes(jobs, now, now.AddDate(0, 0, 7), 5*time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(clashes) != 2 {
+		t.Fatalf("Expected 2 sets of clashing jobs, got %+v", clashes)
+	}
+
+	daily, sunday := clashes[0], clashes[1]
+
+	if len(daily.jobs) != 2 || daily.count != 6 || !daily.first.Equal(time.Date(2025, time.March, 8, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected daily clash %+v", daily)
+	}
+
+	if len(sunday.jobs) != 3 || sunday.count != 1 || sunday.first.Weekday() != time.Sunday {
+		t.Errorf("Unexpected Sunday clash %+v", sunday)
+	}
+
+	expected := "02:00: backup-db, backup-files run within 5m, 6 times (first Sat 2025-03-08 02:00)"
+	if got := describeClash(daily, jobs, 5*time.Minute); got != expected {
+		t.Errorf("describeClash = %q, expected %q", got, expected)
+	}
+
+	if clashes, _ := findClashes(jobs, now, now.AddDate(0, 0, 7), 0); len(clashes) != 0 {
+		t.Errorf("Expected no clashes with a zero window, got %+v", clashes)
+	}
+}
+
+// TestCrontabJobs verifies that schedules and commands are read from a
+// crontab, skipping comments, variables, and @reboot.
+func TestCrontabJobs(t *testing.T) {
+	t.Parallel()
+
+	jobs, err := crontabJobs("MAILTO=ops\n# nightly\n\n0 2 * * * backup.sh --full\n@hourly ping.sh\n@reboot start.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(jobs) != 2 || jobs[0] != (clashJob{name: "backup.sh --full", expr: "0 2 * * *", source: 3}) ||
+		jobs[1] != (clashJob{name: "ping.sh", expr: "0 * * * *", source: 4}) {
+		t.Errorf("Unexpected jobs %+v", jobs)
+	}
+
+	if _, err := crontabJobs("0 2 * * *\n"); !errors.Is(err, ErrFieldCount) || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("Expected a missing command error on line 1, got %v", err)
+	}
+
+	if _, err := crontabJobs("# ok\n61 2 * * * backup.sh\n"); !errors.Is(err, ErrInvalidValue) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected an invalid value error on line 2, got %v", err)
+	}
+}
+
+// TestRunClashes verifies the report and exit error for a crontab with
+// clashing jobs, and the message when there are none.
+func TestRunClashes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	clashing := filepath.Join(dir, "clashing")
+	quiet := filepath.Join(dir, "quiet")
+
+	if err := os.WriteFile(clashing, []byte("0 2 * * * a.sh\n0 2 * * * b.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(quiet, []byte("0 2 * * * a.sh\n0 4 * * * b.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout bytes.Buffer
+
+	err := runClashes([]string{"--timezone", "UTC", clashing}, &stdout, io.Discard)
+	if !errors.Is(err, ErrClashes) || !strings.Contains(stdout.String(), "a.sh, b.sh run within 5m, 7 times") {
+		t.Errorf("Expected a daily clash, got %v:\n%s", err, stdout.String())
+	}
+
+	stdout.Reset()
+
+	err = runClashes([]string{quiet, "--window", "1h"}, &stdout, io.Discard)
+	if err != nil || stdout.String() != "no jobs run within 1h0m0s of each other in the next 7 days\n" {
+		t.Errorf("Expected no clashes, got %v: %q", err, stdout.String())
+	}
+
+	for _, args := range [][]string{nil, {"--days", "0", quiet}, {"--window", "-1m", quiet}} {
+		if err := runClashes(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runClashes(%q) expected ErrUsage, got %v", args, err)
+		}
+	}
+}
+
+// TestTabClashes verifies that tabs running at the same time are flagged
+// under the merged timeline.
+func TestTabClashes(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.tabs = []string{"0 2 * * *", "2 2 * * *", "0 4 * * *"}
+	m.setExpression("0 2 * * *")
+
+	clashes, jobs := m.tabClashes(time.Now())
+	if len(clashes) != 1 || len(clashes[0].jobs) != 2 || jobs[clashes[0].jobs[1]].name != "tab 2" {
+		t.Errorf("Expected tabs 1 and 2 to clash, got %+v", clashes)
+	}
+
+	if !strings.Contains(m.renderClashes(), "clash 02:00: tab 1, tab 2 run within 5m") {
+		t.Errorf("Expected the clash in the view, got %q", m.renderClashes())
+	}
+
+	m.clashWindow = time.Minute
+	if m.renderClashes() != "" {
+		t.Error("Expected no clash with a one minute window")
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrUsage is returned when a subcommand is invoked with the wrong arguments
+var ErrUsage = errors.New("usage") //nolint:gochecknoglobals
+
+// ErrHelp is returned when -h or --help asked for the usage, which the flag
+// set has already printed, so the program exits successfully
+var ErrHelp = errors.New("help requested") //nolint:gochecknoglobals
+
+// command is a non-interactive subcommand such as "convert"
+type command struct {
+	name    string                                              // Name typed after the program name
+	usage   string                                              // Argument synopsis shown in help
+	summary string                                              // One-line description shown in help
+	run     func(args []string, stdout, stderr io.Writer) error // Runs the command with the remaining arguments
+}
+
+// commands returns the available subcommands in the order help lists them
+func commands() []command {
+	return []command{
+		{
+			name:    "card",
+			usage:   "[-o FILE] [--timezone ZONE] EXPRESSION",
+			summary: "render a PNG card with the description, next runs, and a timeline",
+			run:     runCard,
+		},
+		{
+			name:    "clashes",
+			usage:   "[--window 5m] [--days 7] [--timezone ZONE] CRONTAB",
+			summary: "report crontab jobs that run within a window of each other",
+			run:     runClashes,
+		},
+		{
+			name:    "convert",
+			usage:   "--from DIALECT --to DIALECT [--seed NAME] EXPRESSION",
+			summary: "convert an expression between cron dialects",
+			run:     runConvert,
+		},
+		{
+			name:    "count",
+			usage:   "[--from TIME] --to TIME [--list] [--dialect DIALECT] [--timezone ZONE] EXPRESSION",
+			summary: "print how many times a schedule runs in a range, or with --list every run",
+			run:     runCount,
+		},
+		{
+			name:    "dialects",
+			usage:   "[--output text|json] [DIALECT...]",
+			summary: "list the fields, ranges, tokens, and semantics each dialect accepts",
+			run:     runDialects,
+		},
+		{
+			name:    "dst",
+			usage:   "[--timezone ZONE] EXPRESSION",
+			summary: "list runs around the next daylight saving change in local and UTC time",
+			run:     runDST,
+		},
+		{
+			name:    "edit",
+			usage:   "[--user USER] [--host USER@HOST] [FILE]",
+			summary: "browse and edit the jobs of your crontab, another user's through sudo, a host's through ssh, or a file",
+			run:     runEdit,
+		},
+		{
+			name:    "explain",
+			usage:   "[--dialect DIALECT] [--seed NAME] [--quiet] EXPRESSION",
+			summary: "describe an expression and show its next run, or only describe it with --quiet",
+			run:     runExplain,
+		},
+		{
+			name:    "export",
+			usage:   "--format FORMAT [--name NAME] [--command CMD] [--timezone ZONE] EXPRESSION",
+			summary: "render an expression for another scheduler",
+			run:     runExport,
+		},
+		{
+			name:    "export-workspace",
+			usage:   "[--workspace FILE] [--format csv|crontab] [--columns LIST]",
+			summary: "write workspace entries as CSV for spreadsheets or as a crontab with a comment per heading",
+			run:     runExportWorkspace,
+		},
+		{
+			name:    "histogram",
+			usage:   "[--week] [--timezone ZONE] CRONTAB",
+			summary: "chart how many crontab jobs run in each hour of the day or week",
+			run:     runHistogram,
+		},
+		{
+			name:    "history",
+			usage:   "[--user USER] [--host USER@HOST] [FILE]",
+			summary: "show every save of a crontab committed to the history repository, with its diff",
+			run:     runHistory,
+		},
+		{
+			name:    "ics",
+			usage:   "[--count N] [--duration D] [--name NAME] [--timezone ZONE] EXPRESSION",
+			summary: "write upcoming runs as an iCalendar file",
+			run:     runICS,
+		},
+		{
+			name:    "import",
+			usage:   "[--workspace FILE] [--dry-run] FILE.csv",
+			summary: "import jobs from a CSV with name, schedule, command, timezone, and owner columns",
+			run:     runImport,
+		},
+		{
+			name:    "k8s",
+			usage:   "import [--namespace NS] [MANIFEST]",
+			summary: "edit the schedules of Kubernetes CronJobs from kubectl or a manifest and print the patched manifest",
+			run:     runK8s,
+		},
+		{
+			name:    "lint",
+			usage:   "[--fix] [--output text|json|sarif] FILE...",
+			summary: "check schedules in crontab, YAML, and workspace files and fix them like gofmt",
+			run:     runLint,
+		},
+		{
+			name:    "logs",
+			usage:   "[--log FILE | --journal] [--since 24h] [--user USER] [--timezone ZONE] CRONTAB",
+			summary: "compare the runs cron logged with the ones a crontab schedules and list missed and unexpected runs",
+			run:     runLogs,
+		},
+		{
+			name:    "markdown",
+			usage:   "[--runs N] [--name NAME] [--timezone ZONE] EXPRESSION",
+			summary: "print a Markdown snippet with the description and next runs",
+			run:     runMarkdown,
+		},
+		{
+			name:    "match",
+			usage:   "[--at TIME] [--dialect DIALECT] [--timezone ZONE] EXPRESSION",
+			summary: "exit 0 when a time, now by default, falls in a minute the schedule runs, and 1 otherwise",
+			run:     runMatch,
+		},
+		{
+			name:    "mcp",
+			usage:   "[--timezone ZONE]",
+			summary: "offer describe, next, validate, and convert as Model Context Protocol tools on stdin and stdout",
+			run:     runMCP,
+		},
+		{
+			name:    "next",
+			usage:   "[--count N] [--format unix|rfc3339|relative|csv|LAYOUT] [--until TIME] [--tz-list ZONES] [--dialect DIALECT] [--timezone ZONE] EXPRESSION",
+			summary: "print the next runs of an expression, one per line, as timestamps scripts can use",
+			run:     runNext,
+		},
+		{
+			name:    "pick",
+			usage:   "[EXPRESSION]",
+			summary: "pick an expression in a minimal editor on stderr and print it, for $(crontab-guru pick) in scripts",
+			run:     runPick,
+		},
+		{
+			name:    "prev",
+			usage:   "[--count N] [--format unix|rfc3339|relative|csv|LAYOUT] [--dialect DIALECT] [--timezone ZONE] EXPRESSION",
+			summary: "print the most recent past runs of an expression, newest first, one per line",
+			run:     runPrev,
+		},
+		{
+			name:    "quiz",
+			usage:   "[--rounds N]",
+			summary: "practice reading cron: pick the description of a random expression, or the expression of a description",
+			run:     runQuiz,
+		},
+		{
+			name:    "restore",
+			usage:   "[--user USER] [--host USER@HOST] [FILE]",
+			summary: "pick a backup taken before a crontab was written, preview it, and put it back",
+			run:     runRestore,
+		},
+		{
+			name:    "serve",
+			usage:   "[--listen :8080] [--timezone ZONE]",
+			summary: "answer describe, next, and validate requests with JSON over HTTP",
+			run:     runServe,
+		},
+		{
+			name:    "stagger",
+			usage:   "[--window 5m] [--days 7] [--jitter] [--timezone ZONE] CRONTAB",
+			summary: "print a crontab with clashing jobs moved apart or delayed at random",
+			run:     runStagger,
+		},
+		{
+			name:    "systemd",
+			usage:   "[--user] [--crontab] [TIMER...]",
+			summary: "list systemd timers with cron equivalents of their OnCalendar= settings, or write them as a crontab",
+			run:     runSystemd,
+		},
+		{
+			name:    "tonight",
+			usage:   "[--from TIME] [--to TIME] [--workspace FILE] [--timezone ZONE] [CRONTAB]",
+			summary: "summarize the jobs that run in a window, 12 hours from now by default, with owners and risk badges for a handoff",
+			run:     runTonight,
+		},
+		{
+			name:    "watch",
+			usage:   "[--user USER] [--host USER@HOST] [--timezone ZONE] [CRONTAB]",
+			summary: "show a crontab's jobs with their last and next runs and a live countdown",
+			run:     runWatch,
+		},
+		{
+			name:    "window",
+			usage:   "--duration DURATION [--at TIME] [--dialect DIALECT] [--timezone ZONE] EXPRESSION",
+			summary: "exit 0 when a time, now by default, falls in a window that opens on each run and lasts the duration",
+			run:     runWindow,
+		},
+		{
+			name:    "workspace",
+			usage:   "[--workspace FILE]",
+			summary: "rename, reorder, and group workspace entries under headings",
+			run:     runWorkspace,
+		},
+		{
+			name:    "help",
+			usage:   "",
+			summary: "list the available commands",
+			run:     runHelp,
+		},
+	}
+}
+
+// findCommand looks up a subcommand by name
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+
+	return command{}, false
+}
+
+// parseInterspersed parses flags that may appear before or after positional
+// arguments, returning the positional arguments in order
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		err := flags.Parse(args)
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, ErrHelp
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUsage, err)
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// runConvert translates an expression between dialects, printing the result to
+// stdout and an explanation of any lossy translation to stderr
+func runConvert(args []string, stdout, stderr io.Writer) error {
+	var from, target, seed string
+
+	flags := flag.NewFlagSet("convert", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&from, "from", string(dialectStandard), "dialect of the input expression")
+	flags.StringVar(&target, "to", string(dialectStandard), "dialect to convert to")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru convert --from DIALECT --to DIALECT EXPRESSION", ErrUsage)
+	}
+
+	fromDialect, err := parseDialect(from)
+	if err != nil {
+		return err
+	}
+
+	targetDialect, err := parseDialect(target)
+	if err != nil {
+		return err
+	}
+
+	// Accept the expression quoted as one argument or spread across several
+	result, err := convertExpression(strings.Join(positional, " "), fromDialect, targetDialect, seed)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, result.expression)
+
+	for _, note := range result.notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+
+	return nil
+}
+
+// runHelp lists the subcommands and the supported dialects
+func runHelp(_ []string, stdout, _ io.Writer) error {
+	fmt.Fprintln(stdout, "Usage: crontab-guru [--plain]")
+	fmt.Fprintln(stdout, "       crontab-guru COMMAND [ARGS]")
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "Commands:")
+
+	width := 0
+	for _, cmd := range commands() {
+		width = max(width, len(cmd.name))
+	}
+
+	for _, format := range exporters() {
+		width = max(width, len(format.name))
+	}
+
+	for _, cmd := range commands() {
+		fmt.Fprintf(stdout, "  %-*s  %s\n", width, cmd.name, cmd.summary)
+
+		if cmd.usage != "" {
+			fmt.Fprintf(stdout, "  %*s  %s %s\n", width, "", cmd.name, cmd.usage)
+		}
+	}
+
+	fmt.Fprintln(stdout)
+	fmt.Fprintf(stdout, "Dialects: %s\n", dialectNames())
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "Export formats:")
+
+	for _, format := range exporters() {
+		fmt.Fprintf(stdout, "  %-*s  %s\n", width, format.name, format.summary)
+	}
+
+	return nil
+}
+
+// runCommand runs the subcommand named by the first argument
+func runCommand(args []string, stdout, stderr io.Writer) error {
+	cmd, ok := findCommand(args[0])
+
+	// crontab -e runs $EDITOR with the path of a temporary copy of the crontab
+	if !ok && len(args) == 1 && isCrontabFile(args[0]) {
+		return runEdit(args, stdout, stderr)
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: unknown command %q (run \"crontab-guru help\")", ErrUsage, args[0])
+	}
+
+	return cmd.run(args[1:], stdout, stderr)
+}
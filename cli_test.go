@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRunConvertCommand verifies that convert prints the result to stdout and
+// notes to stderr, with flags accepted after the expression.
+func TestRunConvertCommand(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	err := runCommand([]string{"convert", "0 9 * * 1-5", "--to", "quartz"}, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if stdout.String() != "0 0 9 ? * 2-6\n" {
+		t.Errorf("Unexpected stdout %q", stdout.String())
+	}
+
+	if !strings.HasPrefix(stderr.String(), "note: ") {
+		t.Errorf("Expected a note on stderr, got %q", stderr.String())
+	}
+}
+
+// TestRunCommandErrors verifies usage errors for unknown commands and missing arguments.
+func TestRunCommandErrors(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	tests := [][]string{
+		{"frobnicate"},
+		{"convert"},
+		{"convert", "--bogus", "* * * * *"},
+	}
+
+	for _, args := range tests {
+		if err := runCommand(args, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+			t.Errorf("runCommand(%q) expected ErrUsage, got %v", args, err)
+		}
+	}
+
+	if err := runCommand([]string{"convert", "--from", "cobol", "* * * * *"}, &stdout, &stderr); !errors.Is(err, ErrUnknownDialect) {
+		t.Errorf("Expected ErrUnknownDialect, got %v", err)
+	}
+}
+
+// TestRunHelpCommand verifies that help lists every command and dialect.
+func TestRunHelpCommand(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	if err := runCommand([]string{"help"}, &stdout, &stdout); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"convert", "help", "quartz", "jenkins"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Expected help to mention %q:\n%s", want, stdout.String())
+		}
+	}
+}
+
+// TestRunCommandHelpFlag verifies that -h and --help print the usage and
+// return ErrHelp, which exits successfully, rather than a usage error.
+func TestRunCommandHelpFlag(t *testing.T) {
+	t.Parallel()
+
+	for _, flag := range []string{"-h", "--help"} {
+		var stdout, stderr bytes.Buffer
+
+		err := runCommand([]string{"convert", flag}, &stdout, &stderr)
+		if !errors.Is(err, ErrHelp) || errors.Is(err, ErrUsage) {
+			t.Errorf("convert %s: expected ErrHelp, got %v", flag, err)
+		}
+
+		if !strings.Contains(stderr.String(), "-from") {
+			t.Errorf("convert %s: expected the usage on stderr, got %q", flag, stderr.String())
+		}
+	}
+
+	if _, err := parseOptions([]string{"--help"}); !errors.Is(err, ErrHelp) {
+		t.Errorf("Expected ErrHelp from the editor's flags, got %v", err)
+	}
+}
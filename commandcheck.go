@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// intervalSampleRuns is how many upcoming runs are compared to find the
+// shortest time between two of them
+const intervalSampleRuns = 200
+
+// commandCheck is a crontab command with what its sanity checks need to
+// know about where and how often it runs
+type commandCheck struct {
+	command  string        // Command after the schedule
+	path     string        // PATH the command runs with
+	lookup   bool          // Whether its program can be looked up on this machine
+	interval time.Duration // Shortest time between two runs, 0 when unknown
+	runtime  time.Duration // How long the command typically runs, 0 when unknown
+}
+
+// programProblem says why a program cannot run under path, "" when it can
+func programProblem(program, path, home string) string {
+	switch {
+	case findProgram(program, path, home):
+		return ""
+	case strings.Contains(program, "/"):
+		return program + " is not an executable file"
+	default:
+		return fmt.Sprintf("%s is not in PATH %s", program, path)
+	}
+}
+
+// hasUnescapedPercent reports whether a command has a % without a backslash
+// before it, which cron turns into a newline, passing the rest as input
+func hasUnescapedPercent(command string) bool {
+	for index := range len(command) {
+		if command[index] == '%' && (index == 0 || command[index-1] != '\\') {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shortestInterval returns the shortest time between two of a schedule's
+// upcoming runs, 0 when it runs less than twice
+func shortestInterval(schedule cronparser.Schedule, now time.Time) time.Duration {
+	runs := nextTimes(schedule, now, time.Time{}, intervalSampleRuns)
+
+	var shortest time.Duration
+
+	for index := 1; index < len(runs); index++ {
+		if gap := runs[index].Sub(runs[index-1]); shortest == 0 || gap < shortest {
+			shortest = gap
+		}
+	}
+
+	return shortest
+}
+
+// warnings lists the mistakes that make a cron job fail quietly: a program
+// that cannot be found, a path relative to wherever cron starts, output
+// nobody reads, a % cron cuts the command at, and runs that outlast the
+// time between them
+func (c commandCheck) warnings(home string) []string {
+	var warnings []string
+
+	program, name := commandProgram(c.command), jobName(c.command)
+
+	if program != "" && strings.Contains(program, "/") && !filepath.IsAbs(program) {
+		warnings = append(warnings, program+" is relative to the home directory cron starts in; use an absolute path")
+	}
+
+	if program != "" && c.lookup {
+		if problem := programProblem(program, c.path, home); problem != "" {
+			warnings = append(warnings, problem)
+		}
+	}
+
+	if !strings.ContainsAny(c.command, ">|") {
+		warnings = append(warnings, fmt.Sprintf("output is not redirected, so it is mailed or lost; append >> /var/log/%s.log 2>&1", name))
+	}
+
+	if hasUnescapedPercent(c.command) {
+		warnings = append(warnings, `cron ends the command at % and passes the rest as input; write \% instead`)
+	}
+
+	if c.runtime > 0 && c.interval > 0 && c.interval < c.runtime && name != "flock" && name != "timeout" {
+		warnings = append(warnings, fmt.Sprintf(
+			"runs every %s but takes about %s, so runs overlap; wrap it in flock -n /tmp/%s.lock to skip a run while one is going, or timeout %s to stop it in time",
+			formatDuration(c.interval), formatDuration(c.runtime), name, formatDuration(c.interval)))
+	}
+
+	return warnings
+}
+
+// formatDuration formats a duration in its largest units, e.g. "1h 30m"
+func formatDuration(duration time.Duration) string {
+	return strings.TrimPrefix(formatRelative(duration), "in ")
+}
+
+// commandWarnings checks the command of a pasted crontab line against the
+// PATH it runs with and the configured typical runtime
+func (m *model) commandWarnings() []string {
+	if m.lineCommand == "" {
+		return nil
+	}
+
+	check := commandCheck{command: m.lineCommand, path: m.linePath, lookup: !m.lineRemote, runtime: m.runtime}
+
+	if check.path == "" {
+		check.path = cronDefaultPath
+
+		for _, assignment := range m.lineEnv {
+			if name, value, ok := variableDeclaration(assignment); ok && name == "PATH" {
+				check.path = value
+			}
+		}
+	}
+
+	if m.runtime > 0 {
+		if schedule, err := standardParser.Parse(m.buildCronExpression()); err == nil {
+			check.interval = shortestInterval(schedule, time.Now())
+		}
+	}
+
+	home, _ := os.UserHomeDir()
+
+	return check.warnings(home)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// TestCommandCheckWarnings verifies each sanity check of a crontab command,
+// and that a command with none of the mistakes passes.
+func TestCommandCheckWarnings(t *testing.T) {
+	t.Parallel()
+
+	home := t.TempDir()
+	bin := filepath.Join(home, "bin")
+
+	if err := os.Mkdir(bin, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(bin, "backup.sh"), []byte("#!/bin/sh\n"), 0o700); err != nil { //nolint:gosec // A test script must be executable
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		check    commandCheck
+		expected []string
+	}{
+		{
+			commandCheck{command: "backup.sh >> /var/log/backup.log 2>&1", path: bin, lookup: true},
+			nil,
+		},
+		{
+			commandCheck{command: "backup.sh", path: "/nonexistent", lookup: true},
+			[]string{
+				"backup.sh is not in PATH /nonexistent",
+				"output is not redirected, so it is mailed or lost; append >> /var/log/backup.log 2>&1",
+			},
+		},
+		{
+			commandCheck{command: "bin/backup.sh | logger", path: bin, lookup: true},
+			[]string{"bin/backup.sh is relative to the home directory cron starts in; use an absolute path"},
+		},
+		{
+			commandCheck{command: "backup.sh --date $(date +%F) > /dev/null", path: "/nonexistent"},
+			[]string{`cron ends the command at % and passes the rest as input; write \% instead`},
+		},
+		{
+			commandCheck{command: `backup.sh --date $(date +\%F) > /dev/null`, path: "/nonexistent"},
+			nil,
+		},
+		{
+			commandCheck{command: "backup.sh > /dev/null", interval: 5 * time.Minute, runtime: 10 * time.Minute},
+			[]string{"runs every 5m but takes about 10m, so runs overlap; wrap it in flock -n /tmp/backup.lock " +
+				"to skip a run while one is going, or timeout 5m to stop it in time"},
+		},
+		{
+			commandCheck{command: "flock -n /tmp/backup.lock backup.sh > /dev/null", interval: 5 * time.Minute, runtime: 10 * time.Minute},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		if warnings := test.check.warnings(home); !reflect.DeepEqual(warnings, test.expected) {
+			t.Errorf("warnings(%q) = %q, expected %q", test.check.command, warnings, test.expected)
+		}
+	}
+}
+
+// TestShortestInterval verifies that the shortest gap between runs is
+// found even when runs are unevenly spaced.
+func TestShortestInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]time.Duration{
+		"*/5 * * * *":  5 * time.Minute,
+		"0,10 9 * * *": 10 * time.Minute,
+		"0 9 * * 1,2":  24 * time.Hour,
+	}
+
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	for expr, expected := range tests {
+		schedule, err := cronparser.NewParser(cronParserOptions).Parse(expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if interval := shortestInterval(schedule, now); interval != expected {
+			t.Errorf("shortestInterval(%q) = %s, expected %s", expr, interval, expected)
+		}
+	}
+}
+
+// TestPastedCommandWarnings verifies that the editor warns about a pasted
+// command under it, using the PATH pasted with it and the typical runtime.
+func TestPastedCommandWarnings(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.runtime = 2 * time.Hour
+	m.setExpression("0 * * * *")
+	m.lineEnv = []string{"PATH=/nonexistent"}
+	m.lineCommand = "report.sh"
+
+	view := m.View()
+	for _, expected := range []string{
+		"report.sh is not in PATH /nonexistent",
+		"output is not redirected",
+		"runs every 1h but takes about 2h",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the view, got:\n%s", expected, view)
+		}
+	}
+
+	m.lineRemote = true
+	m.plain = true
+
+	if view := m.View(); strings.Contains(view, "not in PATH") || !strings.Contains(view, "command warning: output is not redirected") {
+		t.Errorf("Expected no lookup for a remote command in the plain view, got:\n%s", view)
+	}
+}
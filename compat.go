@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// schedulerSupport is how well a scheduler runs an expression
+type schedulerSupport int
+
+const (
+	supportWorks       schedulerSupport = iota // Runs as written, or as converted to its dialect
+	supportCaveat                              // Runs, but not quite as standard cron would
+	supportUnsupported                         // Rejected, or cannot be written in its dialect
+)
+
+// String names the support level for the matrix
+func (support schedulerSupport) String() string {
+	switch support {
+	case supportCaveat:
+		return "works with caveat"
+	case supportUnsupported:
+		return "unsupported"
+	default:
+		return "works"
+	}
+}
+
+// scheduler is what a scheduler accepts where it differs from standard cron
+type scheduler struct {
+	name     string  // Name shown in the matrix
+	dialect  dialect // Dialect its expressions are written in
+	minGap   int     // Shortest gap in minutes between runs it keeps, 0 for every minute
+	zoneNote string  // Caveat for schedules tied to the clock, "" when jobs pick their time zone
+}
+
+//nolint:gochecknoglobals
+var schedulers = []scheduler{
+	{name: "Vixie cron", dialect: dialectStandard},
+	{name: "cronie", dialect: dialectStandard},
+	{name: "BusyBox crond", dialect: dialectStandard},
+	{name: "robfig/cron", dialect: dialectStandard},
+	{name: "Quartz", dialect: dialectQuartz},
+	{name: "AWS EventBridge", dialect: dialectAWS, zoneNote: "runs in UTC unless EventBridge Scheduler sets a time zone"},
+	{name: "GitHub Actions", dialect: dialectStandard, minGap: 5, zoneNote: "runs in UTC"},
+	{name: "Kubernetes", dialect: dialectStandard, zoneNote: "runs in the controller's time zone unless spec.timeZone is set"},
+}
+
+// schedulerCompat is how one scheduler handles an expression
+type schedulerCompat struct {
+	scheduler scheduler        // Scheduler checked
+	support   schedulerSupport // Worst problem found
+	notes     []string         // Caveats, problems, and how to write the expression for it
+}
+
+// note records a finding, keeping the worst support level seen
+func (compat *schedulerCompat) note(support schedulerSupport, text string) {
+	compat.support = max(compat.support, support)
+	compat.notes = append(compat.notes, text)
+}
+
+// checkCompat checks a valid standard expression against one scheduler
+func checkCompat(fields []string, target scheduler) schedulerCompat {
+	compat := schedulerCompat{scheduler: target}
+	expr := strings.Join(fields, " ")
+
+	if target.dialect != dialectStandard {
+		conv, err := convertExpression(expr, dialectStandard, target.dialect, "")
+		if err != nil {
+			compat.note(supportUnsupported, err.Error())
+
+			return compat
+		}
+
+		compat.note(supportWorks, "written "+conv.expression)
+		compat.notes = append(compat.notes, conv.notes...)
+	}
+
+	if target.minGap > 0 {
+		// The fields are valid, so they expand without error
+		minutes, _ := expandField(fields[fieldIndexMinute], fieldIndexMinute)
+		hours, _ := expandField(fields[fieldIndexHour], fieldIndexHour)
+
+		if gap := minInterval(minutes, hours); gap < target.minGap {
+			compat.note(supportCaveat, fmt.Sprintf("runs at most every %d minutes, not every %d", target.minGap, gap))
+		}
+	}
+
+	// Only schedules restricting the hour or the day depend on the time zone
+	if target.zoneNote != "" && strings.Join(fields[fieldIndexHour:], " ") != "* * * *" {
+		compat.note(supportCaveat, target.zoneNote)
+	}
+
+	return compat
+}
+
+// compatMatrix checks an expression against every scheduler
+func compatMatrix(expr string) ([]schedulerCompat, error) {
+	if hasJenkinsHash(expr) {
+		return nil, fmt.Errorf("%w: H is Jenkins syntax; ctrl+e replaces it with values", ErrUnsupportedSyntax)
+	}
+
+	fields := strings.Fields(expr)
+	if err := validateStandardFields(fields); err != nil {
+		return nil, err
+	}
+
+	matrix := make([]schedulerCompat, 0, len(schedulers))
+	for _, target := range schedulers {
+		matrix = append(matrix, checkCompat(fields, target))
+	}
+
+	return matrix, nil
+}
+
+// compatLines lays out the matrix with a row per scheduler
+func compatLines(matrix []schedulerCompat) []string {
+	nameWidth, supportWidth := 0, 0
+	for _, compat := range matrix {
+		nameWidth = max(nameWidth, len(compat.scheduler.name))
+		supportWidth = max(supportWidth, len(compat.support.String()))
+	}
+
+	lines := make([]string, 0, len(matrix))
+	for _, compat := range matrix {
+		line := fmt.Sprintf("%-*s  %-*s  %s", nameWidth, compat.scheduler.name, supportWidth, compat.support,
+			strings.Join(compat.notes, "; "))
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+
+	return lines
+}
+
+// renderCompat renders the compatibility matrix for the current expression
+func (m *model) renderCompat() string {
+	if !m.showCompat {
+		return ""
+	}
+
+	return m.cached("compat", func() string {
+		matrix, err := compatMatrix(m.buildCronExpression())
+		if err != nil {
+			return m.place(peekStyle.Render(err.Error())) + "\n"
+		}
+
+		return m.place(peekStyle.Render(strings.Join(compatLines(matrix), "\n"))) + "\n"
+	})
+}
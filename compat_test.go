@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// compatRow finds a scheduler's row in the matrix
+func compatRow(t *testing.T, matrix []schedulerCompat, name string) schedulerCompat {
+	t.Helper()
+
+	for _, compat := range matrix {
+		if compat.scheduler.name == name {
+			return compat
+		}
+	}
+
+	t.Fatalf("No row for %s", name)
+
+	return schedulerCompat{}
+}
+
+// TestCompatMatrix verifies the support levels and notes for expressions
+// that run everywhere, too often for GitHub Actions, and on both day fields.
+func TestCompatMatrix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr      string
+		scheduler string
+		support   schedulerSupport
+		note      string
+	}{
+		{"20 4 * * *", "Vixie cron", supportWorks, ""},
+		{"20 4 * * *", "Quartz", supportWorks, "written 0 20 4 * * ?"},
+		{"20 4 * * *", "GitHub Actions", supportCaveat, "runs in UTC"},
+		{"20 4 * * *", "Kubernetes", supportCaveat, "spec.timeZone"},
+		{"*/2 * * * *", "GitHub Actions", supportCaveat, "runs at most every 5 minutes, not every 2"},
+		{"*/2 * * * *", "Kubernetes", supportWorks, ""},
+		{"*/10 * * * *", "GitHub Actions", supportWorks, ""},
+		{"0 9 * * 1-5", "AWS EventBridge", supportCaveat, `weekday "1-5" renumbered to "2-6"`},
+		{"0 9 1 * 1-5", "Quartz", supportUnsupported, "cannot restrict both day"},
+		{"0 9 1 * 1-5", "robfig/cron", supportWorks, ""},
+	}
+
+	for _, tt := range tests {
+		matrix, err := compatMatrix(tt.expr)
+		if err != nil {
+			t.Fatalf("compatMatrix(%q): unexpected error: %v", tt.expr, err)
+		}
+
+		row := compatRow(t, matrix, tt.scheduler)
+		notes := strings.Join(row.notes, "; ")
+
+		if row.support != tt.support || !strings.Contains(notes, tt.note) || (tt.note == "" && notes != "") {
+			t.Errorf("%s on %s = %s (%s), expected %s with %q", tt.expr, tt.scheduler, row.support, notes, tt.support, tt.note)
+		}
+	}
+}
+
+// TestCompatMatrixErrors verifies that invalid and Jenkins expressions are
+// reported instead of checked.
+func TestCompatMatrixErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := compatMatrix("H 4 * * *"); !errors.Is(err, ErrUnsupportedSyntax) {
+		t.Errorf("Expected ErrUnsupportedSyntax for H, got %v", err)
+	}
+
+	if _, err := compatMatrix("61 4 * * *"); err == nil {
+		t.Error("Expected an error for an invalid minute")
+	}
+}
+
+// TestCompatToggle verifies that alt+m shows and hides the matrix.
+func TestCompatToggle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width, m.height = 120, 60
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m"), Alt: true})
+	m = assertModelType(t, newModel)
+
+	if !m.showCompat || !strings.Contains(m.View(), "GitHub Actions") {
+		t.Errorf("Expected the matrix shown, got:\n%s", m.View())
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m"), Alt: true})
+	m = assertModelType(t, newModel)
+
+	if m.showCompat || strings.Contains(m.View(), "GitHub Actions") {
+		t.Error("Expected the matrix hidden")
+	}
+}
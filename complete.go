@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fieldNameList returns the names a field accepts, in value order, or nil
+// for fields without names
+func fieldNameList(fieldIndex int) []string {
+	switch fieldIndex {
+	case fieldIndexMonth:
+		return monthNames
+	case fieldIndexWeekday:
+		return weekdayNames
+	default:
+		return nil
+	}
+}
+
+// fieldCompletions returns the partly typed name at the end of a month or
+// weekday value and the names it may be completed to. Letters complete to
+// the names starting with them, in either case, and a name followed by "-"
+// completes to the names that can end its range.
+func fieldCompletions(value string, fieldIndex int) (string, []string) {
+	names := fieldNameList(fieldIndex)
+	if names == nil {
+		return "", nil
+	}
+
+	separator := strings.LastIndexAny(value, ",-/")
+	partial := value[separator+1:]
+
+	if partial == "" {
+		if separator <= 0 || value[separator] != '-' {
+			return "", nil
+		}
+
+		start := strings.ToUpper(value[strings.LastIndexAny(value[:separator], ",-/")+1 : separator])
+		if position := slices.Index(names, start); position >= 0 {
+			return "", names[position+1:]
+		}
+
+		return "", nil
+	}
+
+	if (separator >= 0 && value[separator] == '/') || !isLetters(partial) {
+		return "", nil
+	}
+
+	var matches []string
+
+	for _, name := range names {
+		if strings.HasPrefix(name, strings.ToUpper(partial)) && name != partial {
+			matches = append(matches, name)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	return partial, matches
+}
+
+// isLetters reports whether a string is only ASCII letters
+func isLetters(value string) bool {
+	return strings.IndexFunc(value, func(char rune) bool {
+		return (char < 'A' || char > 'Z') && (char < 'a' || char > 'z')
+	}) < 0
+}
+
+// completions returns the completions of the focused field, nil while no
+// month or weekday name is being typed
+func (m *model) completions() (string, []string) {
+	if m.rawMode || m.focusIndex < 0 || m.focusIndex >= len(m.inputs) {
+		return "", nil
+	}
+
+	return fieldCompletions(m.inputs[m.focusIndex].Value(), m.focusIndex)
+}
+
+// handleCompletionKey accepts the selected completion with tab and moves the
+// selection with up and down. It reports false for keys it leaves alone, and
+// for tab when there is nothing to complete, so tab still moves to the next
+// field.
+func (m *model) handleCompletionKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	partial, matches := m.completions()
+
+	switch msg.String() {
+	case "tab":
+		if len(matches) == 0 {
+			return nil, false
+		}
+
+		input := &m.inputs[m.focusIndex]
+		value := input.Value()
+		input.SetValue(value[:len(value)-len(partial)] + matches[m.completionIndex%len(matches)])
+		input.CursorEnd()
+
+		m.completionIndex = 0
+		m.syncRawFromFields()
+
+		return m.scheduleCmd(), true
+	case "up", "down":
+		if len(matches) == 0 {
+			return nil, false
+		}
+
+		step := 1
+		if msg.String() == "up" {
+			step = len(matches) - 1
+		}
+
+		m.completionIndex = (m.completionIndex%len(matches) + step) % len(matches)
+
+		return nil, true
+	default:
+		m.completionIndex = 0
+
+		return nil, false
+	}
+}
+
+// renderCompletions draws the completions of the focused field on one line
+// under the fields, the one tab accepts highlighted
+func (m *model) renderCompletions() string {
+	_, matches := m.completions()
+	if len(matches) == 0 {
+		return ""
+	}
+
+	items := make([]string, 0, len(matches))
+	for index, name := range matches {
+		if index == m.completionIndex%len(matches) {
+			items = append(items, focusedLabelStyle.Render(name))
+		} else {
+			items = append(items, dimStyle.Render(name))
+		}
+	}
+
+	return m.place(dimStyle.Render("tab: ")+strings.Join(items, " ")) + "\n"
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestFieldCompletions verifies the names offered for partly typed months and
+// weekdays and for the ends of ranges.
+func TestFieldCompletions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		field    int
+		partial  string
+		expected []string
+	}{
+		{"J", fieldIndexMonth, "J", []string{"JAN", "JUN", "JUL"}},
+		{"1,ma", fieldIndexMonth, "ma", []string{"MAR", "MAY"}},
+		{"S", fieldIndexWeekday, "S", []string{"SUN", "SAT"}},
+		{"MON-", fieldIndexWeekday, "", []string{"TUE", "WED", "THU", "FRI", "SAT"}},
+		{"JAN,OCT-", fieldIndexMonth, "", []string{"NOV", "DEC"}},
+		{"MON-F", fieldIndexWeekday, "F", []string{"FRI"}},
+		{"MON", fieldIndexWeekday, "", nil},
+		{"1-", fieldIndexMonth, "", nil},
+		{"*/M", fieldIndexWeekday, "", nil},
+		{"X", fieldIndexWeekday, "", nil},
+		{"J", fieldIndexHour, "", nil},
+	}
+
+	for _, test := range tests {
+		partial, matches := fieldCompletions(test.value, test.field)
+		if partial != test.partial || !slices.Equal(matches, test.expected) {
+			t.Errorf("fieldCompletions(%q, %d) = %q, %v, expected %q, %v",
+				test.value, test.field, partial, matches, test.partial, test.expected)
+		}
+	}
+}
+
+// TestCompletionKeys verifies that the completions show under the fields,
+// that up and down choose one and tab accepts it, and that tab still moves
+// to the next field when there is nothing to complete.
+func TestCompletionKeys(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 9 * * *")
+	m.setFocus(fieldIndexMonth)
+	m.inputs[fieldIndexMonth].SetValue("")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+
+	if view := m.View(); !strings.Contains(view, "tab: ") || !strings.Contains(view, "JUL") {
+		t.Errorf("Expected the completions under the fields:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "complete with tab: JAN, [JUN], JUL\n") {
+		t.Errorf("Expected JUN selected in plain mode:\n%s", plain)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	if value := m.inputs[fieldIndexMonth].Value(); value != "JUN-DEC" || m.focusIndex != fieldIndexMonth {
+		t.Errorf("Expected JUN-DEC in the month field, got %q in field %d", value, m.focusIndex)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	if m.focusIndex != fieldIndexWeekday {
+		t.Errorf("Expected tab to move to the weekday field, got field %d", m.focusIndex)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	configDirName  = "crontab-guru" // Directory under the user config directory
+	configFileName = "config.json"  // Settings file inside the config directory
+)
+
+// startupMode selects which editor the app opens in
+type startupMode string
+
+const (
+	modeFields startupMode = "fields" // Per-field inputs
+	modeRaw    startupMode = "raw"    // Whole-expression text input
+	modeWizard startupMode = "wizard" // Questions that build the expression, then the per-field inputs
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrInvalidConfig is returned when the config file or a startup option is invalid
+	ErrInvalidConfig = errors.New("invalid config")
+
+	// systemConfigPath holds defaults for every user of the machine, read
+	// before the user's own config file; tests point it elsewhere
+	systemConfigPath = "/etc/" + configDirName + "/" + configFileName
+
+	// Startup modes in the order they are listed to users
+	startupModes = []startupMode{modeFields, modeRaw, modeWizard}
+
+	// Config keys set by each command-line flag, which a locked key also
+	// keeps from being changed
+	lockableFlags = map[string]string{
+		"plain":        "plain",
+		"mode":         "mode",
+		"field":        "field",
+		"seed":         "seed",
+		"session":      "session",
+		"dialect":      "dialect",
+		"runtime":      "runtime",
+		"tz-list":      "timezones",
+		"locale":       "locale",
+		"strict-names": "strict_names",
+		"bell":         "bell",
+		"auto-advance": "auto_advance",
+	}
+)
+
+// config holds the persistent settings read from the system and user config
+// files. The user's settings take precedence over the system's, except for
+// the keys the system config locks, and command-line flags take precedence
+// over both.
+type config struct {
+	Mode         string     `json:"mode,omitempty"`          // Startup mode, see startupModes
+	Field        string     `json:"field,omitempty"`         // Field focused at startup, e.g. "hour"
+	Plain        bool       `json:"plain,omitempty"`         // Start in plain mode
+	Seed         string     `json:"seed,omitempty"`          // Jenkins job name used to resolve H tokens
+	Session      string     `json:"session,omitempty"`       // Session restored at startup, "default" when empty
+	RiskRules    []riskRule `json:"risk_rules,omitempty"`    // Rules assigning risk badges, replacing the defaults
+	ClashWindow  string     `json:"clash_window,omitempty"`  // Tabs running this close together clash, e.g. "10m"
+	Dialect      string     `json:"dialect,omitempty"`       // Dialect of the raw input, see dialects
+	History      bool       `json:"history,omitempty"`       // Commit every written crontab to a git repository
+	Runtime      string     `json:"runtime,omitempty"`       // How long pasted commands typically run, e.g. "10m"
+	LogTemplates []string   `json:"log_templates,omitempty"` // Logging suffixes for commands, {name} naming the program
+	Calendars    []string   `json:"calendars,omitempty"`     // Holiday, business hours, and freeze files, relative to the config's directory
+	Timezones    []string   `json:"timezones,omitempty"`     // Time zones the next run is also shown in, e.g. "Asia/Tokyo"
+	Expression   string     `json:"expression,omitempty"`    // Expression the editor starts with and alt+r restores, e.g. "0 9 * * 1-5"
+	Locale       string     `json:"locale,omitempty"`        // Language of month and weekday names typed into the fields, e.g. "pt"
+	Dictionaries localeSet  `json:"dictionaries,omitempty"`  // Month and weekday names of languages to add, by language code
+	StrictNames  bool       `json:"strict_names,omitempty"`  // Suggest abbreviations only for full month and weekday names
+	FieldLimit   int        `json:"field_limit,omitempty"`   // Longest value a field accepts, 32 by default
+	Notation     string     `json:"notation,omitempty"`      // Months and weekdays at startup as "numeric" or "named"
+	Bell         bool       `json:"bell,omitempty"`          // Ring the bell when the expression turns valid or invalid
+	SoundCommand string     `json:"sound_command,omitempty"` // Command run instead of the bell, e.g. "paplay done.oga"
+	AutoAdvance  bool       `json:"auto_advance,omitempty"`  // Move to the next field once a number in one is complete
+	Keys         keyConfig  `json:"keys,omitempty"`          // Keys of editor actions replacing the defaults, e.g. {"copy": ["ctrl+y"]}
+	Locked       []string   `json:"locked,omitempty"`        // Keys of the system config users cannot change, ignored in the user config
+}
+
+// defaultConfigPath returns the config file location under the user config directory
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, configDirName, configFileName)
+}
+
+// loadConfig reads the system config and then the user config file at path
+// on top of it. Missing files are not an error and yield the zero config.
+func loadConfig(path string) (config, error) {
+	return loadLayeredConfig(systemConfigPath, path)
+}
+
+// loadLayeredConfig reads the config at systemPath, then overrides it with
+// the keys set in the config at userPath, leaving out the keys the system
+// config locks. Calendars of the system config are resolved against its own
+// directory, so they are found whichever file ends up naming them.
+func loadLayeredConfig(systemPath, userPath string) (config, error) {
+	var cfg config
+
+	system, err := readConfigLayer(systemPath)
+	if err != nil {
+		return cfg, err
+	}
+
+	if err := decodeConfigLayer(system, &cfg, systemPath); err != nil {
+		return cfg, err
+	}
+
+	for index, calendar := range cfg.Calendars {
+		if !filepath.IsAbs(calendar) {
+			cfg.Calendars[index] = filepath.Join(filepath.Dir(systemPath), calendar)
+		}
+	}
+
+	user, err := readConfigLayer(userPath)
+	if err != nil {
+		return cfg, err
+	}
+
+	delete(user, "locked")
+
+	for _, key := range cfg.Locked {
+		if !isConfigKey(key) {
+			return cfg, fmt.Errorf("%w: %s: cannot lock unknown key %q", ErrInvalidConfig, systemPath, key)
+		}
+
+		delete(user, key)
+	}
+
+	if err := decodeConfigLayer(user, &cfg, userPath); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// readConfigLayer reads the keys set in the config file at path, nil when
+// there is no path or no file
+func readConfigLayer(path string) (map[string]json.RawMessage, error) {
+	var layer map[string]json.RawMessage
+
+	if path == "" {
+		return layer, nil
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // The path is the user's own or the system config file
+	if errors.Is(err, fs.ErrNotExist) {
+		return layer, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	if err := json.Unmarshal(data, &layer); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	return layer, nil
+}
+
+// decodeConfigLayer sets the keys of a config layer on cfg, leaving the
+// settings the layer does not mention as they were
+func decodeConfigLayer(layer map[string]json.RawMessage, cfg *config, path string) error {
+	if len(layer) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(layer)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	return nil
+}
+
+// isConfigKey reports whether a config file may set key
+func isConfigKey(key string) bool {
+	fields := reflect.TypeFor[config]()
+	for index := range fields.NumField() {
+		if name, _, _ := strings.Cut(fields.Field(index).Tag.Get("json"), ","); name == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkLockedFlags rejects the command-line flags setting a key the system
+// config locks
+func checkLockedFlags(set map[string]bool, cfg config) error {
+	for _, name := range slices.Sorted(maps.Keys(set)) {
+		if key, ok := lockableFlags[name]; ok && slices.Contains(cfg.Locked, key) {
+			return fmt.Errorf("%w: --%s cannot be used, %s locks %q", ErrInvalidConfig, name, systemConfigPath, key)
+		}
+	}
+
+	return nil
+}
+
+// parseStartupMode looks up a startup mode by name; empty selects the field editor
+func parseStartupMode(name string) (startupMode, error) {
+	if name == "" {
+		return modeFields, nil
+	}
+
+	names := make([]string, 0, len(startupModes))
+
+	for _, mode := range startupModes {
+		if strings.EqualFold(name, string(mode)) {
+			return mode, nil
+		}
+
+		names = append(names, string(mode))
+	}
+
+	return "", fmt.Errorf("%w: mode %q (expected one of %s)", ErrInvalidConfig, name, strings.Join(names, ", "))
+}
+
+// parseFieldName looks up a field index by name; empty selects the first field
+func parseFieldName(name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+
+	for index, fieldName := range fieldNames {
+		if strings.EqualFold(name, fieldName) {
+			return index, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: field %q (expected one of %s)", ErrInvalidConfig, name, strings.Join(fieldNames, ", "))
+}
+
+// applyStartup opens the model in the configured mode and field
+func (m *model) applyStartup(opts options) {
+	m.plain = opts.plain
+	m.hashSeed = opts.seed
+	m.riskRules = opts.riskRules
+	m.clashWindow = opts.clashWindow
+	m.dialect = opts.dialect
+	m.runtime = opts.runtime
+	m.logTemplates = opts.logTemplates
+	m.calendars = opts.calendars
+	m.timezones = opts.timezones
+	m.defaultExpr = opts.expression
+	m.locale = opts.locale
+	m.strictNames = opts.strictNames
+	m.bell = opts.bell
+	m.soundCommand = opts.soundCommand
+	m.autoAdvance = opts.autoAdvance
+
+	if opts.fieldLimit > 0 {
+		m.fieldLimit = opts.fieldLimit
+	}
+
+	if opts.keys != nil {
+		m.keys = *opts.keys
+	}
+
+	m.setFocus(opts.field)
+
+	if m.defaultExpr != "" {
+		m.setExpression(m.defaultExpr)
+	}
+
+	if opts.notation != "" {
+		m.applyNotation(opts.notation == notationNamed)
+	}
+
+	if m.defaultExpr != "" || opts.notation != "" {
+		m.updateDescription()
+	}
+
+	m.wasInvalid = m.err != nil
+
+	switch opts.mode {
+	case modeFields:
+		// The fields are shown from the start
+	case modeRaw:
+		m.toggleRawMode()
+	case modeWizard:
+		m.openWizard()
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// writeConfig writes a config file into a temporary directory and returns its path
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), configFileName)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	return path
+}
+
+// TestParseOptionsConfig verifies that the config file supplies the startup
+// mode and field, and that flags override it.
+func TestParseOptionsConfig(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"mode": "raw", "field": "hour", "plain": true}`)
+
+	opts, err := parseOptions([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.mode != modeRaw || opts.field != 1 || !opts.plain {
+		t.Errorf("Expected the config to select raw mode on the hour field, got %+v", opts)
+	}
+
+	opts, err = parseOptions([]string{"--config", path, "--mode", "fields", "--field", "Weekday", "--plain=false"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.mode != modeFields || opts.field != fieldIndexWeekday || opts.plain {
+		t.Errorf("Expected flags to override the config, got %+v", opts)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.json")
+
+	opts, err = parseOptions([]string{"--config", missing})
+	if err != nil || opts.mode != modeFields || opts.field != 0 || opts.clashWindow != defaultClashWindow {
+		t.Errorf("Expected defaults for a missing config, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseOptions([]string{"--config", writeConfig(t, `{"clash_window": "15m"}`)})
+	if err != nil || opts.clashWindow != 15*time.Minute {
+		t.Errorf("Expected a 15m clash window, got %+v, %v", opts, err)
+	}
+
+	if opts.dialect != dialectStandard {
+		t.Errorf("Expected the standard dialect by default, got %q", opts.dialect)
+	}
+
+	opts, err = parseOptions([]string{"--config", writeConfig(t, `{"dialect": "quartz"}`), "--dialect", "AWS"})
+	if err != nil || opts.dialect != dialectAWS {
+		t.Errorf("Expected the flag to select the aws dialect, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseOptions([]string{"--config", writeConfig(t, `{"runtime": "10m"}`)})
+	if err != nil || opts.runtime != 10*time.Minute {
+		t.Errorf("Expected a 10m runtime, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseOptions([]string{"--config", writeConfig(t, `{"timezones": ["UTC", "Asia/Tokyo"]}`)})
+	if err != nil || len(opts.timezones) != 2 || opts.timezones[1].String() != "Asia/Tokyo" {
+		t.Errorf("Expected the configured time zones, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseOptions([]string{"--config", writeConfig(t, `{"timezones": ["UTC"]}`), "--tz-list", "America/New_York"})
+	if err != nil || len(opts.timezones) != 1 || opts.timezones[0].String() != "America/New_York" {
+		t.Errorf("Expected --tz-list to replace the configured time zones, got %+v, %v", opts, err)
+	}
+}
+
+// TestParseOptionsConfigErrors verifies that malformed config files and unknown
+// modes or fields are reported instead of ignored.
+func TestParseOptionsConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	missing := filepath.Join(t.TempDir(), "missing.json")
+
+	tests := [][]string{
+		{"--config", writeConfig(t, `{"mode": `)},
+		{"--config", writeConfig(t, `{"mode": "calendar"}`)},
+		{"--config", missing, "--field", "second"},
+		{"--config", missing, "--mode", "visual"},
+		{"--config", writeConfig(t, `{"clash_window": "soon"}`)},
+		{"--config", missing, "--dialect", "posix"},
+		{"--config", missing, "--runtime", "long"},
+		{"--config", missing, "--tz-list", "UTC,Mars/Base"},
+		{"--config", writeConfig(t, `{"field_limit": -1}`)},
+	}
+
+	for _, args := range tests {
+		if _, err := parseOptions(args); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("parseOptions(%q) expected ErrInvalidConfig, got %v", args, err)
+		}
+	}
+}
+
+// TestApplyStartup verifies that the model opens in the configured mode and field.
+func TestApplyStartup(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.applyStartup(options{mode: modeRaw, field: 2})
+
+	if !m.rawMode || !m.rawInput.Focused() {
+		t.Error("Expected the raw input to be focused")
+	}
+
+	if m.focusIndex != 2 {
+		t.Errorf("Expected the day field to be selected, got %d", m.focusIndex)
+	}
+
+	m = initialModel()
+	m.applyStartup(options{mode: modeFields, field: fieldIndexMonth})
+
+	if m.rawMode || !m.inputs[fieldIndexMonth].Focused() || m.inputs[0].Focused() {
+		t.Error("Expected only the month field to be focused")
+	}
+}
+
+// TestFieldLimit verifies that a value longer than the field limit is kept
+// whole and reported instead of cut off, and that the config raises the limit.
+func TestFieldLimit(t *testing.T) {
+	t.Parallel()
+
+	minutes := "0,2,4,6,8,10,12,14,16,18,20,22,24,26,28,30"
+
+	m := initialModel()
+	m.rawInput.SetValue(minutes + " 9 * * *")
+	m.syncFieldsFromRaw()
+
+	err := m.validateCronParts()
+	if m.inputs[fieldIndexMinute].Value() != minutes || !errors.Is(err, ErrInvalidValue) || !strings.Contains(err.Error(), "field_limit") {
+		t.Errorf("Expected the minutes kept whole and reported, got %q: %v", m.inputs[fieldIndexMinute].Value(), err)
+	}
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"field_limit": 64}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m.applyStartup(opts)
+
+	if err := m.validateCronParts(); err != nil {
+		t.Errorf("Expected the minutes accepted with a limit of 64, got %v", err)
+	}
+}
+
+// TestLoadLayeredConfig verifies that the user config overrides the system
+// config key by key, except for the keys the system config locks, and that
+// system calendars are found next to the system config.
+func TestLoadLayeredConfig(t *testing.T) {
+	t.Parallel()
+
+	system := writeConfig(t, `{
+		"timezones": ["UTC"],
+		"seed": "org",
+		"calendars": ["holidays.json"],
+		"risk_rules": [{"level": "high", "reason": "business hours", "hours": "9-17"}],
+		"locked": ["risk_rules"]
+	}`)
+	user := writeConfig(t, `{"timezones": ["Asia/Tokyo"], "mode": "raw", "risk_rules": [], "locked": []}`)
+
+	cfg, err := loadLayeredConfig(system, user)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(cfg.Timezones) != 1 || cfg.Timezones[0] != "Asia/Tokyo" || cfg.Mode != "raw" || cfg.Seed != "org" {
+		t.Errorf("Expected the user settings over the system ones, got %+v", cfg)
+	}
+
+	if len(cfg.RiskRules) != 1 || len(cfg.Locked) != 1 {
+		t.Errorf("Expected the locked risk rules kept, got %+v", cfg)
+	}
+
+	if expected := filepath.Join(filepath.Dir(system), "holidays.json"); len(cfg.Calendars) != 1 || cfg.Calendars[0] != expected {
+		t.Errorf("Expected the system calendar at %s, got %v", expected, cfg.Calendars)
+	}
+
+	if err := checkLockedFlags(map[string]bool{"mode": true}, config{Locked: []string{"timezones"}}); err != nil {
+		t.Errorf("Expected --mode allowed, got %v", err)
+	}
+
+	if err := checkLockedFlags(map[string]bool{"tz-list": true}, config{Locked: []string{"timezones"}}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected --tz-list rejected while timezones is locked, got %v", err)
+	}
+
+	if _, err := loadLayeredConfig(writeConfig(t, `{"locked": ["theme"]}`), user); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected an unknown locked key rejected, got %v", err)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// cronStarBit is the bit the cron parser sets on a day field written as "*"
+// or "?", which makes the other day field decide alone
+const cronStarBit = fieldSet(1) << 63
+
+// runCounter counts and lists the runs of a schedule in a range from the
+// sets of values its fields select, so a century takes as long as a few
+// thousand days. Years and months the schedule skips are passed over whole,
+// and a matching day without a daylight saving change runs once per
+// selected hour, minute, and second. The days at either end of the range
+// and those the clocks change on are gone through an hour at a time, and
+// only an hour cut short by the range or by a change of offset is gone
+// through minute by minute.
+type runCounter struct {
+	spec     cronSpec                 // Expression, for its year field
+	schedule cronparser.Schedule      // Parsed schedule, searched run by run when it has no field sets
+	fields   *cronparser.SpecSchedule // Field sets of the schedule, nil when it has none
+}
+
+// newRunCounter counts the runs of a parsed schedule
+func newRunCounter(spec cronSpec, schedule cronparser.Schedule) runCounter {
+	fields, _ := schedule.(*cronparser.SpecSchedule)
+
+	return runCounter{spec: spec, schedule: schedule, fields: fields}
+}
+
+// inYear reports whether a time falls in a year the year field selects,
+// always true for dialects without one
+func (c runCounter) inYear(at time.Time) bool {
+	if c.spec.year == "" {
+		return true
+	}
+
+	matched, err := yearMatches(c.spec.year, at.Year())
+
+	return err == nil && matched
+}
+
+// field returns the values a field of the schedule selects
+func field(bits uint64) fieldSet {
+	return fieldSet(bits) &^ cronStarBit
+}
+
+// dayMatches reports whether the schedule runs on a day. As in cron, a day
+// matches either day field when both are restricted, and both otherwise.
+func (c runCounter) dayMatches(day time.Time) bool {
+	if !field(c.fields.Month).Has(int(day.Month())) {
+		return false
+	}
+
+	dom, dow := fieldSet(c.fields.Dom), fieldSet(c.fields.Dow)
+	domMatches, dowMatches := dom.Has(day.Day()), dow.Has(int(day.Weekday()))
+
+	if dom&cronStarBit != 0 || dow&cronStarBit != 0 {
+		return domMatches && dowMatches
+	}
+
+	return domMatches || dowMatches
+}
+
+// clockMatches reports whether the schedule runs at a time of day
+func (c runCounter) clockMatches(at time.Time) bool {
+	return field(c.fields.Hour).Has(at.Hour()) && field(c.fields.Minute).Has(at.Minute()) &&
+		field(c.fields.Second).Has(at.Second())
+}
+
+// runsPerHour is how many times the schedule runs in an hour it selects
+func (c runCounter) runsPerHour() int {
+	return field(c.fields.Second).Len() * field(c.fields.Minute).Len()
+}
+
+// count returns how many runs fall at or after from and before to
+func (c runCounter) count(from, to time.Time) int {
+	return c.walk(from, to, nil)
+}
+
+// walk counts the runs at or after from and before to, handing each to
+// visit in order when it is set
+func (c runCounter) walk(from, to time.Time, visit func(time.Time)) int {
+	if c.fields == nil {
+		return c.search(from, to, visit)
+	}
+
+	// Runs fall in the zone of from unless the schedule names its own
+	location := from.Location()
+	if c.fields.Location != time.Local {
+		location = c.fields.Location
+	}
+
+	from, to = from.In(location), to.In(location)
+	perDay := c.runsPerHour() * field(c.fields.Hour).Len()
+	total := 0
+
+	// Days are stepped through as dates, since midnight does not always exist
+	date := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day := dayStart(date, location); day.Before(to); day = dayStart(date, location) {
+		switch {
+		case !c.inYear(date):
+			date = time.Date(date.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			continue
+		case !field(c.fields.Month).Has(int(date.Month())):
+			date = time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+			continue
+		}
+
+		matches := c.dayMatches(date)
+		date = date.AddDate(0, 0, 1)
+		next := dayStart(date, location)
+
+		switch {
+		case !matches:
+		case visit == nil && !day.Before(from) && !next.After(to) && next.Sub(day) == hoursPerDay*time.Hour:
+			total += perDay
+		default:
+			total += c.walkHours(maxTime(day, from), minTime(next, to), visit)
+		}
+	}
+
+	return total
+}
+
+// dayStart returns when a date starts in location: at midnight, or at the
+// first time after it on days the clocks skip midnight
+func dayStart(date time.Time, location *time.Location) time.Time {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+	if start.Day() != date.Day() {
+		start = start.Add(time.Duration(hoursPerDay-start.Hour()) * time.Hour)
+	}
+
+	return start
+}
+
+// walkHours goes through the time between two times on one day an hour at
+// a time, reading the hour off the clock as cron does, so an hour repeated
+// when the clocks go back runs twice and one skipped when they go forward
+// never runs
+func (c runCounter) walkHours(from, to time.Time, visit func(time.Time)) int {
+	total := 0
+	minutes, seconds := field(c.fields.Minute).Values(), field(c.fields.Second).Values()
+
+	start := time.Date(from.Year(), from.Month(), from.Day(), from.Hour(), 0, 0, 0, from.Location())
+	for ; start.Before(to); start = start.Add(time.Hour) {
+		end := start.Add(time.Hour)
+		_, startOffset := start.Zone()
+		_, endOffset := end.Add(-time.Nanosecond).Zone()
+
+		switch {
+		case start.Before(from) || end.After(to) || start.Minute() != 0 || startOffset != endOffset:
+			total += c.walkClock(maxTime(start, from), minTime(end, to), visit)
+		case !field(c.fields.Hour).Has(start.Hour()):
+		case visit == nil:
+			total += c.runsPerHour()
+		default:
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					visit(start.Add(time.Duration(minute)*time.Minute + time.Duration(second)*time.Second))
+				}
+			}
+
+			total += len(minutes) * len(seconds)
+		}
+	}
+
+	return total
+}
+
+// walkClock goes through the time between two times a minute at a time, or
+// a second at a time for schedules with seconds, reading the clock at each
+func (c runCounter) walkClock(from, to time.Time, visit func(time.Time)) int {
+	step := time.Minute
+	if field(c.fields.Second) != 1 {
+		step = time.Second
+	}
+
+	total := 0
+
+	at := from.Truncate(step)
+	if at.Before(from) {
+		at = at.Add(step)
+	}
+
+	for ; at.Before(to); at = at.Add(step) {
+		if !c.clockMatches(at) {
+			continue
+		}
+
+		if visit != nil {
+			visit(at)
+		}
+
+		total++
+	}
+
+	return total
+}
+
+// search counts the runs at or after from and before to one by one, handing
+// each to visit when it is set
+func (c runCounter) search(from, to time.Time, visit func(time.Time)) int {
+	total := 0
+
+	for run := c.schedule.Next(from.Add(-time.Nanosecond)); !run.IsZero() && run.Before(to); run = c.schedule.Next(run) {
+		if !c.inYear(run) {
+			continue
+		}
+
+		if visit != nil {
+			visit(run)
+		}
+
+		total++
+	}
+
+	return total
+}
+
+// maxTime returns the later of two times
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+// minTime returns the earlier of two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+// parseRangeEnd reads --to as a time in location, in one of
+// nextUntilLayouts, or as a duration after from such as 720h
+func parseRangeEnd(value string, from time.Time, location *time.Location) (time.Time, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return from.Add(duration), nil
+	}
+
+	if end, ok := parseLayoutTime(value, location); ok {
+		return end, nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: --to %q is neither a duration nor a time like 2006-01-02 15:04", ErrUsage, value)
+}
+
+// runCount prints how many times an expression runs from --from, now by
+// default, up to but not including --to, or with --list every run
+func runCount(args []string, stdout, stderr io.Writer) error {
+	var (
+		from, seed, timezone, start, end string
+		list                             bool
+	)
+
+	flags := flag.NewFlagSet("count", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&start, "from", "", "start of the range, e.g. 2025-01-01, now by default")
+	flags.StringVar(&end, "to", "", "end of the range, not included, as a time or a duration after --from")
+	flags.BoolVar(&list, "list", false, "print every run instead of the count")
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&timezone, "timezone", "", "IANA time zone the schedule is read in, local by default")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 || end == "" {
+		return fmt.Errorf("%w: crontab-guru count [--from TIME] --to TIME [--list] EXPRESSION", ErrUsage)
+	}
+
+	query, err := readServeQuery(strings.Join(positional, " "), from, seed, timezone, time.Local)
+	if err != nil {
+		return err
+	}
+
+	rangeStart := time.Now().In(query.location)
+	if start != "" {
+		var ok bool
+		if rangeStart, ok = parseLayoutTime(start, query.location); !ok {
+			return fmt.Errorf("%w: --from %q is not a time like 2006-01-02 15:04", ErrUsage, start)
+		}
+	}
+
+	rangeEnd, err := parseRangeEnd(end, rangeStart, query.location)
+	if err != nil {
+		return err
+	}
+
+	if rangeEnd.Before(rangeStart) {
+		return fmt.Errorf("%w: --to is before --from", ErrUsage)
+	}
+
+	schedule, err := specSchedule(query.spec)
+	if err != nil {
+		return err
+	}
+
+	counter := newRunCounter(query.spec, schedule)
+
+	if list {
+		counter.walk(rangeStart.In(query.location), rangeEnd.In(query.location), func(run time.Time) {
+			fmt.Fprintln(stdout, run.Format(time.RFC3339))
+		})
+
+		return nil
+	}
+
+	fmt.Fprintln(stdout, counter.count(rangeStart.In(query.location), rangeEnd.In(query.location)))
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRunCounterMatchesSearch verifies that counting and listing from the
+// field sets agree with searching every run, across partial days and
+// hours, both day-field rules, seconds, skipped months, and daylight saving
+// changes.
+func TestRunCounterMatchesSearch(t *testing.T) {
+	t.Parallel()
+
+	specs := []cronSpec{
+		{fields: []string{"*/7", "*", "*", "*", "*"}},
+		{fields: []string{"30", "0-3", "*", "*", "*"}},
+		{fields: []string{"0", "9", "13", "*", "5"}},
+		{fields: []string{"0", "9", "*/2", "*", "1-5"}},
+		{fields: []string{"0", "0", "29", "2", "*"}},
+		{fields: []string{"15", "*/6", "1", "MAR,OCT", "*"}},
+		{fields: []string{"0", "12", "*", "*", "*"}, seconds: "*/20"},
+	}
+
+	for _, zone := range []string{"Europe/Lisbon", "America/New_York", "Asia/Kolkata"} {
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		from := time.Date(2018, time.February, 27, 13, 17, 30, 0, location)
+		to := time.Date(2018, time.November, 5, 6, 45, 0, 0, location)
+
+		for _, spec := range specs {
+			schedule, err := specSchedule(spec)
+			if err != nil {
+				t.Fatalf("Unexpected error for %q: %v", spec.fields, err)
+			}
+
+			counter := newRunCounter(spec, schedule)
+
+			var listed, searched []time.Time
+
+			counter.walk(from, to, func(run time.Time) { listed = append(listed, run) })
+			counter.search(from, to, func(run time.Time) { searched = append(searched, run) })
+
+			if count := counter.count(from, to); count != len(searched) || !slices.EqualFunc(listed, searched, time.Time.Equal) {
+				t.Errorf("count(%q) in %s = %d with %d listed, expected %d", spec.fields, zone, count, len(listed), len(searched))
+			}
+		}
+	}
+}
+
+// TestRunCounterIrregularClocks verifies runs on days the clocks skip
+// midnight or change by half an hour, where every minute whose clock
+// matches the fields runs.
+func TestRunCounterIrregularClocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		zone     string
+		fields   []string
+		expected int
+	}{
+		// Midnight is skipped on November 4, a Sunday, which runs neither
+		{"America/Sao_Paulo", []string{"0", "9", "*/2", "*", "1-5"}, 3},
+		{"America/Sao_Paulo", []string{"30", "*", "*", "*", "*"}, 4*24 - 1},
+		// The clocks go forward from 02:00 to 02:30 on October 7
+		{"Australia/Lord_Howe", []string{"0", "12", "*", "*", "*"}, 4},
+		{"Australia/Lord_Howe", []string{"15", "2", "*", "*", "*"}, 3},
+	}
+
+	for _, test := range tests {
+		location, err := time.LoadLocation(test.zone)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		from := time.Date(2018, time.November, 2, 0, 0, 0, 0, location)
+		if test.zone == "Australia/Lord_Howe" {
+			from = time.Date(2018, time.October, 5, 0, 0, 0, 0, location)
+		}
+
+		spec := cronSpec{fields: test.fields}
+
+		schedule, err := specSchedule(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if count := newRunCounter(spec, schedule).count(from, from.AddDate(0, 0, 4)); count != test.expected {
+			t.Errorf("count(%q) in %s = %d, expected %d", test.fields, test.zone, count, test.expected)
+		}
+	}
+}
+
+// TestRunCounterCentury verifies that a century of runs every minute is
+// counted exactly, the clocks going back and forward evening out each year.
+func TestRunCounterCentury(t *testing.T) {
+	t.Parallel()
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := cronSpec{fields: []string{"*", "*", "*", "*", "*"}}
+
+	schedule, err := specSchedule(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2000, time.January, 1, 0, 0, 0, 0, location)
+	to := time.Date(2100, time.January, 1, 0, 0, 0, 0, location)
+
+	if count := newRunCounter(spec, schedule).count(from, to); count != 36525*24*60 {
+		t.Errorf("Expected %d runs, got %d", 36525*24*60, count)
+	}
+}
+
+// TestRunCount verifies counts over ranges given as times and durations,
+// the year field, listing, and usage errors.
+func TestRunCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"--from", "2025-01-01", "--to", "2026-01-01", "--timezone", "UTC", "0 0 * * *"}, "365\n"},
+		{[]string{"--from", "2000-01-01", "--to", "2100-01-01", "--timezone", "UTC", "0 0 29 2 *"}, "25\n"},
+		{[]string{"--from", "2025-06-01", "--to", "24h", "--timezone", "UTC", "*/15 * * * *"}, "96\n"},
+		{[]string{"--from", "2025-01-01", "--to", "2035-01-01", "--timezone", "UTC", "--dialect", "aws", "cron(0 0 1 1 ? 2025-2035/5)"}, "2\n"},
+		{
+			[]string{"--from", "2025-06-01 10:00", "--to", "2025-06-01 12:00", "--timezone", "UTC", "--list", "0 * * * *"},
+			"2025-06-01T10:00:00Z\n2025-06-01T11:00:00Z\n",
+		},
+	}
+
+	for _, test := range tests {
+		var stdout bytes.Buffer
+		if err := runCount(test.args, &stdout, io.Discard); err != nil || stdout.String() != test.expected {
+			t.Errorf("runCount(%q) = %q, %v, expected %q", test.args, stdout.String(), err, test.expected)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"0 0 * * *"},
+		{"--to", "soon", "0 0 * * *"},
+		{"--from", "2025-01-02", "--to", "2025-01-01", "0 0 * * *"},
+		{"--from", "yesterday", "--to", "24h", "0 0 * * *"},
+	} {
+		if err := runCount(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runCount(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
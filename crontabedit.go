@@ -0,0 +1,386 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	noCrontabMessage = "no crontab for" // What crontab -l prints for a user without a crontab
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrCrontabCommand is returned when loading or installing a crontab fails
+	ErrCrontabCommand = errors.New("crontab command failed")
+	// ErrCrontabChanged is returned when the crontab changed while it was being edited
+	ErrCrontabChanged = errors.New("crontab changed since it was loaded")
+
+	// Account names edit passes to sudo and ssh; anything else could inject options or shell syntax
+	crontabUserPattern = regexp.MustCompile(`^[A-Za-z0-9_.][A-Za-z0-9_.-]*$`)
+)
+
+// crontabTarget is whose crontab edit loads and writes back, and watch shows
+type crontabTarget struct {
+	user string // Account whose crontab is edited through sudo, "" for your own
+	host string // Host reached with ssh, e.g. deploy@server, "" for this machine
+}
+
+// String names the crontab for messages, e.g. "www-data on deploy@server"
+func (target crontabTarget) String() string {
+	name := "your crontab"
+	if target.user != "" {
+		name = target.user
+	}
+
+	if target.host != "" {
+		name += " on " + target.host
+	}
+
+	return name
+}
+
+// validate rejects names that could smuggle options or shell syntax into
+// sudo or ssh
+func (target crontabTarget) validate() error {
+	if target.user != "" && !crontabUserPattern.MatchString(target.user) {
+		return fmt.Errorf("%w: --user %q is not a valid account name", ErrUsage, target.user)
+	}
+
+	if strings.HasPrefix(target.host, "-") {
+		return fmt.Errorf("%w: --host %q must not start with -", ErrUsage, target.host)
+	}
+
+	return nil
+}
+
+// argv builds the command line running crontab with args for the target:
+// sudo for another user, and ssh for another host
+func (target crontabTarget) argv(args ...string) []string {
+	argv := append([]string{"crontab"}, args...)
+	if target.user != "" {
+		argv = append([]string{"sudo", "crontab", "-u", target.user}, args...)
+	}
+
+	if target.host != "" {
+		argv = append([]string{"ssh", target.host}, argv...)
+	}
+
+	return argv
+}
+
+// commandRunner runs a command line with stdin and returns its stdout
+type commandRunner func(argv []string, stdin string) (string, error)
+
+// execRunner runs a command line, reporting its stderr when it fails. Password
+// prompts from sudo and ssh go to the terminal directly.
+func execRunner(argv []string, stdin string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(argv[0], argv[1:]...) //nolint:gosec // Built from a fixed command and validated names
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("%w: %s: %s", ErrCrontabCommand, strings.Join(argv, " "),
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
+
+// loadCrontab reads the target's crontab. A user without a crontab has an
+// empty one.
+func loadCrontab(target crontabTarget, run commandRunner) (string, error) {
+	text, err := run(target.argv("-l"), "")
+	if err != nil && strings.Contains(err.Error(), noCrontabMessage) {
+		return "", nil
+	}
+
+	return text, err
+}
+
+// installCrontab replaces the target's crontab with text
+func installCrontab(target crontabTarget, run commandRunner, text string) error {
+	_, err := run(target.argv("-"), text)
+
+	return err
+}
+
+// crontabDocument is a crontab being edited, with each job's schedule
+// rewritten in place and every other byte kept as it was
+type crontabDocument struct {
+	header  []string       // Variable lines added above the crontab
+	syntax  *crontabSyntax // Crontab lines, with edited jobs rewritten
+	sources []int          // Index of each job's line
+	removed map[int]bool   // Lines of deleted jobs, left out of the text
+}
+
+// apply rewrites a job's schedule and command, keeping the spacing and line
+// ending around them. A schedule that still reads the same, such as @daily
+// for 0 0 * * *, is left as written.
+func (doc *crontabDocument) apply(index int, expr, command string) {
+	line := &doc.syntax.lines[doc.sources[index]]
+
+	current, err := splitRawExpression(strings.Join(line.fields(), " "))
+	if err != nil || strings.Join(current, " ") != expr {
+		line.setSchedule(strings.Fields(expr))
+	}
+
+	line.setCommand(command)
+}
+
+// appendLine adds a line at the end of the crontab and returns its index.
+// The last line has no line ending, so a non-empty one is given one first.
+func (doc *crontabDocument) appendLine(text string) int {
+	if last := doc.syntax.lines[len(doc.syntax.lines)-1].String(); last != "" {
+		doc.syntax.lines[len(doc.syntax.lines)-1] = parseCrontabSyntaxLine(last + "\n")
+		doc.syntax.lines = append(doc.syntax.lines, crontabSyntaxLine{})
+	}
+
+	index := len(doc.syntax.lines) - 1
+	doc.syntax.lines[index] = parseCrontabSyntaxLine(text + "\n")
+	doc.syntax.lines = append(doc.syntax.lines, crontabSyntaxLine{})
+
+	return index
+}
+
+// line returns the text of a crontab line, without its line ending
+func (doc *crontabDocument) line(index int) string {
+	return strings.TrimSuffix(strings.TrimSuffix(doc.syntax.lines[index].String(), "\n"), "\r")
+}
+
+// remove leaves a deleted job's line out of the text, or puts it back
+func (doc *crontabDocument) remove(index int, deleted bool) {
+	if deleted {
+		doc.removed[doc.sources[index]] = true
+	} else {
+		delete(doc.removed, doc.sources[index])
+	}
+}
+
+// text joins the crontab's lines back together under any added variables,
+// without deleted jobs
+func (doc *crontabDocument) text() string {
+	var builder strings.Builder
+
+	for _, line := range doc.header {
+		builder.WriteString(line + "\n")
+	}
+
+	for index := range doc.syntax.lines {
+		if !doc.removed[index] {
+			builder.WriteString(doc.syntax.lines[index].String())
+		}
+	}
+
+	return builder.String()
+}
+
+// newCrontabBrowser reads the jobs of a crontab for browsing
+func newCrontabBrowser(target crontabTarget, text string) (*jobBrowser, *crontabDocument, error) {
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &crontabDocument{syntax: parseCrontabSyntax(text), removed: make(map[int]bool)}
+	entries := make([]browserJob, 0, len(jobs))
+
+	for _, job := range jobs {
+		entry := browserJob{name: job.name, expr: job.expr, location: time.Local}
+		if line, err := parseCrontabLine(doc.line(job.source)); err == nil {
+			entry.command = line.command
+		}
+
+		doc.sources = append(doc.sources, job.source)
+		entries = append(entries, entry)
+	}
+
+	browser := newJobBrowser(target.String(), "write back", entries, doc.apply)
+	browser.remove = doc.remove
+	browser.doc = doc
+	browser.checkPaths = target.host == ""
+
+	if drifted := browser.driftedComments(); len(drifted) > 0 {
+		browser.status = fmt.Sprintf("%d description comments no longer match their schedules", len(drifted))
+	}
+
+	return browser, doc, nil
+}
+
+// browseJobs runs a job browser and reports whether w was pressed to keep
+// the changes
+func browseJobs(browser *jobBrowser) (bool, error) {
+	calendars, err := configCalendars(defaultConfigPath())
+	if err != nil {
+		return false, err
+	}
+
+	browser.calendars = calendars
+
+	final, err := tea.NewProgram(browser).Run()
+	if err != nil {
+		return false, fmt.Errorf("app execution failed: %w", err)
+	}
+
+	result, ok := final.(*jobBrowser)
+
+	return ok && result.save, nil
+}
+
+// editCrontabFile lets the jobs of a crontab file be browsed and edited,
+// writing the file back in place when the changes are kept. This is what
+// crontab -e needs from $EDITOR: it hands over a temporary copy of the
+// crontab and installs it afterwards if the file changed, so a file left
+// alone means no changes. A new or empty crontab opens without jobs, for
+// the first to be added. The file is backed up under root before it is
+// written and committed to history after.
+func editCrontabFile(path, root string, history historyRepo, stdout io.Writer, browse func(*jobBrowser) (bool, error)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	original, err := readCrontab(path)
+	if err != nil {
+		return err
+	}
+
+	browser, doc, err := newCrontabBrowser(crontabTarget{}, original)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	browser.action = "save"
+
+	if save, err := browse(browser); err != nil || !save {
+		return err
+	}
+
+	// crontab rejects a last line without a newline
+	text := doc.text()
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	key := fileBackupKey(path)
+	if err := saveBackup(root, key, original, time.Now()); err != nil {
+		return fmt.Errorf("%w; nothing was written", err)
+	}
+
+	if err := os.WriteFile(path, []byte(text), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	fmt.Fprintf(stdout, "wrote %s to %s\n", browser.summary(), path)
+
+	if err := history.record(key, text); err != nil {
+		return fmt.Errorf("%s was written, but not committed to history: %w", path, err)
+	}
+
+	return nil
+}
+
+// isCrontabFile reports whether an argument names an existing regular file,
+// as when crontab -e runs crontab-guru as $EDITOR on its temporary copy
+func isCrontabFile(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.Mode().IsRegular()
+}
+
+// runEdit loads a crontab, possibly of another user through sudo or on
+// another host through ssh, lets its jobs be browsed and edited, and writes
+// it back. Given a file instead, it edits the file in place, which makes it
+// a drop-in $EDITOR for crontab -e.
+func runEdit(args []string, stdout, stderr io.Writer) error {
+	var target crontabTarget
+
+	flags := flag.NewFlagSet("edit", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&target.user, "user", "", "edit this user's crontab through sudo")
+	flags.StringVar(&target.host, "host", "", "edit the crontab on this host through ssh, e.g. deploy@server")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 1 || (len(positional) == 1 && target != (crontabTarget{})) {
+		return fmt.Errorf("%w: crontab-guru edit [--user USER] [--host USER@HOST] [FILE]", ErrUsage)
+	}
+
+	if err := target.validate(); err != nil {
+		return err
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("%w: crontab-guru edit needs a terminal", ErrUsage)
+	}
+
+	root, history, err := openCrontabKeeping()
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 1 {
+		return editCrontabFile(positional[0], root, history, stdout, browseJobs)
+	}
+
+	original, err := loadCrontab(target, execRunner)
+	if err != nil {
+		return err
+	}
+
+	browser, doc, err := newCrontabBrowser(target, original)
+	if err != nil {
+		return fmt.Errorf("%s: %w", target, err)
+	}
+
+	if save, err := browseJobs(browser); err != nil || !save {
+		return err
+	}
+
+	// Refuse to overwrite changes someone else made in the meantime
+	current, err := loadCrontab(target, execRunner)
+	if err != nil {
+		return err
+	}
+
+	if current != original {
+		return fmt.Errorf("%w: %s; nothing was written", ErrCrontabChanged, target)
+	}
+
+	if err := saveBackup(root, target.String(), current, time.Now()); err != nil {
+		return fmt.Errorf("%w; nothing was written", err)
+	}
+
+	if err := installCrontab(target, execRunner, doc.text()); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "wrote %s to %s\n", browser.summary(), target)
+
+	if err := history.record(target.String(), doc.text()); err != nil {
+		return fmt.Errorf("%s was written, but not committed to history: %w", target, err)
+	}
+
+	return nil
+}
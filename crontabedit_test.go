@@ -0,0 +1,406 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// sendBrowserKey sends a key to the job browser
+func sendBrowserKey(t *testing.T, b *jobBrowser, msg tea.KeyMsg) tea.Cmd {
+	t.Helper()
+
+	newModel, cmd := b.Update(msg)
+	if newModel != b {
+		t.Fatalf("Expected the same browser, got %T", newModel)
+	}
+
+	return cmd
+}
+
+// TestCrontabTargetArgv verifies that another user's crontab goes through
+// sudo and another host's through ssh.
+func TestCrontabTargetArgv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		target   crontabTarget
+		expected string
+		name     string
+	}{
+		{crontabTarget{}, "crontab -l", "your crontab"},
+		{crontabTarget{user: "www-data"}, "sudo crontab -u www-data -l", "www-data"},
+		{crontabTarget{host: "deploy@server"}, "ssh deploy@server crontab -l", "your crontab on deploy@server"},
+		{crontabTarget{user: "www-data", host: "server"}, "ssh server sudo crontab -u www-data -l", "www-data on server"},
+	}
+
+	for _, tt := range tests {
+		if got := strings.Join(tt.target.argv("-l"), " "); got != tt.expected {
+			t.Errorf("argv(%+v) = %q, expected %q", tt.target, got, tt.expected)
+		}
+
+		if got := tt.target.String(); got != tt.name {
+			t.Errorf("String(%+v) = %q, expected %q", tt.target, got, tt.name)
+		}
+	}
+}
+
+// TestLoadAndInstallCrontab verifies that a missing crontab loads as empty,
+// that other failures are reported, and that installing pipes the text to
+// crontab -.
+func TestLoadAndInstallCrontab(t *testing.T) {
+	t.Parallel()
+
+	target := crontabTarget{user: "www-data"}
+
+	text, err := loadCrontab(target, func([]string, string) (string, error) {
+		return "", fmt.Errorf("%w: no crontab for www-data", ErrCrontabCommand)
+	})
+	if err != nil || text != "" {
+		t.Errorf("Expected an empty crontab, got %q, %v", text, err)
+	}
+
+	_, err = loadCrontab(target, func([]string, string) (string, error) {
+		return "", fmt.Errorf("%w: sudo: a password is required", ErrCrontabCommand)
+	})
+	if !errors.Is(err, ErrCrontabCommand) {
+		t.Errorf("Expected ErrCrontabCommand, got %v", err)
+	}
+
+	var (
+		ran   []string
+		input string
+	)
+
+	err = installCrontab(target, func(argv []string, stdin string) (string, error) {
+		ran, input = argv, stdin
+
+		return "", nil
+	}, "0 2 * * * backup.sh\n")
+	if err != nil || !slices.Equal(ran, []string{"sudo", "crontab", "-u", "www-data", "-"}) ||
+		input != "0 2 * * * backup.sh\n" {
+		t.Errorf("Unexpected install of %q with %v: %v", input, ran, err)
+	}
+}
+
+// TestCrontabBrowserEdit verifies that editing a job rewrites only its
+// schedule, keeping the command and every other line, and that w asks for
+// the crontab to be written back.
+func TestCrontabBrowserEdit(t *testing.T) {
+	t.Parallel()
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, "MAILTO=ops\n# nightly\n0 2 * * * backup.sh --full\n*/5 * * * * poll.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(b.jobs) != 2 || !strings.Contains(b.View(), "backup.sh --full") {
+		t.Fatalf("Expected two jobs listed, got:\n%s", b.View())
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.editor == nil || b.editor.buildCronExpression() != "*/5 * * * *" || b.editor.lineCommand != "poll.sh" {
+		t.Fatalf("Expected the editor on poll.sh, got %+v", b.editor)
+	}
+
+	b.editor.setExpression("*/10 * * * *")
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	expected := "MAILTO=ops\n# nightly\n0 2 * * * backup.sh --full\n*/10 * * * * poll.sh\n"
+	if b.editor != nil || len(b.edited) != 1 || doc.text() != expected {
+		t.Errorf("Expected the poll.sh schedule rewritten, got %q", doc.text())
+	}
+
+	if cmd := sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}); cmd == nil || !b.save {
+		t.Error("Expected w to quit and save")
+	}
+}
+
+// TestCrontabBrowserAdd verifies that a new job is appended after the last
+// line, and that one typed without a command is not added.
+func TestCrontabBrowserAdd(t *testing.T) {
+	t.Parallel()
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	b.editor.rawInput.SetValue("*/5 * * * *")
+	b.editor.syncFieldsFromRaw()
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if len(b.jobs) != 1 || !strings.Contains(b.status, "not added") {
+		t.Errorf("Expected a job without a command not added, got %d jobs (%s)", len(b.jobs), b.status)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	b.editor.rawInput.SetValue("*/5 * * * * poll.sh")
+	b.editor.syncFieldsFromRaw()
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if doc.text() != "0 2 * * * backup.sh\n*/5 * * * * poll.sh\n" || b.cursor != 1 {
+		t.Errorf("Expected poll.sh appended and selected, got %q", doc.text())
+	}
+}
+
+// TestCrontabBrowserTrash verifies that deleted jobs leave the list and the
+// crontab text, that the trash lists them, and that restoring puts them
+// back where they were.
+func TestCrontabBrowserTrash(t *testing.T) {
+	t.Parallel()
+
+	text := "# nightly\n0 2 * * * backup.sh\n*/5 * * * * poll.sh\n0 9 * * 1 report.sh\n"
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, text)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	del := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, del)
+
+	if doc.text() != "# nightly\n0 2 * * * backup.sh\n0 9 * * 1 report.sh\n" || b.cursor != 2 ||
+		strings.Contains(b.View(), "*/5") || !strings.Contains(b.View(), "trash (1)") {
+		t.Errorf("Expected poll.sh deleted and report.sh selected, got %q at %d:\n%s", doc.text(), b.cursor, b.View())
+	}
+
+	// Deleting the last job selects the one before it, skipping the trash
+	sendBrowserKey(t, b, del)
+
+	if b.cursor != 0 || len(b.trash) != 2 {
+		t.Errorf("Expected backup.sh selected, got %d with trash %v", b.cursor, b.trash)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	view := b.View()
+	if !b.showTrash || strings.Index(view, "report.sh") > strings.Index(view, "poll.sh") || strings.Contains(view, "backup.sh") {
+		t.Fatalf("Expected the trash newest first, got:\n%s", view)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+
+	if doc.text() != "# nightly\n0 2 * * * backup.sh\n*/5 * * * * poll.sh\n" || b.cursor != 1 || !b.showTrash {
+		t.Errorf("Expected poll.sh restored, got %q at %d", doc.text(), b.cursor)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+
+	if doc.text() != text || b.showTrash || b.changed() {
+		t.Errorf("Expected the crontab restored and the trash closed, got %q", doc.text())
+	}
+
+	k8s := newJobBrowser("CronJobs", "print the manifest", []browserJob{{name: "web/report", expr: "0 9 * * 1", location: time.UTC}},
+		func(int, string, string) {})
+	sendBrowserKey(t, k8s, del)
+
+	if len(k8s.trash) != 0 || strings.Contains(k8s.View(), "d: delete") {
+		t.Error("Expected a browser without remove not to delete")
+	}
+}
+
+// TestCrontabBrowserRejectsInvalid verifies that an invalid expression is not
+// written into the crontab.
+func TestCrontabBrowserRejectsInvalid(t *testing.T) {
+	t.Parallel()
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+	b.editor.setExpression("61 2 * * *")
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if len(b.edited) != 0 || doc.text() != "0 2 * * * backup.sh\n" || !strings.HasPrefix(b.status, "not applied") {
+		t.Errorf("Expected the change rejected, got %q (%s)", doc.text(), b.status)
+	}
+
+	if cmd := sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}); cmd != nil || b.save {
+		t.Error("Expected w without changes to do nothing")
+	}
+}
+
+// TestCrontabBrowserQuitConfirm verifies that quitting with unsaved changes
+// takes a second q.
+func TestCrontabBrowserQuitConfirm(t *testing.T) {
+	t.Parallel()
+
+	b, _, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	b.edited[0] = true
+	quit := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}
+
+	if cmd := sendBrowserKey(t, b, quit); cmd != nil || !strings.Contains(b.status, "unsaved changes") {
+		t.Errorf("Expected a warning first, got %q", b.status)
+	}
+
+	if cmd := sendBrowserKey(t, b, quit); cmd == nil || b.save {
+		t.Error("Expected the second q to quit without saving")
+	}
+}
+
+// TestRunEditUsage verifies that names which could smuggle options or shell
+// syntax into sudo or ssh are rejected, and that a file cannot be combined
+// with --user or --host.
+func TestRunEditUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{
+		{"--user", "-oProxyCommand=x"},
+		{"--user", "www-data;reboot"},
+		{"--host", "-oProxyCommand=x"},
+		{"--user", "www-data", "crontab.txt"},
+		{"a.txt", "b.txt"},
+	} {
+		if err := runEdit(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runEdit(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
+
+// TestEditCrontabFile verifies that a crontab file is written back in place
+// with a final newline when the changes are kept, and left alone otherwise.
+func TestEditCrontabFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab.XXXXXX")
+	if err := os.WriteFile(path, []byte("MAILTO=ops\n0 2 * * * backup.sh\n*/5 * * * * poll.sh"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	root := t.TempDir()
+
+	discard := func(*jobBrowser) (bool, error) { return false, nil }
+	if err := editCrontabFile(path, root, historyRepo{}, io.Discard, discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); !strings.HasSuffix(string(data), "poll.sh") {
+		t.Errorf("Expected the file left alone, got %q", data)
+	}
+
+	var stdout strings.Builder
+
+	err := editCrontabFile(path, root, historyRepo{}, &stdout, func(b *jobBrowser) (bool, error) {
+		sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+		sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+		b.editor.setExpression("*/10 * * * *")
+		sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "MAILTO=ops\n0 2 * * * backup.sh\n*/10 * * * * poll.sh\n" {
+		t.Errorf("Unexpected file %q", data)
+	}
+
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0o600 || !strings.Contains(stdout.String(), "wrote 1 changed and 0 deleted jobs") {
+		t.Errorf("Unexpected mode %v or output %q", info.Mode(), stdout.String())
+	}
+
+	backups, err := listBackups(root, fileBackupKey(path))
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected one backup, got %v, %v", backups, err)
+	}
+
+	if data, _ := os.ReadFile(backups[0].path); !strings.HasSuffix(string(data), "*/5 * * * * poll.sh") {
+		t.Errorf("Expected the original backed up, got %q", data)
+	}
+}
+
+// TestEditCrontabFileEmpty verifies that a crontab without jobs still opens
+// in the browser, and that a job typed as a whole line in the editor is
+// appended to it.
+func TestEditCrontabFileEmpty(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	if err := os.WriteFile(path, []byte("# no jobs yet"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout strings.Builder
+
+	err := editCrontabFile(path, t.TempDir(), historyRepo{}, &stdout, func(b *jobBrowser) (bool, error) {
+		if len(b.jobs) != 0 || !strings.Contains(b.View(), "a to add one") {
+			t.Errorf("Expected an empty job list, got:\n%s", b.View())
+		}
+
+		sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+
+		if b.editor == nil || !b.editor.rawMode {
+			t.Fatal("Expected the editor open in raw mode")
+		}
+
+		b.editor.rawInput.SetValue("0 9 * * 1-5 report.sh")
+		b.editor.syncFieldsFromRaw()
+		sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+		if len(b.jobs) != 1 || b.jobs[0].command != "report.sh" {
+			t.Errorf("Expected report.sh added, got %+v (%s)", b.jobs, b.status)
+		}
+
+		return sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}) != nil && b.save, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "# no jobs yet\n0 9 * * 1-5 report.sh\n" {
+		t.Errorf("Unexpected file %q", data)
+	}
+
+	if !strings.Contains(stdout.String(), "wrote 1 added, 0 changed and 0 deleted jobs") {
+		t.Errorf("Unexpected output %q", stdout.String())
+	}
+}
+
+// TestRunCommandEditor verifies that a lone file argument, as crontab -e
+// passes to $EDITOR, is edited, while an unknown name is still an unknown
+// command.
+func TestRunCommandEditor(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	if err := os.WriteFile(path, []byte("0 2 * * * backup.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tests have no terminal, so reaching edit shows as its terminal check
+	if err := runCommand([]string{path}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) ||
+		!strings.Contains(err.Error(), "edit needs a terminal") {
+		t.Errorf("Expected the file to be edited, got %v", err)
+	}
+
+	if err := runCommand([]string{"nonexistent"}, io.Discard, io.Discard); err == nil ||
+		!strings.Contains(err.Error(), "unknown command") {
+		t.Errorf("Expected an unknown command, got %v", err)
+	}
+}
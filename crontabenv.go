@@ -0,0 +1,338 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	cronDefaultShell = "/bin/sh"       // Shell cron runs commands with when SHELL is not set
+	cronDefaultPath  = "/usr/bin:/bin" // PATH cron runs commands with when PATH is not set
+)
+
+//nolint:gochecknoglobals
+var (
+	// crontabEnvNames are the variables cron itself reads, in the order the
+	// variables form lists them
+	crontabEnvNames = []string{"MAILTO", "SHELL", "PATH"}
+
+	// crontabEnvDefaults describe what cron does when a variable is not set
+	crontabEnvDefaults = map[string]string{
+		"MAILTO": "the crontab's owner",
+		"SHELL":  cronDefaultShell,
+		"PATH":   cronDefaultPath,
+	}
+
+	// shellBuiltins are commands the shell runs itself, so they are never
+	// looked up in PATH
+	shellBuiltins = []string{
+		".", ":", "[", "alias", "bg", "break", "cd", "command", "continue", "echo", "eval", "exec", "exit",
+		"export", "false", "for", "if", "printf", "pwd", "read", "return", "set", "shift", "source", "test",
+		"trap", "true", "type", "ulimit", "umask", "unset", "until", "wait", "while",
+	}
+)
+
+// envForm edits the variables of a crontab, one input per name in
+// crontabEnvNames
+type envForm struct {
+	inputs []textinput.Model // Value of each variable
+	focus  int               // Index of the focused input
+}
+
+// variableDeclaration splits a crontab line that sets one of
+// crontabEnvNames into its name and value, with quotes around the value
+// removed
+func variableDeclaration(line string) (string, string, bool) {
+	name, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+	if !ok || !slices.Contains(crontabEnvNames, name) {
+		return "", "", false
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+
+	return name, value, true
+}
+
+// variable returns the value of the first declaration of a variable, and
+// whether the crontab declares it
+func (doc *crontabDocument) variable(name string) (string, bool) {
+	for _, line := range doc.header {
+		if declared, value, ok := variableDeclaration(line); ok && declared == name {
+			return value, true
+		}
+	}
+
+	for index := range doc.syntax.lines {
+		if declared, value, ok := variableDeclaration(doc.line(index)); ok && declared == name && !doc.removed[index] {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// setVariable rewrites the first declaration of a variable, or adds one at
+// the top of the crontab. An empty value removes the declaration, except
+// for MAILTO, where it turns mail off and is written as MAILTO="".
+func (doc *crontabDocument) setVariable(name, value string) {
+	line := name + "=" + value
+	if value == "" || strings.ContainsAny(value, " \t") {
+		line = name + "=" + `"` + value + `"`
+	}
+
+	keep := value != "" || name == "MAILTO"
+
+	declares := func(line string) bool {
+		declared, _, ok := variableDeclaration(line)
+
+		return ok && declared == name
+	}
+
+	if index := slices.IndexFunc(doc.header, declares); index >= 0 {
+		if keep {
+			doc.header[index] = line
+		} else {
+			doc.header = slices.Delete(doc.header, index, index+1)
+		}
+
+		return
+	}
+
+	for index := range doc.syntax.lines {
+		if doc.removed[index] || !declares(doc.line(index)) {
+			continue
+		}
+
+		if keep {
+			doc.syntax.setLine(index, line)
+		} else {
+			doc.removed[index] = true
+		}
+
+		return
+	}
+
+	if keep {
+		doc.header = append(doc.header, line)
+	}
+}
+
+// pathAt returns the PATH cron runs a line's command with: the last PATH
+// declaration before it, or cron's default
+func (doc *crontabDocument) pathAt(lineIndex int) string {
+	path := cronDefaultPath
+
+	for _, line := range doc.header {
+		if name, value, ok := variableDeclaration(line); ok && name == "PATH" {
+			path = value
+		}
+	}
+
+	for index := range lineIndex {
+		if name, value, ok := variableDeclaration(doc.line(index)); ok && name == "PATH" && !doc.removed[index] {
+			path = value
+		}
+	}
+
+	return path
+}
+
+// commandProgram returns the program a command starts, skipping variable
+// assignments before it, or "" for a shell builtin or a command starting
+// with shell syntax that cannot be checked
+func commandProgram(command string) string {
+	for _, token := range strings.Fields(command) {
+		if isEnvAssignment(token) {
+			continue
+		}
+
+		if slices.Contains(shellBuiltins, token) || strings.ContainsAny(token, "$`(){}<>|&;'\"*?~") {
+			return ""
+		}
+
+		return token
+	}
+
+	return ""
+}
+
+// findProgram reports whether a program runs under path: a path containing
+// "/" must be an executable file, relative ones counted from home where
+// cron starts commands, and a bare name must be one in a PATH directory
+func findProgram(program, path, home string) bool {
+	executable := func(file string) bool {
+		info, err := os.Stat(file)
+
+		return err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0o111 != 0
+	}
+
+	if strings.Contains(program, "/") {
+		if !filepath.IsAbs(program) {
+			program = filepath.Join(home, program)
+		}
+
+		return executable(program)
+	}
+
+	for dir := range strings.SplitSeq(path, ":") {
+		if dir != "" && executable(filepath.Join(dir, program)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pathWarnings lists the jobs whose program cannot be found under the PATH
+// in effect for them, leaving out deleted jobs
+func (b *jobBrowser) pathWarnings() []string {
+	if b.doc == nil || !b.checkPaths {
+		return nil
+	}
+
+	home, _ := os.UserHomeDir()
+
+	var warnings []string
+
+	for index, job := range b.jobs {
+		program := commandProgram(job.command)
+		if program == "" || slices.Contains(b.trash, index) {
+			continue
+		}
+
+		if problem := programProblem(program, b.doc.pathAt(b.doc.sources[index]), home); problem != "" {
+			warnings = append(warnings, fmt.Sprintf("job %d (%s): %s", index+1, job.name, problem))
+		}
+	}
+
+	return warnings
+}
+
+// renderVariables shows the variables cron reads and their values, with
+// cron's default for those the crontab does not set
+func (b *jobBrowser) renderVariables() string {
+	parts := make([]string, 0, len(crontabEnvNames))
+
+	for _, name := range crontabEnvNames {
+		if value, ok := b.doc.variable(name); ok {
+			parts = append(parts, name+"="+value)
+		} else {
+			parts = append(parts, fmt.Sprintf("%s: %s", name, crontabEnvDefaults[name]))
+		}
+	}
+
+	return labelStyle.Render(strings.Join(parts, " · "))
+}
+
+// openEnvForm opens the variables form on the crontab's current values
+func (b *jobBrowser) openEnvForm() tea.Cmd {
+	form := &envForm{}
+
+	for _, name := range crontabEnvNames {
+		input := textinput.New()
+		input.Prompt = fmt.Sprintf("%-7s", name)
+		input.Placeholder = crontabEnvDefaults[name]
+		input.Width = max(20, b.width-12)
+
+		if value, ok := b.doc.variable(name); ok {
+			input.SetValue(value)
+		}
+
+		form.inputs = append(form.inputs, input)
+	}
+
+	b.envForm = form
+	b.status = ""
+
+	return form.inputs[0].Focus()
+}
+
+// updateEnvForm moves between the variables, applies them on enter, and
+// closes the form without changes on esc
+func (b *jobBrowser) updateEnvForm(msg tea.Msg) (tea.Model, tea.Cmd) {
+	form := b.envForm
+
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "tab", "down":
+			return b, form.move(1)
+		case "shift+tab", "up":
+			return b, form.move(-1)
+		case "enter":
+			b.applyEnvForm()
+
+			return b, nil
+		case "esc":
+			b.envForm = nil
+
+			return b, nil
+		case "ctrl+c":
+			return b, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	form.inputs[form.focus], cmd = form.inputs[form.focus].Update(msg)
+
+	return b, cmd
+}
+
+// move focuses the next or previous variable, wrapping around
+func (f *envForm) move(delta int) tea.Cmd {
+	f.inputs[f.focus].Blur()
+	f.focus = (f.focus + delta + len(f.inputs)) % len(f.inputs)
+
+	return f.inputs[f.focus].Focus()
+}
+
+// applyEnvForm writes the variables that changed into the crontab
+func (b *jobBrowser) applyEnvForm() {
+	var changed []string
+
+	for index, name := range crontabEnvNames {
+		value := strings.TrimSpace(b.envForm.inputs[index].Value())
+		if current, _ := b.doc.variable(name); current == value {
+			continue
+		}
+
+		b.doc.setVariable(name, value)
+		changed = append(changed, name)
+	}
+
+	b.envForm = nil
+
+	if len(changed) > 0 {
+		b.envEdited = true
+		b.status = "changed " + strings.Join(changed, ", ")
+	}
+}
+
+// renderEnvForm renders the variables form
+func (b *jobBrowser) renderEnvForm() string {
+	var builder strings.Builder
+
+	builder.WriteString(titleStyle.Render("crontab guru: variables of "+b.title) + "\n")
+
+	for _, input := range b.envForm.inputs {
+		builder.WriteString(input.View() + "\n")
+	}
+
+	builder.WriteString("\n" + labelStyle.Render(`an empty SHELL or PATH is removed to use the default; an empty MAILTO sends no mail`) + "\n")
+	builder.WriteString("\n" + helpStyle.Render("tab: next · enter: apply · esc: cancel"))
+
+	return builder.String()
+}
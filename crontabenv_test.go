@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestCrontabVariables verifies that variables are read however they are
+// quoted, rewritten where they are declared, added at the top when they are
+// not, and removed when emptied.
+func TestCrontabVariables(t *testing.T) {
+	t.Parallel()
+
+	text := "SHELL='/bin/bash'\n0 2 * * * backup.sh\nPATH=/opt/bin\n0 3 * * * report.sh\n"
+
+	_, doc, err := newCrontabBrowser(crontabTarget{}, text)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if shell, ok := doc.variable("SHELL"); !ok || shell != "/bin/bash" {
+		t.Errorf("Expected SHELL /bin/bash, got %q (%v)", shell, ok)
+	}
+
+	if _, ok := doc.variable("MAILTO"); ok {
+		t.Error("Expected MAILTO not declared")
+	}
+
+	if first, second := doc.pathAt(doc.sources[0]), doc.pathAt(doc.sources[1]); first != cronDefaultPath || second != "/opt/bin" {
+		t.Errorf("Expected PATH %s then /opt/bin, got %s then %s", cronDefaultPath, first, second)
+	}
+
+	doc.setVariable("MAILTO", "")
+	doc.setVariable("PATH", "/usr/local/bin:/usr/bin")
+	doc.setVariable("SHELL", "")
+
+	expected := "MAILTO=\"\"\n0 2 * * * backup.sh\nPATH=/usr/local/bin:/usr/bin\n0 3 * * * report.sh\n"
+	if doc.text() != expected {
+		t.Errorf("Unexpected crontab %q, expected %q", doc.text(), expected)
+	}
+
+	doc.setVariable("MAILTO", "ops@example.com")
+
+	if !strings.HasPrefix(doc.text(), "MAILTO=ops@example.com\n0 2") {
+		t.Errorf("Expected the added MAILTO rewritten, got %q", doc.text())
+	}
+}
+
+// TestCommandProgram verifies that the program a command starts is found
+// past variable assignments, and that builtins and shell syntax are skipped.
+func TestCommandProgram(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"backup.sh --full":             "backup.sh",
+		"LANG=C /usr/bin/report > out": "/usr/bin/report",
+		"cd /srv && make":              "",
+		"$HOME/bin/sync":               "",
+		"(sleep 5; poll)":              "",
+		"":                             "",
+	}
+
+	for command, expected := range tests {
+		if program := commandProgram(command); program != expected {
+			t.Errorf("commandProgram(%q) = %q, expected %q", command, program, expected)
+		}
+	}
+}
+
+// TestFindProgram verifies that bare names are looked up in PATH, relative
+// paths from home, and that only executable files count.
+func TestFindProgram(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	bin := filepath.Join(dir, "bin")
+
+	if err := os.Mkdir(bin, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(bin, "backup"), []byte("#!/bin/sh\n"), 0o700); err != nil { //nolint:gosec // A test script must be executable
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(bin, "notes"), []byte("notes\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		program  string
+		path     string
+		expected bool
+	}{
+		{"backup", "/nonexistent:" + bin, true},
+		{"backup", "/nonexistent", false},
+		{"notes", bin, false},
+		{"bin/backup", "", true},
+		{filepath.Join(bin, "backup"), "", true},
+		{filepath.Join(bin, "missing"), bin, false},
+	}
+
+	for _, test := range tests {
+		if found := findProgram(test.program, test.path, dir); found != test.expected {
+			t.Errorf("findProgram(%q, %q) = %v, expected %v", test.program, test.path, found, test.expected)
+		}
+	}
+}
+
+// TestBrowserVariables verifies that the job list shows the crontab's
+// variables and warns about commands missing from PATH, and that the
+// variables form writes its changes into the crontab.
+func TestBrowserVariables(t *testing.T) {
+	t.Parallel()
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, "PATH=/nonexistent\n0 2 * * * backup.sh\n0 3 * * * cd /srv && make\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	view := b.View()
+	for _, expected := range []string{
+		"MAILTO: the crontab's owner · SHELL: /bin/sh · PATH=/nonexistent",
+		"command: job 1 (backup.sh): backup.sh is not in PATH /nonexistent",
+		"v: variables",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the job list, got:\n%s", expected, view)
+		}
+	}
+
+	if strings.Contains(view, "job 2") {
+		t.Errorf("Expected shell syntax not checked, got:\n%s", view)
+	}
+
+	b.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+
+	if b.envForm == nil || b.envForm.inputs[2].Value() != "/nonexistent" {
+		t.Fatal("Expected the variables form open on the crontab's values")
+	}
+
+	b.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ops")})
+	b.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.envForm != nil || !b.changed() || doc.text() != "MAILTO=ops\nPATH=/nonexistent\n0 2 * * * backup.sh\n0 3 * * * cd /srv && make\n" {
+		t.Errorf("Expected MAILTO added, got %q", doc.text())
+	}
+
+	b.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	b.Update(tea.KeyMsg{Type: tea.KeyTab})
+	b.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/bin/bash")})
+	b.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if _, ok := doc.variable("SHELL"); ok || b.envForm != nil {
+		t.Errorf("Expected esc to discard the form, got %q", doc.text())
+	}
+}
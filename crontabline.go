@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A token that reads as a cron field rather than the start of a command
+var cronFieldToken = regexp.MustCompile(`^[0-9*/,?LW#-]+$`) //nolint:gochecknoglobals
+
+// crontabLine is a pasted crontab entry split into its parts
+type crontabLine struct {
+	env     []string // Variable assignments before the schedule, e.g. MAILTO=ops
+	fields  []string // Minute, hour, day, month, and weekday fields, macros expanded
+	command string   // Command after the schedule, "" for a bare expression
+}
+
+// isEnvAssignment reports whether a crontab token sets a variable, such as
+// MAILTO=ops or CRON_TZ=Europe/Lisbon, rather than starting a schedule
+func isEnvAssignment(token string) bool {
+	name, _, ok := strings.Cut(token, "=")
+
+	return ok && name != "" && !strings.ContainsAny(name, "*/,-@")
+}
+
+// parseCrontabLine splits a crontab line into variable assignments, schedule,
+// and command. A bare expression is a line without a command. Pasting
+// several lines joins them with spaces, so variable lines pasted before the
+// entry are read as assignments. The command keeps its own spacing.
+func parseCrontabLine(text string) (crontabLine, error) {
+	var line crontabLine
+
+	rest := strings.TrimSpace(text)
+
+	nextToken := func() string {
+		end := strings.IndexAny(rest+" ", " \t")
+		token := rest[:end]
+		rest = strings.TrimLeft(rest[end:], " \t")
+
+		return token
+	}
+
+	for rest != "" && isEnvAssignment(strings.Fields(rest)[0]) {
+		line.env = append(line.env, nextToken())
+	}
+
+	count := numCronFields
+	if strings.HasPrefix(rest, "@") {
+		count = 1
+	}
+
+	schedule := make([]string, 0, count)
+	for rest != "" && len(schedule) < count {
+		schedule = append(schedule, nextToken())
+	}
+
+	fields, err := splitRawExpression(strings.Join(schedule, " "))
+	if err != nil {
+		return line, err
+	}
+
+	// A sixth field such as a seconds or year value is a mistake, not a command
+	if rest != "" && cronFieldToken.MatchString(strings.Fields(rest)[0]) {
+		return line, fmt.Errorf("%w: expected %d, got %d", ErrFieldCount, numCronFields, numCronFields+len(strings.Fields(rest)))
+	}
+
+	line.fields = fields
+	line.command = rest
+
+	return line, nil
+}
+
+// crontabLineText joins the fields with the pasted variables and command, so
+// the raw input shows the whole line
+func (m *model) crontabLineText() string {
+	parts := append(append([]string(nil), m.lineEnv...), m.buildCronExpression())
+	if m.lineCommand != "" {
+		parts = append(parts, m.lineCommand)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// renderCrontabLine shows the command and variables of a pasted crontab line
+// read-only under the expression
+func (m *model) renderCrontabLine() string {
+	if m.lineCommand == "" && len(m.lineEnv) == 0 {
+		return ""
+	}
+
+	var lines []string
+
+	if len(m.lineEnv) > 0 {
+		lines = append(lines, labelStyle.Render("env     ")+previewStyle.Render(strings.Join(m.lineEnv, " ")))
+	}
+
+	if m.lineCommand != "" {
+		lines = append(lines, labelStyle.Render("command ")+previewStyle.Render(m.lineCommand))
+	}
+
+	for _, warning := range m.commandWarnings() {
+		lines = append(lines, conflictStyle.Render("! "+warning))
+	}
+
+	return m.place(strings.Join(lines, "\n")) + "\n"
+}
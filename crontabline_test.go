@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestParseCrontabLine verifies splitting pasted crontab lines into
+// variables, schedule, and command, keeping the command's own spacing.
+func TestParseCrontabLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text     string
+		expected crontabLine
+	}{
+		{"*/5 * * * *", crontabLine{fields: []string{"*/5", "*", "*", "*", "*"}}},
+		{
+			"30 2 * * 1-5  /usr/bin/backup.sh --dest '/mnt/a  b' >> /var/log/backup.log 2>&1",
+			crontabLine{
+				fields:  []string{"30", "2", "*", "*", "1-5"},
+				command: "/usr/bin/backup.sh --dest '/mnt/a  b' >> /var/log/backup.log 2>&1",
+			},
+		},
+		{
+			"MAILTO=ops CRON_TZ=Europe/Lisbon @daily\tFOO=bar run.sh",
+			crontabLine{
+				env:     []string{"MAILTO=ops", "CRON_TZ=Europe/Lisbon"},
+				fields:  []string{"0", "0", "*", "*", "*"},
+				command: "FOO=bar run.sh",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parseCrontabLine(tt.text)
+		if err != nil {
+			t.Errorf("parseCrontabLine(%q) unexpected error: %v", tt.text, err)
+
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("parseCrontabLine(%q) = %+v, expected %+v", tt.text, got, tt.expected)
+		}
+	}
+
+	for _, text := range []string{"0 2 * *", "0 2 * * * 2025", "MAILTO=ops", "0 2 * * * */5 run.sh"} {
+		if _, err := parseCrontabLine(text); !errors.Is(err, ErrFieldCount) {
+			t.Errorf("parseCrontabLine(%q) expected ErrFieldCount, got %v", text, err)
+		}
+	}
+}
+
+// TestPasteCrontabLine verifies that a crontab line pasted into the raw
+// input fills the fields, shows the command read-only, and keeps it when
+// returning to the fields.
+func TestPasteCrontabLine(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+	m.rawInput.SetValue("")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("MAILTO=ops\n15 3 * * 0 /usr/bin/cleanup.sh"), Paste: true})
+	m = assertModelType(t, newModel)
+
+	if m.buildCronExpression() != "15 3 * * 0" || m.rawConflict != "" {
+		t.Fatalf("Expected the schedule in the fields, got %q (%s)", m.buildCronExpression(), m.rawConflict)
+	}
+
+	if m.lineCommand != "/usr/bin/cleanup.sh" || !reflect.DeepEqual(m.lineEnv, []string{"MAILTO=ops"}) {
+		t.Errorf("Expected the command and variable kept aside, got %q and %q", m.lineCommand, m.lineEnv)
+	}
+
+	if view := m.View(); !strings.Contains(view, "/usr/bin/cleanup.sh") || !strings.Contains(view, "MAILTO=ops") {
+		t.Errorf("Expected the command and variable in the view, got:\n%s", view)
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = assertModelType(t, newModel)
+	m = typeRaw(t, m, "5")
+
+	if m.rawInput.Value() != "MAILTO=ops 155 3 * * 0 /usr/bin/cleanup.sh" {
+		t.Errorf("Expected field edits to keep the pasted line around the schedule, got %q", m.rawInput.Value())
+	}
+
+	m.plain = true
+	if view := m.View(); !strings.Contains(view, "command: /usr/bin/cleanup.sh\n") || !strings.Contains(view, "env: MAILTO=ops\n") {
+		t.Errorf("Expected the command and variable in the plain view, got:\n%s", view)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+)
+
+// crontabTokenKind tells what part of a crontab line a token is
+type crontabTokenKind int
+
+const (
+	crontabTrivia   crontabTokenKind = iota // Whitespace, comments, and the line ending
+	crontabVariable                         // Variable assignment, e.g. MAILTO=ops
+	crontabField                            // Schedule field or @ macro
+	crontabCommand                          // Command run by the job, with its own spacing
+)
+
+// crontabToken is a piece of a crontab line, kept exactly as written
+type crontabToken struct {
+	kind crontabTokenKind // What part of the line the token is
+	text string           // Text of the token
+}
+
+// crontabSyntaxLine is one line of a crontab as tokens that join back into
+// its exact text, line ending included, so a job can be edited without
+// touching the spacing, tabs, or comments around it
+type crontabSyntaxLine struct {
+	tokens []crontabToken // Tokens of the line in order
+}
+
+// crontabSyntax is a crontab as lines of tokens. Parsing it and joining it
+// back gives the same bytes, and an edit reparses only the line it changes.
+type crontabSyntax struct {
+	lines []crontabSyntaxLine // Lines in order; the last has no line ending
+}
+
+// parseCrontabSyntax splits a crontab into lines of tokens. Like splitting
+// on newlines, it gives one more line than the text has newlines, so line
+// indexes match those of the other crontab readers.
+func parseCrontabSyntax(text string) *crontabSyntax {
+	parts := strings.SplitAfter(text, "\n")
+	syntax := &crontabSyntax{lines: make([]crontabSyntaxLine, 0, len(parts))}
+
+	for _, part := range parts {
+		syntax.lines = append(syntax.lines, parseCrontabSyntaxLine(part))
+	}
+
+	return syntax
+}
+
+// parseCrontabSyntaxLine splits one line into tokens. A line whose first
+// word sets a variable is a variable line, one starting with @ has a single
+// schedule field, and any other line that is not blank or a comment has
+// five, the rest of it being the command.
+func parseCrontabSyntaxLine(text string) crontabSyntaxLine {
+	var line crontabSyntaxLine
+
+	add := func(kind crontabTokenKind, text string) {
+		if text != "" {
+			line.tokens = append(line.tokens, crontabToken{kind: kind, text: text})
+		}
+	}
+
+	body := strings.TrimRight(text, " \t\r\n")
+	trailing := text[len(body):]
+	rest := strings.TrimLeft(body, " \t")
+	add(crontabTrivia, body[:len(body)-len(rest)])
+
+	switch {
+	case rest == "" || strings.HasPrefix(rest, "#"):
+		add(crontabTrivia, rest)
+	case isEnvAssignment(strings.Fields(rest)[0]):
+		add(crontabVariable, rest)
+	default:
+		count := numCronFields
+		if strings.HasPrefix(rest, "@") {
+			count = 1
+		}
+
+		for range count {
+			end := strings.IndexAny(rest+" ", " \t")
+			add(crontabField, rest[:end])
+
+			rest = rest[end:]
+			if rest == "" {
+				break
+			}
+
+			spacing := rest[:len(rest)-len(strings.TrimLeft(rest, " \t"))]
+			add(crontabTrivia, spacing)
+			rest = rest[len(spacing):]
+		}
+
+		add(crontabCommand, rest)
+	}
+
+	add(crontabTrivia, trailing)
+
+	return line
+}
+
+// String joins the tokens back into the line's text
+func (line *crontabSyntaxLine) String() string {
+	var builder strings.Builder
+
+	for _, token := range line.tokens {
+		builder.WriteString(token.text)
+	}
+
+	return builder.String()
+}
+
+// String joins the lines back into the crontab's text
+func (syntax *crontabSyntax) String() string {
+	var builder strings.Builder
+
+	for index := range syntax.lines {
+		builder.WriteString(syntax.lines[index].String())
+	}
+
+	return builder.String()
+}
+
+// indexes returns the positions of the tokens of a kind
+func (line *crontabSyntaxLine) indexes(kind crontabTokenKind) []int {
+	var found []int
+
+	for index, token := range line.tokens {
+		if token.kind == kind {
+			found = append(found, index)
+		}
+	}
+
+	return found
+}
+
+// fields returns the schedule fields as written
+func (line *crontabSyntaxLine) fields() []string {
+	var fields []string
+
+	for _, index := range line.indexes(crontabField) {
+		fields = append(fields, line.tokens[index].text)
+	}
+
+	return fields
+}
+
+// setSchedule rewrites the schedule fields in place. With as many fields as
+// before, the spacing after each is kept, narrowed or widened where it is
+// made of spaces so the columns after it stay where they were. Otherwise, as
+// when a macro becomes five fields, the new fields are written in place of
+// the old ones, one space apart.
+func (line *crontabSyntaxLine) setSchedule(fields []string) {
+	positions := line.indexes(crontabField)
+	if len(positions) == 0 {
+		return
+	}
+
+	if len(positions) != len(fields) {
+		first, last := positions[0], positions[len(positions)-1]
+		replaced := crontabToken{kind: crontabField, text: strings.Join(fields, " ")}
+		line.tokens = append(append(line.tokens[:first:first], replaced), line.tokens[last+1:]...)
+
+		return
+	}
+
+	for field, position := range positions {
+		grown := len(fields[field]) - len(line.tokens[position].text)
+		line.tokens[position].text = fields[field]
+
+		if position+1 == len(line.tokens) {
+			continue
+		}
+
+		// Spacing of a single space or with tabs is kept as it is
+		spacing := &line.tokens[position+1]
+		if spacing.kind == crontabTrivia && strings.Trim(spacing.text, " ") == "" && len(spacing.text) > 1 {
+			spacing.text = strings.Repeat(" ", max(1, len(spacing.text)-grown))
+		}
+	}
+}
+
+// setCommand rewrites the command, keeping the spacing before it. A line
+// without a command gets one after a space, and an empty command removes it
+// with the spacing before it.
+func (line *crontabSyntaxLine) setCommand(command string) {
+	positions := line.indexes(crontabCommand)
+
+	switch {
+	case len(positions) == 1 && command != "":
+		line.tokens[positions[0]].text = command
+	case len(positions) == 1:
+		start := positions[0]
+		if start > 0 && line.tokens[start-1].kind == crontabTrivia {
+			start--
+		}
+
+		line.tokens = append(line.tokens[:start:start], line.tokens[positions[0]+1:]...)
+	case command != "":
+		fields := line.indexes(crontabField)
+		if len(fields) == 0 {
+			return
+		}
+
+		after := fields[len(fields)-1] + 1
+		added := []crontabToken{{kind: crontabTrivia, text: " "}, {kind: crontabCommand, text: command}}
+		line.tokens = append(append(line.tokens[:after:after], added...), line.tokens[after:]...)
+	}
+}
+
+// ending returns the line's newline, "\r\n" or "\n", or "" for the last line
+func (line *crontabSyntaxLine) ending() string {
+	text := line.String()
+
+	switch {
+	case strings.HasSuffix(text, "\r\n"):
+		return "\r\n"
+	case strings.HasSuffix(text, "\n"):
+		return "\n"
+	default:
+		return ""
+	}
+}
+
+// setLine replaces the text of a line, keeping its line ending, and
+// reparses that line alone
+func (syntax *crontabSyntax) setLine(index int, text string) {
+	syntax.lines[index] = parseCrontabSyntaxLine(text + syntax.lines[index].ending())
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// messyCrontab mixes tabs, aligned columns, Windows line endings, trailing
+// spaces, comments, variables, macros, and no newline at the end.
+const messyCrontab = "# m h dom mon dow  command\r\n" +
+	"MAILTO=\"ops@example.com\"\r\n" +
+	"  SHELL=/bin/bash\n" +
+	"\n" +
+	"0   2\t*  *  *    /usr/local/bin/backup.sh   >> /var/log/backup.log 2>&1  \n" +
+	"*/5 *\t*  *  1-5  poll.sh # every five minutes\n" +
+	"\t@daily\t\tcleanup.sh\r\n" +
+	"@reboot start.sh\n" +
+	"   # indented comment   \n" +
+	"15 3 * * * report.sh"
+
+// TestCrontabSyntaxRoundTrip verifies that parsing a crontab and joining it
+// back gives the same bytes, with one line per newline plus one.
+func TestCrontabSyntaxRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{messyCrontab, "", "\n", "0 0 * * * a\n", "\r\n\r\n", "*/5 * *"} {
+		syntax := parseCrontabSyntax(text)
+
+		if joined := syntax.String(); joined != text {
+			t.Errorf("Round trip of %q gave %q", text, joined)
+		}
+
+		if len(syntax.lines) != len(strings.Split(text, "\n")) {
+			t.Errorf("Expected %d lines for %q, got %d", len(strings.Split(text, "\n")), text, len(syntax.lines))
+		}
+	}
+}
+
+// TestCrontabSyntaxTokens verifies which parts of a line are fields,
+// variables, commands, and trivia.
+func TestCrontabSyntaxTokens(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line     string
+		kind     crontabTokenKind
+		expected []string
+	}{
+		{"0   2\t*  *  *    backup.sh  >> log  \n", crontabField, []string{"0", "2", "*", "*", "*"}},
+		{"0   2\t*  *  *    backup.sh  >> log  \n", crontabCommand, []string{"backup.sh  >> log"}},
+		{"\t@daily\t\tcleanup.sh\r\n", crontabField, []string{"@daily"}},
+		{"\t@daily\t\tcleanup.sh\r\n", crontabTrivia, []string{"\t", "\t\t", "\r\n"}},
+		{"MAILTO=\"a b\"  \n", crontabVariable, []string{"MAILTO=\"a b\""}},
+		{"  # comment 0 0 * * *\n", crontabTrivia, []string{"  ", "# comment 0 0 * * *", "\n"}},
+	}
+
+	for _, test := range tests {
+		line := parseCrontabSyntaxLine(test.line)
+
+		var found []string
+		for _, index := range line.indexes(test.kind) {
+			found = append(found, line.tokens[index].text)
+		}
+
+		if strings.Join(found, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("Tokens of kind %d in %q = %q, expected %q", test.kind, test.line, found, test.expected)
+		}
+	}
+}
+
+// TestCrontabSyntaxEdits verifies that rewriting a schedule or command
+// changes only those tokens, keeping aligned columns where the spacing is
+// made of spaces.
+func TestCrontabSyntaxEdits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line     string
+		expr     string
+		command  string
+		expected string
+	}{
+		{"0   2\t*  *  *    backup.sh  \r\n", "30 2 * * *", "backup.sh", "30  2\t*  *  *    backup.sh  \r\n"},
+		{"0 2 * * * backup.sh\n", "*/15 2 * * 1-5", "backup.sh", "*/15 2 * * 1-5 backup.sh\n"},
+		{"*/15  2  *  *  *  a.sh\n", "5 2 * * *", "a.sh", "5     2  *  *  *  a.sh\n"},
+		{"\t@daily\t\tcleanup.sh\r\n", "0 3 * * *", "cleanup.sh", "\t0 3 * * *\t\tcleanup.sh\r\n"},
+		{"0 3 * * *\tcleanup.sh\n", "@daily", "cleanup.sh", "@daily\tcleanup.sh\n"},
+		{"0 3 * * *\tcleanup.sh", "0 3 * * *", "cleanup.sh --all", "0 3 * * *\tcleanup.sh --all"},
+	}
+
+	for _, test := range tests {
+		line := parseCrontabSyntaxLine(test.line)
+		line.setSchedule(strings.Fields(test.expr))
+		line.setCommand(test.command)
+
+		if edited := line.String(); edited != test.expected {
+			t.Errorf("Editing %q to %q %q gave %q, expected %q", test.line, test.expr, test.command, edited, test.expected)
+		}
+	}
+}
+
+// TestCrontabSyntaxSetLine verifies that replacing a line keeps its line
+// ending and every other line byte for byte.
+func TestCrontabSyntaxSetLine(t *testing.T) {
+	t.Parallel()
+
+	syntax := parseCrontabSyntax(messyCrontab)
+	syntax.setLine(1, "MAILTO=\"\"")
+
+	expected := strings.Replace(messyCrontab, "MAILTO=\"ops@example.com\"\r\n", "MAILTO=\"\"\r\n", 1)
+	if joined := syntax.String(); joined != expected {
+		t.Errorf("Expected only the MAILTO line replaced, got %q", joined)
+	}
+}
+
+// TestCrontabDocumentKeepsFormatting verifies that editing jobs of a
+// crontab in the browser leaves every other byte of the file as it was,
+// including a macro whose schedule was not changed.
+func TestCrontabDocumentKeepsFormatting(t *testing.T) {
+	t.Parallel()
+
+	_, doc, err := newCrontabBrowser(crontabTarget{}, messyCrontab)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc.apply(1, "*/10 * * * 1-5", "poll.sh # every ten minutes")
+	doc.apply(2, "0 0 * * *", "cleanup.sh --all")
+
+	expected := strings.Replace(messyCrontab, "*/5 *\t*  *  1-5  poll.sh # every five minutes",
+		"*/10 *\t*  *  1-5  poll.sh # every ten minutes", 1)
+	expected = strings.Replace(expected, "cleanup.sh", "cleanup.sh --all", 1)
+	if text := doc.text(); text != expected {
+		t.Errorf("Expected only the poll.sh line changed, got %q", text)
+	}
+}
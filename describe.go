@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	crondesc "github.com/lnquy/cron"
+	cronparser "github.com/robfig/cron/v3"
+)
+
+//nolint:gochecknoglobals
+var (
+	// Parsers for five-field expressions and for those with seconds. A
+	// parser holds nothing but its options, so one serves every caller.
+	standardParser = cronparser.NewParser(cronParserOptions)
+	secondsParser  = cronparser.NewParser(cronSecondsParserOptions)
+
+	// sharedDescriptor creates the English descriptor on first use. Its
+	// parser and locale are only read while describing, so the editor's
+	// background commands, the server, and the MCP tools share it without
+	// locking.
+	sharedDescriptor = sync.OnceValues(func() (*crondesc.ExpressionDescriptor, error) {
+		descriptor, err := crondesc.NewDescriptor()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCronDescriptor, err)
+		}
+
+		return descriptor, nil
+	})
+)
+
+// describeExpression describes an expression in English with the shared
+// descriptor. It is safe to call from several goroutines at once.
+func describeExpression(expr string) (string, error) {
+	descriptor, err := sharedDescriptor()
+	if err != nil {
+		return "", err
+	}
+
+	description, err := descriptor.ToDescription(expr, crondesc.Locale_en)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	return description, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	crondesc "github.com/lnquy/cron"
+)
+
+// TestDescribeExpressionConcurrently verifies that the shared descriptor
+// gives every goroutine the same descriptions as a descriptor of its own.
+// Run with -race to catch unsynchronized access.
+func TestDescribeExpressionConcurrently(t *testing.T) {
+	t.Parallel()
+
+	exprs := []string{"*/15 9-17 * * 1-5", "0 0 1 1 *", "30 2 * * SUN", "0 */6 1,15 * *"}
+
+	own, err := crondesc.NewDescriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := make(map[string]string, len(exprs))
+
+	for _, expr := range exprs {
+		if expected[expr], err = own.ToDescription(expr, crondesc.Locale_en); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var group sync.WaitGroup
+
+	for worker := range 8 {
+		group.Go(func() {
+			for round := range 50 {
+				expr := exprs[(worker+round)%len(exprs)]
+
+				description, err := describeExpression(expr)
+				if err != nil || description != expected[expr] {
+					t.Errorf("describeExpression(%q) = %q, %v, expected %q", expr, description, err, expected[expr])
+				}
+
+				if result := computeSchedule(expr, time.Now()); result.err != nil || result.description != expected[expr] {
+					t.Errorf("computeSchedule(%q) = %q, %v", expr, result.description, result.err)
+				}
+			}
+		})
+	}
+
+	group.Wait()
+}
+
+// BenchmarkDescribeExpression measures describing with the shared descriptor.
+func BenchmarkDescribeExpression(b *testing.B) {
+	for b.Loop() {
+		if _, err := describeExpression("*/15 9-17 * * 1-5"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDescribeWithNewDescriptor measures creating a descriptor for
+// every description, as each feature used to.
+func BenchmarkDescribeWithNewDescriptor(b *testing.B) {
+	for b.Loop() {
+		descriptor, err := crondesc.NewDescriptor()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if _, err := descriptor.ToDescription("*/15 9-17 * * 1-5", crondesc.Locale_en); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkComputeSchedule measures the description and next run the editor
+// computes in the background on every edit.
+func BenchmarkComputeSchedule(b *testing.B) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	for b.Loop() {
+		if result := computeSchedule("*/15 9-17 * * 1-5", now); result.err != nil {
+			b.Fatal(result.err)
+		}
+	}
+}
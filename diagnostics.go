@@ -0,0 +1,271 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// diagnosticSeverity is how much a diagnostic matters
+type diagnosticSeverity string
+
+const (
+	severityError   diagnosticSeverity = "error"   // The expression is invalid
+	severityWarning diagnosticSeverity = "warning" // The expression is valid but likely not what was meant
+	severityInfo    diagnosticSeverity = "info"    // The expression could be written more simply
+)
+
+// Codes identifying what a diagnostic is about, stable across releases so
+// tools can filter on them
+const (
+	codeFieldCount   = "field-count"        // Not five fields
+	codeInvalidValue = "invalid-value"      // A field value out of range or malformed
+	codeUnsupported  = "unsupported-syntax" // Syntax the dialect does not have
+	codeParse        = "parse"              // Rejected by the cron parser
+	codeInvalid      = "invalid"            // Any other reason an expression is invalid
+	codeNeverRuns    = "never-runs"         // No date matches, e.g. February 30
+	codeDayOrWeekday = "day-or-weekday"     // Both day fields restricted, so either matching runs the job
+	codeSpacing      = "spacing"            // Fields not one space apart
+	codeMacroCase    = "macro-case"         // A macro not in lower case
+	codeSimplify     = "simplify"           // A field with a simpler way to write it
+)
+
+// diagnosticSpan is the bytes of the text a diagnostic is about, from Start
+// up to but not including End
+type diagnosticSpan struct {
+	Start int `json:"start"` // Offset of the first byte
+	End   int `json:"end"`   // Offset after the last byte
+}
+
+// diagnostic is one finding about an expression. The editor, the lint
+// command, and the HTTP and MCP servers all render these, so the same
+// expression reads the same everywhere.
+type diagnostic struct {
+	Code       string             `json:"code"`                 // What the diagnostic is about, one of the code constants
+	Severity   diagnosticSeverity `json:"severity"`             // How much it matters
+	Field      string             `json:"field,omitempty"`      // Field it is about, "" for the whole expression
+	Span       diagnosticSpan     `json:"span"`                 // Where it is in the expression
+	Message    string             `json:"message"`              // What is wrong, for people
+	Suggestion string             `json:"suggestion,omitempty"` // Text to replace the span with, "" when there is none
+}
+
+// String renders a diagnostic on one line, e.g.
+// `warning[day-or-weekday]: runs when either ...`
+func (d diagnostic) String() string {
+	text := fmt.Sprintf("%s[%s]: %s", d.Severity, d.Code, d.Message)
+	if d.Suggestion != "" {
+		text += fmt.Sprintf(" (write %q)", d.Suggestion)
+	}
+
+	return text
+}
+
+// expressionSpans returns where each whitespace-separated token of an
+// expression starts and ends
+func expressionSpans(expr string) []diagnosticSpan {
+	var spans []diagnosticSpan
+
+	start := -1
+
+	for index := 0; index <= len(expr); index++ {
+		blank := index == len(expr) || expr[index] == ' ' || expr[index] == '\t'
+
+		switch {
+		case !blank && start < 0:
+			start = index
+		case blank && start >= 0:
+			spans = append(spans, diagnosticSpan{Start: start, End: index})
+			start = -1
+		}
+	}
+
+	return spans
+}
+
+// errorCode picks the code of an error from the sentinel it wraps
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrFieldCount):
+		return codeFieldCount
+	case errors.Is(err, ErrInvalidValue):
+		return codeInvalidValue
+	case errors.Is(err, ErrUnsupportedSyntax):
+		return codeUnsupported
+	case errors.Is(err, ErrCronParse):
+		return codeParse
+	default:
+		return codeInvalid
+	}
+}
+
+// errorDiagnostic turns an error about an expression into a diagnostic
+// spanning all of it, for dialects diagnoseExpression does not read
+func errorDiagnostic(err error, expr string) diagnostic {
+	return diagnostic{
+		Code:     errorCode(err),
+		Severity: severityError,
+		Span:     diagnosticSpan{Start: 0, End: len(expr)},
+		Message:  err.Error(),
+	}
+}
+
+// starAllowed reports whether a field selecting every value may be written
+// as "*". A restricted day field makes cron match either day field, so a
+// full range in the other one cannot become "*" without changing the
+// schedule.
+func starAllowed(fields []string, index int) bool {
+	switch index {
+	case fieldIndexDay:
+		return fields[fieldIndexWeekday] == "*"
+	case fieldIndexWeekday:
+		return fields[fieldIndexDay] == "*"
+	default:
+		return true
+	}
+}
+
+// diagnoseExpression checks a standard expression: its field count and
+// values, whether any date matches it, whether both day fields are
+// restricted, and the fixes lint would make. Errors come alone, since the
+// other checks need a valid expression.
+func diagnoseExpression(expr string) []diagnostic {
+	spans := expressionSpans(expr)
+	whole := diagnosticSpan{Start: 0, End: len(expr)}
+	trimmed := strings.TrimSpace(expr)
+
+	if _, ok := cronMacros[strings.ToLower(trimmed)]; ok && len(spans) == 1 {
+		if lower := strings.ToLower(trimmed); lower != trimmed {
+			return []diagnostic{{
+				Code:       codeMacroCase,
+				Severity:   severityInfo,
+				Span:       spans[0],
+				Message:    "macro written in lower case",
+				Suggestion: lower,
+			}}
+		}
+
+		return nil
+	}
+
+	if len(spans) != numCronFields {
+		return []diagnostic{{
+			Code:     codeFieldCount,
+			Severity: severityError,
+			Span:     whole,
+			Message:  fmt.Sprintf("%v: expected %d, got %d", ErrFieldCount, numCronFields, len(spans)),
+		}}
+	}
+
+	fields := make([]string, numCronFields)
+	for index, span := range spans {
+		fields[index] = expr[span.Start:span.End]
+	}
+
+	var diagnostics []diagnostic
+
+	for index, field := range fields {
+		if _, err := expandField(field, index); err != nil {
+			diagnostics = append(diagnostics, diagnostic{
+				Code:       errorCode(err),
+				Severity:   severityError,
+				Field:      fieldNames[index],
+				Span:       spans[index],
+				Message:    fmt.Sprintf("%v (%s)", err, lowerFirst(allowedValues[index])),
+				Suggestion: suggestField(field, index, false),
+			})
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return diagnostics
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		// Lint's fixes make some rejected expressions parse, e.g. 7 for Sunday
+		fixed, _, fixErr := fixExpression(expr)
+		if fixErr != nil {
+			fixed = ""
+		}
+
+		return []diagnostic{{
+			Code:       codeParse,
+			Severity:   severityError,
+			Span:       whole,
+			Message:    fmt.Sprintf("%v: %v", ErrCronParse, err),
+			Suggestion: fixed,
+		}}
+	}
+
+	// A leap year start finds February 29 within the parser's search
+	if schedule.Next(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		diagnostics = append(diagnostics, diagnostic{
+			Code:     codeNeverRuns,
+			Severity: severityWarning,
+			Span:     whole,
+			Message:  fmt.Sprintf("never runs: no month %q has a day %q", fields[fieldIndexMonth], fields[fieldIndexDay]),
+		})
+	}
+
+	if fields[fieldIndexDay] != "*" && fields[fieldIndexWeekday] != "*" {
+		diagnostics = append(diagnostics, diagnostic{
+			Code:     codeDayOrWeekday,
+			Severity: severityWarning,
+			Span:     diagnosticSpan{Start: spans[fieldIndexDay].Start, End: spans[fieldIndexWeekday].End},
+			Message: fmt.Sprintf("runs when either the day %q or the weekday %q matches, not only when both do",
+				fields[fieldIndexDay], fields[fieldIndexWeekday]),
+		})
+	}
+
+	if joined := strings.Join(fields, " "); joined != trimmed {
+		diagnostics = append(diagnostics, diagnostic{
+			Code:       codeSpacing,
+			Severity:   severityInfo,
+			Span:       diagnosticSpan{Start: spans[0].Start, End: spans[numCronFields-1].End},
+			Message:    "single spaces between fields",
+			Suggestion: joined,
+		})
+	}
+
+	for index, field := range fields {
+		if fixed, notes := fixField(field, index, starAllowed(fields, index)); fixed != field {
+			diagnostics = append(diagnostics, diagnostic{
+				Code:       codeSimplify,
+				Severity:   severityInfo,
+				Field:      fieldNames[index],
+				Span:       spans[index],
+				Message:    strings.Join(notes, ", "),
+				Suggestion: fixed,
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+// warnings returns the messages of the warnings about the fields, followed
+// by the runs the calendars have something to say about, which the editor
+// shows under the description
+func (m *model) warnings() []string {
+	return append(m.fieldWarnings(), m.calendarWarnings(time.Now())...)
+}
+
+// fieldWarnings returns the messages of the warnings about the fields, which
+// depend on the expression alone
+func (m *model) fieldWarnings() []string {
+	var messages []string
+
+	for _, found := range diagnoseExpression(m.buildCronExpression()) {
+		if found.Severity == severityWarning {
+			messages = append(messages, found.Message)
+		}
+	}
+
+	return messages
+}
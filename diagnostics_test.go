@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDiagnoseExpression verifies the code, severity, field, span, and
+// suggestion of each kind of diagnostic.
+func TestDiagnoseExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected []diagnostic
+	}{
+		{"0 9 * * 1-5", nil},
+		{"@daily", nil},
+		{"@Daily", []diagnostic{{Code: codeMacroCase, Severity: severityInfo, Span: diagnosticSpan{0, 6}, Suggestion: "@daily"}}},
+		{"0 9 * *", []diagnostic{{Code: codeFieldCount, Severity: severityError, Span: diagnosticSpan{0, 7}}}},
+		{"0 61 * * 99", []diagnostic{
+			{Code: codeInvalidValue, Severity: severityError, Field: "hour", Span: diagnosticSpan{2, 4}},
+			{Code: codeInvalidValue, Severity: severityError, Field: "weekday", Span: diagnosticSpan{9, 11}},
+		}},
+		{"0 9 * * 7", []diagnostic{{Code: codeParse, Severity: severityError, Span: diagnosticSpan{0, 9}, Suggestion: "0 9 * * 0"}}},
+		{"0 0 30 2 *", []diagnostic{{Code: codeNeverRuns, Severity: severityWarning, Span: diagnosticSpan{0, 10}}}},
+		{"0 0 1 * MON", []diagnostic{{Code: codeDayOrWeekday, Severity: severityWarning, Span: diagnosticSpan{4, 11}}}},
+		{"0  9 * jan */1", []diagnostic{
+			{Code: codeSpacing, Severity: severityInfo, Span: diagnosticSpan{0, 14}, Suggestion: "0 9 * jan */1"},
+			{Code: codeSimplify, Severity: severityInfo, Field: "month", Span: diagnosticSpan{7, 10}, Suggestion: "JAN"},
+			{Code: codeSimplify, Severity: severityInfo, Field: "weekday", Span: diagnosticSpan{11, 14}, Suggestion: "*"},
+		}},
+	}
+
+	for _, tt := range tests {
+		found := diagnoseExpression(tt.expr)
+		if len(found) != len(tt.expected) {
+			t.Errorf("diagnoseExpression(%q) = %v, expected %d diagnostics", tt.expr, found, len(tt.expected))
+
+			continue
+		}
+
+		for index, want := range tt.expected {
+			got := found[index]
+			if got.Message == "" {
+				t.Errorf("diagnoseExpression(%q)[%d] has no message", tt.expr, index)
+			}
+
+			got.Message = ""
+			if got != want {
+				t.Errorf("diagnoseExpression(%q)[%d] = %+v, expected %+v", tt.expr, index, got, want)
+			}
+		}
+	}
+}
+
+// TestDiagnosticString verifies the one-line rendering with a suggestion.
+func TestDiagnosticString(t *testing.T) {
+	t.Parallel()
+
+	found := diagnoseExpression("*/1 9 * * *")
+	if len(found) != 1 || found[0].String() != `info[simplify]: minute "*/1" selects every value, written as * (write "*")` {
+		t.Errorf("Unexpected diagnostics %v", found)
+	}
+}
+
+// TestEditorWarnings verifies that the editor shows warnings under the
+// description in both layouts.
+func TestEditorWarnings(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 0 1 * MON")
+
+	if view := m.View(); !strings.Contains(view, `! runs when either the day "1" or the weekday "MON" matches`) {
+		t.Errorf("Expected the day-or-weekday warning in the view:\n%s", view)
+	}
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "warning: runs when either") {
+		t.Errorf("Expected the warning in plain mode:\n%s", plain)
+	}
+
+	m.setExpression("0 0 1 * *")
+
+	if view := m.View(); strings.Contains(view, "runs when either") {
+		t.Errorf("Expected the warning gone with the weekday cleared:\n%s", view)
+	}
+}
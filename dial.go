@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	minuteDialRadius = 6 // Radius in rows of the minute dial
+	hourDialRadius   = 4 // Radius in rows of the hour dial
+	dialAspect       = 2 // Terminal cells are about twice as tall as they are wide
+	dialGap          = 4 // Columns between the two dials
+)
+
+// dialCell is one position on a dial's character grid
+type dialCell struct {
+	marked  bool // At least one value drawn in this cell is selected
+	present bool // At least one value is drawn in this cell
+}
+
+// renderDial draws the values of a field as marks around a clock face, with
+// selected values highlighted and 0 at the top. Values that share a cell are
+// merged, with the cell marked when any of them is selected.
+func renderDial(set fieldSet, positions int, radius int, label string) string {
+	rows := 2*radius + 1
+	cols := 2*radius*dialAspect + 1
+
+	grid := make([][]dialCell, rows)
+	for row := range grid {
+		grid[row] = make([]dialCell, cols)
+	}
+
+	for value := range positions {
+		angle := 2*math.Pi*float64(value)/float64(positions) - math.Pi/2
+		row := radius + int(math.Round(float64(radius)*math.Sin(angle)))
+		col := radius*dialAspect + int(math.Round(float64(radius*dialAspect)*math.Cos(angle)))
+
+		grid[row][col].present = true
+		grid[row][col].marked = grid[row][col].marked || set.Has(value)
+	}
+
+	var builder strings.Builder
+
+	for row := range grid {
+		line := make([]string, 0, cols)
+
+		for col := range grid[row] {
+			cell := grid[row][col]
+
+			switch {
+			case cell.marked:
+				line = append(line, exampleMarkStyle.Render("●"))
+			case cell.present:
+				line = append(line, exampleDotStyle.Render("·"))
+			default:
+				line = append(line, " ")
+			}
+		}
+
+		rendered := strings.Join(line, "")
+
+		// Write the label across the middle of the dial
+		if row == radius {
+			padding := (cols - len(label)) / 2
+			rendered = strings.Join(line[:padding], "") + labelStyle.Render(label) +
+				strings.Join(line[padding+len(label):], "")
+		}
+
+		builder.WriteString(rendered)
+
+		if row < rows-1 {
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// renderDials renders the hour and minute dials side by side when enabled
+func (m *model) renderDials() string {
+	if !m.showDial {
+		return ""
+	}
+
+	return m.cached("dials", func() string {
+		hours, err := expandField(m.inputs[1].Value(), 1)
+		if err != nil {
+			hours = 0
+		}
+
+		minutes, err := expandField(m.inputs[0].Value(), 0)
+		if err != nil {
+			minutes = 0
+		}
+
+		hourDial := renderDial(hours, 24, hourDialRadius, "hour")
+		minuteDial := renderDial(minutes, 60, minuteDialRadius, "minute")
+		dials := lipgloss.JoinHorizontal(lipgloss.Center, hourDial, strings.Repeat(" ", dialGap), minuteDial)
+
+		return m.place(dials) + "\n"
+	})
+}
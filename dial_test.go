@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestRenderDialMarksSelectedValues verifies that selected values are drawn as
+// marks, with 0 at the top of the dial and the label in the middle.
+func TestRenderDialMarksSelectedValues(t *testing.T) {
+	t.Parallel()
+
+	set, err := expandField("0,6,12,18", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dial := renderDial(set, 24, hourDialRadius, "hour")
+	lines := strings.Split(dial, "\n")
+
+	if len(lines) != 2*hourDialRadius+1 {
+		t.Fatalf("Expected %d rows, got %d", 2*hourDialRadius+1, len(lines))
+	}
+
+	if strings.Count(dial, "●") != 4 {
+		t.Errorf("Expected 4 marks for 4 selected hours, got %d:\n%s", strings.Count(dial, "●"), dial)
+	}
+
+	if !strings.Contains(lines[0], "●") {
+		t.Error("Expected hour 0 to be marked at the top of the dial")
+	}
+
+	if !strings.Contains(lines[hourDialRadius], "hour") {
+		t.Error("Expected the label in the middle row")
+	}
+
+	if empty := renderDial(0, 24, hourDialRadius, "hour"); strings.Contains(empty, "●") {
+		t.Error("Expected no marks for an empty set")
+	}
+}
+
+// TestDialToggle verifies that ctrl+o shows the dials and they follow the fields.
+func TestDialToggle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 80
+
+	if m.renderDials() != "" {
+		t.Error("Expected the dials to be hidden by default")
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	m = assertModelType(t, newModel)
+
+	if !m.showDial || !strings.Contains(m.View(), "minute") {
+		t.Fatal("Expected ctrl+o to show the dials")
+	}
+
+	// "20 4" marks one hour and one minute
+	if got := strings.Count(m.renderDials(), "●"); got != 2 {
+		t.Errorf("Expected 2 marks for a single hour and minute, got %d", got)
+	}
+
+	// Invalid fields render an empty dial rather than failing
+	m.inputs[0].SetValue("x")
+
+	if got := strings.Count(m.renderDials(), "●"); got != 1 {
+		t.Errorf("Expected only the hour mark with an invalid minute, got %d", got)
+	}
+}
@@ -0,0 +1,494 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// dialect identifies a cron flavor with its own field layout and syntax
+type dialect string
+
+const (
+	dialectStandard dialect = "standard" // Five fields, Sunday is 0 or 7
+	dialectSeconds  dialect = "seconds"  // Six fields with seconds first
+	dialectAzure    dialect = "azure"    // Azure Functions NCRONTAB: six fields with seconds first
+	dialectQuartz   dialect = "quartz"   // Seconds first, optional year, Sunday is 1, "?" required
+	dialectAWS      dialect = "aws"      // EventBridge cron(...) with a year field, Sunday is 1
+	dialectJenkins  dialect = "jenkins"  // Five fields plus H hashing
+)
+
+const (
+	cronSecondsParserOptions = cronparser.Second | cronParserOptions
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrUnknownDialect is returned for a dialect name that is not supported
+	ErrUnknownDialect = errors.New("unknown dialect")
+	// ErrUnsupportedSyntax is returned when an expression cannot be expressed in the target dialect
+	ErrUnsupportedSyntax = errors.New("unsupported syntax")
+
+	// Supported dialects in the order they are listed to users
+	dialects = []dialect{dialectStandard, dialectSeconds, dialectAzure, dialectQuartz, dialectAWS, dialectJenkins}
+)
+
+// cronSpec is a dialect-neutral cron expression: the five standard fields in
+// standard numbering (weekday 0-6 with Sunday as 0) plus the seconds and year
+// fields that only some dialects have
+type cronSpec struct {
+	seconds string   // Seconds field, "" when the source had none
+	fields  []string // Minute, hour, day, month, and weekday fields
+	year    string   // Year field, "" when the source had none
+}
+
+// conversion is the result of translating an expression between dialects
+type conversion struct {
+	expression string   // Expression in the target dialect
+	standard   string   // Equivalent five-field standard expression
+	notes      []string // Explanations of lossy or noteworthy translations
+}
+
+// parseDialect looks up a dialect by name
+func parseDialect(name string) (dialect, error) {
+	for _, candidate := range dialects {
+		if strings.EqualFold(name, string(candidate)) {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q (expected one of %s)", ErrUnknownDialect, name, dialectNames())
+}
+
+// dialectNames lists the supported dialects for messages and help
+func dialectNames() string {
+	names := make([]string, 0, len(dialects))
+	for _, candidate := range dialects {
+		names = append(names, string(candidate))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// convertExpression translates expr from one dialect to another. The seed names
+// the job when resolving Jenkins H tokens. The result is validated by parsing
+// it back in the target dialect, and the notes explain anything that changed meaning.
+func convertExpression(expr string, from dialect, target dialect, seed string) (conversion, error) {
+	spec, notes, err := parseSpec(expr, from, seed)
+	if err != nil {
+		return conversion{}, err
+	}
+
+	expression, formatNotes, err := formatSpec(spec, target)
+	if err != nil {
+		return conversion{}, err
+	}
+
+	notes = append(notes, formatNotes...)
+
+	// Validate by reading the result back in the target dialect
+	if _, _, err := parseSpec(expression, target, seed); err != nil {
+		return conversion{}, fmt.Errorf("converted expression %q is invalid: %w", expression, err)
+	}
+
+	return conversion{expression: expression, standard: strings.Join(spec.fields, " "), notes: notes}, nil
+}
+
+// parseSpec reads an expression written in the given dialect
+func parseSpec(expr string, from dialect, seed string) (cronSpec, []string, error) {
+	var notes []string
+
+	switch from {
+	case dialectStandard:
+		spec, err := parseStandardSpec(expr)
+
+		return spec, notes, err
+	case dialectJenkins:
+		if hasJenkinsHash(expr) {
+			resolved, err := resolveJenkinsHash(expr, seed)
+			if err != nil {
+				return cronSpec{}, nil, err
+			}
+
+			notes = append(notes, fmt.Sprintf("H resolved to %q for job %q; pass the Jenkins job name as the seed", resolved, seed))
+			expr = resolved
+		}
+
+		spec, err := parseStandardSpec(expr)
+
+		return spec, notes, err
+	case dialectSeconds, dialectAzure:
+		return parseSecondsSpec(expr, from)
+	case dialectQuartz, dialectAWS:
+		return parseQuartzSpec(expr, from)
+	}
+
+	return cronSpec{}, nil, fmt.Errorf("%w: %q", ErrUnknownDialect, from)
+}
+
+// parseStandardSpec reads a five-field expression, expanding macros like @daily
+func parseStandardSpec(expr string) (cronSpec, error) {
+	fields, err := splitRawExpression(expr)
+	if err != nil {
+		return cronSpec{}, err
+	}
+
+	if err := validateStandardFields(fields); err != nil {
+		return cronSpec{}, err
+	}
+
+	return cronSpec{fields: fields}, nil
+}
+
+// parseSecondsSpec reads a six-field expression with seconds first. A
+// five-field expression gets a hint, since that is the usual mistake.
+func parseSecondsSpec(expr string, from dialect) (cronSpec, []string, error) {
+	parts := strings.Fields(expr)
+
+	switch {
+	case len(parts) == numCronFields:
+		return cronSpec{}, nil, fmt.Errorf("%w: %s needs %d fields with seconds first "+
+			"({second} {minute} {hour} {day} {month} {day-of-week}); did you mean \"0 %s\"?",
+			ErrFieldCount, from, numCronFields+1, strings.Join(parts, " "))
+	case len(parts) != numCronFields+1:
+		return cronSpec{}, nil, fmt.Errorf("%w: expected %d, got %d", ErrFieldCount, numCronFields+1, len(parts))
+	}
+
+	if _, err := expandField(parts[0], 0); err != nil {
+		return cronSpec{}, nil, fmt.Errorf("%w: seconds %q", ErrInvalidValue, parts[0])
+	}
+
+	if err := validateStandardFields(parts[1:]); err != nil {
+		return cronSpec{}, nil, err
+	}
+
+	return cronSpec{seconds: parts[0], fields: parts[1:]}, nil, nil
+}
+
+// parseQuartzSpec reads a Quartz expression (seconds first, optional year) or
+// an AWS expression (optionally wrapped in cron(...), year last). Both count
+// Sunday as 1 and use "?" for whichever day field is unrestricted.
+func parseQuartzSpec(expr string, from dialect) (cronSpec, []string, error) {
+	trimmed := strings.TrimSpace(expr)
+	if from == dialectAWS {
+		trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "cron("), ")")
+	}
+
+	parts := strings.Fields(trimmed)
+
+	var spec cronSpec
+
+	switch {
+	case from == dialectAWS && len(parts) == numCronFields+1:
+		spec = cronSpec{fields: parts[:numCronFields], year: parts[numCronFields]}
+	case from == dialectQuartz && (len(parts) == numCronFields+1 || len(parts) == numCronFields+2):
+		spec = cronSpec{seconds: parts[0], fields: parts[1 : numCronFields+1]}
+		if len(parts) == numCronFields+2 {
+			spec.year = parts[numCronFields+1]
+		}
+	default:
+		return cronSpec{}, nil, fmt.Errorf("%w: %d fields is not a valid %s expression", ErrFieldCount, len(parts), from)
+	}
+
+	for index, field := range spec.fields {
+		if strings.ContainsAny(strings.ToUpper(field), "LW#") && !isNameList(field, index) {
+			return cronSpec{}, nil, fmt.Errorf("%w: %s %q (L, W, and # have no standard equivalent)",
+				ErrUnsupportedSyntax, fieldNames[index], field)
+		}
+	}
+
+	day, weekday := spec.fields[2], spec.fields[fieldIndexWeekday]
+	if (day == "?") == (weekday == "?") {
+		return cronSpec{}, nil, fmt.Errorf("%w: %s needs \"?\" in exactly one of the day and weekday fields",
+			ErrUnsupportedSyntax, from)
+	}
+
+	var notes []string
+
+	if day == "?" {
+		spec.fields[2] = "*"
+	}
+
+	if weekday == "?" {
+		spec.fields[fieldIndexWeekday] = "*"
+	} else {
+		standard, err := shiftWeekdays(weekday, -1)
+		if err != nil {
+			return cronSpec{}, nil, err
+		}
+
+		if standard != weekday {
+			notes = append(notes, fmt.Sprintf("weekday %q renumbered to %q: standard cron counts Sunday as 0", weekday, standard))
+		}
+
+		spec.fields[fieldIndexWeekday] = standard
+	}
+
+	for index, field := range spec.fields {
+		if standard := expandStartSteps(field, index); standard != field {
+			notes = append(notes, fmt.Sprintf("%s %q written as %q: standard cron needs a range or * before a step",
+				fieldNames[index], field, standard))
+			spec.fields[index] = standard
+		}
+	}
+
+	if spec.seconds != "" {
+		if _, err := expandField(spec.seconds, 0); err != nil {
+			return cronSpec{}, nil, fmt.Errorf("%w: seconds %q", ErrInvalidValue, spec.seconds)
+		}
+	}
+
+	if err := validateStandardFields(spec.fields); err != nil {
+		return cronSpec{}, nil, err
+	}
+
+	return spec, notes, nil
+}
+
+// formatSpec writes a spec in the target dialect
+func formatSpec(spec cronSpec, target dialect) (string, []string, error) {
+	var notes []string
+
+	if spec.seconds != "" && spec.seconds != "0" && target != dialectSeconds && target != dialectAzure &&
+		target != dialectQuartz {
+		notes = append(notes, fmt.Sprintf("seconds %q dropped: %s runs at most once per minute", spec.seconds, target))
+	}
+
+	if spec.year != "" && spec.year != "*" && target != dialectQuartz && target != dialectAWS {
+		notes = append(notes, fmt.Sprintf("year %q dropped: %s has no year field", spec.year, target))
+	}
+
+	seconds := spec.seconds
+	if seconds == "" {
+		seconds = "0"
+	}
+
+	switch target {
+	case dialectStandard, dialectJenkins:
+		return strings.Join(spec.fields, " "), notes, nil
+	case dialectSeconds, dialectAzure:
+		return seconds + " " + strings.Join(spec.fields, " "), notes, nil
+	case dialectQuartz, dialectAWS:
+		fields, quartzNotes, err := quartzFields(spec.fields, target)
+		if err != nil {
+			return "", nil, err
+		}
+
+		notes = append(notes, quartzNotes...)
+
+		year := spec.year
+		if year == "" {
+			year = "*"
+		}
+
+		if target == dialectAWS {
+			if spec.seconds != "" && spec.seconds != "0" {
+				notes = append(notes, fmt.Sprintf("seconds %q dropped: aws runs at most once per minute", spec.seconds))
+			}
+
+			return "cron(" + strings.Join(fields, " ") + " " + year + ")", notes, nil
+		}
+
+		expression := seconds + " " + strings.Join(fields, " ")
+		if spec.year != "" {
+			expression += " " + year
+		}
+
+		return expression, notes, nil
+	}
+
+	return "", nil, fmt.Errorf("%w: %q", ErrUnknownDialect, target)
+}
+
+// quartzFields adapts standard fields to Quartz/AWS conventions: Sunday is 1
+// and exactly one of the day and weekday fields must be "?"
+func quartzFields(standard []string, target dialect) ([]string, []string, error) {
+	fields := append([]string(nil), standard...)
+	day, weekday := fields[2], fields[fieldIndexWeekday]
+
+	var notes []string
+
+	switch {
+	case weekday == "*":
+		fields[fieldIndexWeekday] = "?"
+	case day == "*":
+		fields[2] = "?"
+
+		shifted, err := shiftWeekdays(weekday, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if shifted != weekday {
+			notes = append(notes, fmt.Sprintf("weekday %q renumbered to %q: %s counts Sunday as 1", weekday, shifted, target))
+		}
+
+		fields[fieldIndexWeekday] = shifted
+	default:
+		return nil, nil, fmt.Errorf("%w: %s cannot restrict both day %q and weekday %q (standard cron runs when either matches)",
+			ErrUnsupportedSyntax, target, day, weekday)
+	}
+
+	return fields, notes, nil
+}
+
+// shiftWeekdays renumbers a weekday field between standard numbering (Sunday 0)
+// and Quartz numbering (Sunday 1). Fields written only with names are returned
+// unchanged since names mean the same day in both.
+func shiftWeekdays(weekday string, offset int) (string, error) {
+	if weekday == "*" || isNameList(weekday, fieldIndexWeekday) {
+		return weekday, nil
+	}
+
+	if offset < 0 {
+		// Quartz weekdays run 1-7; expand them as standard 0-6 after shifting each bound
+		var set fieldSet
+
+		for item := range strings.SplitSeq(weekday, ",") {
+			itemSet, err := expandQuartzWeekday(item)
+			if err != nil {
+				return "", err
+			}
+
+			set |= itemSet
+		}
+
+		return set.compactString(), nil
+	}
+
+	set, err := expandField(weekday, fieldIndexWeekday)
+	if err != nil {
+		return "", err
+	}
+
+	return set.shift(offset).compactString(), nil
+}
+
+// expandQuartzWeekday expands a Quartz weekday item (1-7, Sunday 1) into standard values
+func expandQuartzWeekday(item string) (fieldSet, error) {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+	start, end := 1, 7
+
+	if rangePart != "*" {
+		low, high, isRange := strings.Cut(rangePart, "-")
+
+		var err error
+		if start, err = parseQuartzWeekday(low); err != nil {
+			return 0, err
+		}
+
+		end = start
+
+		switch {
+		case isRange:
+			if end, err = parseQuartzWeekday(high); err != nil {
+				return 0, err
+			}
+		case hasStep:
+			end = 7
+		}
+	}
+
+	standard := fmt.Sprintf("%d-%d", start-1, end-1)
+	if hasStep {
+		standard += "/" + stepPart
+	}
+
+	return expandField(standard, fieldIndexWeekday)
+}
+
+// parseQuartzWeekday parses a Quartz weekday number (1-7) or name
+func parseQuartzWeekday(token string) (int, error) {
+	value, err := parseFieldValue(token, fieldIndexWeekday)
+	if err != nil {
+		return 0, fmt.Errorf("%w: weekday %q", ErrInvalidValue, token)
+	}
+
+	if hasLetters(token) {
+		return value + 1, nil // Names mean the same day in both numberings
+	}
+
+	if value < 1 || value > 7 {
+		return 0, fmt.Errorf("%w: weekday %q (quartz weekdays are 1-7)", ErrInvalidValue, token)
+	}
+
+	return value, nil
+}
+
+// isNameList reports whether a field is written only with names, ranges, and
+// lists of names (e.g. "MON-FRI,SUN") so its meaning does not depend on numbering
+func isNameList(field string, fieldIndex int) bool {
+	if fieldIndex != fieldIndexMonth && fieldIndex != fieldIndexWeekday {
+		return false
+	}
+
+	for token := range strings.FieldsFuncSeq(field, func(r rune) bool { return r == ',' || r == '-' }) {
+		if _, err := parseFieldValue(token, fieldIndex); err != nil || !hasLetters(token) {
+			return false
+		}
+	}
+
+	return field != ""
+}
+
+// expandStartSteps rewrites the "N/S" items Quartz allows, stepping from N
+// to the end of the field, as the "N-max/S" standard cron needs, or as "*/S"
+// when N is the first value of the field
+func expandStartSteps(field string, fieldIndex int) string {
+	items := strings.Split(field, ",")
+
+	for position, item := range items {
+		start, step, ok := strings.Cut(item, "/")
+		if !ok || start == "*" || strings.Contains(start, "-") {
+			continue
+		}
+
+		value, err := parseFieldValue(start, fieldIndex)
+		if err != nil {
+			continue // Reported by validateStandardFields
+		}
+
+		bounds := fieldRanges[fieldIndex]
+		if fieldIndex == fieldIndexWeekday {
+			bounds.max = 6 // 7 is Sunday again
+		}
+
+		if value == bounds.min {
+			items[position] = "*/" + step
+		} else {
+			items[position] = fmt.Sprintf("%d-%d/%s", value, bounds.max, step)
+		}
+	}
+
+	return strings.Join(items, ",")
+}
+
+// validateStandardFields checks the five standard fields and parses them with
+// the same parser the editor uses for next run times. A step after a single
+// value, such as 15/10, is rejected: Vixie cron and cronie only take one
+// after a range or *.
+func validateStandardFields(fields []string) error {
+	for index, field := range fields {
+		if _, err := expandField(field, index); err != nil {
+			return err
+		}
+
+		if standard := expandStartSteps(field, index); standard != field {
+			return fmt.Errorf("%w: %s %q (a step needs a range or * before it, as in %q)",
+				ErrInvalidValue, fieldNames[index], field, standard)
+		}
+	}
+
+	if _, err := standardParser.Parse(strings.Join(fields, " ")); err != nil {
+		return fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	return nil
+}
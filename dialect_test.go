@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestConvertExpression verifies conversions in both directions between
+// standard cron and each dialect, including the notes on lossy translations.
+func TestConvertExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		from     dialect
+		target   dialect
+		expected string
+		note     string
+	}{
+		{"0 9 * * 1-5", dialectStandard, dialectQuartz, "0 0 9 ? * 2-6", "renumbered"},
+		{"0 15 10 ? * 6", dialectQuartz, dialectStandard, "15 10 * * 5", "renumbered"},
+		{"0 0 0 ? * 1/2", dialectQuartz, dialectStandard, "0 0 * * 0,2,4,6", "renumbered"},
+		{"0 0 12 1 * ? 2030", dialectQuartz, dialectStandard, "0 12 1 * *", "year"},
+		{"0 15/10 * ? * *", dialectQuartz, dialectStandard, "15-59/10 * * * *", "range"},
+		{"0 0/5 * ? * MON-FRI *", dialectQuartz, dialectStandard, "*/5 * * * MON-FRI", "range"},
+		{"*/5 9-17 * * MON-FRI", dialectStandard, dialectAWS, "cron(*/5 9-17 ? * MON-FRI *)", ""},
+		{"cron(0 12 * * ? 2027)", dialectAWS, dialectStandard, "0 12 * * *", "year"},
+		{"0 0 1 * *", dialectStandard, dialectAWS, "cron(0 0 1 * ? *)", ""},
+		{"30 0 9 * * *", dialectSeconds, dialectStandard, "0 9 * * *", "seconds"},
+		{"@daily", dialectStandard, dialectSeconds, "0 0 0 * * *", ""},
+		{"0 9 * * *", dialectStandard, dialectJenkins, "0 9 * * *", ""},
+		{"30 0 9 * * *", dialectSeconds, dialectQuartz, "30 0 9 * * ?", ""},
+		{"0 */5 * * * *", dialectAzure, dialectStandard, "*/5 * * * *", ""},
+		{"0 30 9 * * MON-FRI", dialectAzure, dialectQuartz, "0 30 9 ? * MON-FRI", ""},
+		{"0 9 * * 1-5", dialectStandard, dialectAzure, "0 0 9 * * 1-5", ""},
+	}
+
+	for _, tt := range tests {
+		result, err := convertExpression(tt.expr, tt.from, tt.target, "")
+		if err != nil {
+			t.Errorf("convert %q from %s to %s returned error: %v", tt.expr, tt.from, tt.target, err)
+
+			continue
+		}
+
+		if result.expression != tt.expected {
+			t.Errorf("convert %q from %s to %s = %q, expected %q", tt.expr, tt.from, tt.target, result.expression, tt.expected)
+		}
+
+		notes := strings.Join(result.notes, "\n")
+		if tt.note == "" && notes != "" {
+			t.Errorf("convert %q: expected no notes, got %q", tt.expr, notes)
+		}
+
+		if !strings.Contains(notes, tt.note) {
+			t.Errorf("convert %q: expected a note mentioning %q, got %q", tt.expr, tt.note, notes)
+		}
+	}
+}
+
+// TestConvertJenkinsHash verifies that H tokens are resolved with the seed and explained.
+func TestConvertJenkinsHash(t *testing.T) {
+	t.Parallel()
+
+	first, err := convertExpression("H H * * *", dialectJenkins, dialectStandard, "job-a")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(first.expression, "H") || len(first.notes) != 1 {
+		t.Errorf("Expected H to be resolved with one note, got %q %v", first.expression, first.notes)
+	}
+
+	second, err := convertExpression("H H * * *", dialectJenkins, dialectStandard, "job-a")
+	if err != nil || second.expression != first.expression {
+		t.Errorf("Expected the same seed to give the same result, got %q and %q", first.expression, second.expression)
+	}
+}
+
+// TestConvertExpressionErrors verifies that untranslatable expressions are
+// rejected with an explanation instead of silently changing meaning.
+func TestConvertExpressionErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		from     dialect
+		target   dialect
+		expected error
+	}{
+		{"0 0 1 * 1", dialectStandard, dialectQuartz, ErrUnsupportedSyntax},
+		{"0 0 0 L * ?", dialectQuartz, dialectStandard, ErrUnsupportedSyntax},
+		{"0 0 0 ? * 6#3", dialectQuartz, dialectStandard, ErrUnsupportedSyntax},
+		{"0 0 0 * * *", dialectQuartz, dialectStandard, ErrUnsupportedSyntax},
+		{"0 0 0 ? * 0", dialectQuartz, dialectStandard, ErrInvalidValue},
+		{"0 0 * * *", dialectSeconds, dialectStandard, ErrFieldCount},
+		{"*/5 * * * *", dialectAzure, dialectStandard, ErrFieldCount},
+		{"0 0 0 * * * *", dialectAzure, dialectStandard, ErrFieldCount},
+		{"0 0 * * ?", dialectAWS, dialectStandard, ErrFieldCount},
+		{"61 * * * *", dialectStandard, dialectQuartz, ErrInvalidValue},
+		{"15/10 * * * *", dialectStandard, dialectQuartz, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		if _, err := convertExpression(tt.expr, tt.from, tt.target, ""); !errors.Is(err, tt.expected) {
+			t.Errorf("convert %q from %s to %s: expected %v, got %v", tt.expr, tt.from, tt.target, tt.expected, err)
+		}
+	}
+
+	if _, err := parseDialect("unix"); !errors.Is(err, ErrUnknownDialect) {
+		t.Errorf("Expected ErrUnknownDialect, got %v", err)
+	}
+
+	if d, err := parseDialect("Quartz"); err != nil || d != dialectQuartz {
+		t.Errorf("Expected dialect names to be case-insensitive, got %q (%v)", d, err)
+	}
+}
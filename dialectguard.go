@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+//nolint:gochecknoglobals
+var guardStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	BorderForeground(colorRed).
+	Foreground(colorWhite).
+	Padding(0, 1)
+
+// dialectGuard blocks the editor when the raw text is written in another
+// dialect, so it is converted or the dialect switched on purpose instead of
+// fields quietly turning red or changing meaning
+type dialectGuard struct {
+	text      string   // Raw text that triggered the guard
+	origin    dialect  // Dialect the text is written in
+	tokens    []string // Fields using syntax the editor's dialect lacks, e.g. "?" or "L"
+	converted string   // Text rewritten in the editor's dialect, "" when it cannot be
+	readable  bool     // Whether the text can be edited after switching to its dialect
+	reason    string   // Why the text cannot be converted, "" when it can
+}
+
+// isFiveFieldDialect reports whether the fields hold the dialect's
+// expressions as they are, so the raw input also takes whole crontab lines
+func isFiveFieldDialect(d dialect) bool {
+	return d == dialectStandard || d == dialectJenkins
+}
+
+// quartzLayout reports whether text is laid out as a Quartz or AWS
+// expression: six or seven fields with "?" in exactly one of the day fields,
+// which neither other dialects nor crontab lines use
+func quartzLayout(text string) (dialect, bool) {
+	trimmed := strings.TrimSpace(text)
+	wrapped := strings.HasPrefix(trimmed, "cron(") && strings.HasSuffix(trimmed, ")")
+	parts := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(trimmed, "cron("), ")"))
+
+	if !wrapped && (len(parts) == numCronFields+1 || len(parts) == numCronFields+2) {
+		if (parts[3] == "?") != (parts[5] == "?") {
+			return dialectQuartz, true
+		}
+	}
+
+	if len(parts) == numCronFields+1 && (parts[2] == "?") != (parts[4] == "?") {
+		return dialectAWS, true
+	}
+
+	return "", false
+}
+
+// quartzTokens lists the fields using "?", "L", "W", or "#", ignoring month
+// and weekday names such as JUL and WED
+func quartzTokens(text string) []string {
+	var tokens []string
+
+	for _, field := range strings.Fields(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(text), "cron("), ")")) {
+		upper := strings.ToUpper(field)
+		for _, name := range append(append([]string(nil), monthNames...), weekdayNames...) {
+			upper = strings.ReplaceAll(upper, name, "")
+		}
+
+		if strings.ContainsAny(upper, "?LW#") {
+			tokens = append(tokens, field)
+		}
+	}
+
+	return tokens
+}
+
+// detectForeignDialect returns a guard when text is written in another
+// dialect than the editor's, nil when the editor's dialect can read it
+func (m *model) detectForeignDialect(text string) *dialectGuard {
+	origin, ok := quartzLayout(text)
+	if !ok || origin == m.dialect || m.readsRaw(text) {
+		return nil
+	}
+
+	guard := &dialectGuard{text: strings.TrimSpace(text), origin: origin, tokens: quartzTokens(text)}
+
+	if conv, err := convertExpression(text, origin, m.dialect, m.hashSeed); err != nil {
+		guard.reason = err.Error()
+	} else {
+		guard.converted = conv.expression
+	}
+
+	_, _, err := parseSpec(text, origin, m.hashSeed)
+	guard.readable = err == nil
+
+	return guard
+}
+
+// readsRaw reports whether the editor's dialect reads text without error
+func (m *model) readsRaw(text string) bool {
+	_, _, err := parseSpec(text, m.dialect, m.hashSeed)
+
+	return err == nil
+}
+
+// readRaw reads the raw text in the editor's dialect. Five-field dialects
+// accept whole crontab lines; others are converted to the standard fields.
+func (m *model) readRaw() (crontabLine, error) {
+	if isFiveFieldDialect(m.dialect) {
+		return parseCrontabLine(m.rawInput.Value())
+	}
+
+	conv, err := convertExpression(m.rawInput.Value(), m.dialect, dialectStandard, m.hashSeed)
+	if err != nil {
+		return crontabLine{}, err
+	}
+
+	return crontabLine{env: m.lineEnv, fields: strings.Fields(conv.standard), command: m.lineCommand}, nil
+}
+
+// guardRaw raises the guard when the raw text is in another dialect. It
+// reports whether the guard is now shown.
+func (m *model) guardRaw() bool {
+	m.dialectGuard = m.detectForeignDialect(m.rawInput.Value())
+
+	return m.dialectGuard != nil
+}
+
+// switchDialect changes the dialect the raw input is read and written in,
+// rewriting the raw text from the fields
+func (m *model) switchDialect(target dialect) {
+	m.dialect = target
+	m.rawConflict = ""
+	m.syncRawFromFields()
+}
+
+// nextDialect cycles the raw input through the supported dialects, showing
+// the conversion report for each switch
+func (m *model) nextDialect() tea.Cmd {
+	for index, candidate := range dialects {
+		if candidate == m.dialect {
+			m.requestDialectSwitch(dialects[(index+1)%len(dialects)])
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// handleDialectGuardKey accepts only the guard's choices while it is shown
+func (m *model) handleDialectGuardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	guard := m.dialectGuard
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "c":
+		if guard.converted == "" {
+			return m, nil
+		}
+
+		m.rawInput.SetValue(guard.converted)
+	case "s":
+		if !guard.readable {
+			return m, nil
+		}
+
+		m.dialect = guard.origin
+	case "u":
+		m.syncRawFromFields()
+	default:
+		return m, nil
+	}
+
+	m.dialectGuard = nil
+	m.rawInput.CursorEnd()
+	m.syncFieldsFromRaw()
+
+	return m, m.scheduleCmd()
+}
+
+// renderDialectGuard renders the blocking prompt and its choices
+func (m *model) renderDialectGuard() string {
+	if m.dialectGuard == nil {
+		return ""
+	}
+
+	return m.place(guardStyle.Render(strings.Join(m.dialectGuardLines(), "\n"))) + "\n"
+}
+
+// dialectGuardLines explains the guard and lists the keys that resolve it
+func (m *model) dialectGuardLines() []string {
+	guard := m.dialectGuard
+	lines := []string{fmt.Sprintf("%q is a %s expression, not %s", guard.text, guard.origin, m.dialect)}
+
+	if len(guard.tokens) > 0 {
+		lines = append(lines, fmt.Sprintf("%s uses %s syntax; editing it as %s would change its meaning",
+			strings.Join(guard.tokens, " "), guard.origin, m.dialect))
+	}
+
+	if guard.converted != "" {
+		lines = append(lines, fmt.Sprintf("c  convert to %s: %s", m.dialect, guard.converted))
+	} else {
+		lines = append(lines, "cannot convert: "+guard.reason)
+	}
+
+	if guard.readable {
+		lines = append(lines, fmt.Sprintf("s  switch the raw input to %s", guard.origin))
+	}
+
+	return append(lines, "u  undo the change")
+}
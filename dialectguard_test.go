@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pasteRaw opens the raw input, clears it, and pastes text into it
+func pasteRaw(t *testing.T, m *model, text string) *model {
+	t.Helper()
+
+	if !m.rawMode {
+		m.toggleRawMode()
+	}
+
+	m.rawInput.SetValue("")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text), Paste: true})
+
+	return assertModelType(t, newModel)
+}
+
+// pressKey sends a single key to the model
+func pressKey(t *testing.T, m *model, key string) *model {
+	t.Helper()
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+
+	return assertModelType(t, newModel)
+}
+
+// TestQuartzLayout verifies that Quartz and AWS expressions are recognized by
+// their "?" day field and that standard expressions and crontab lines are not.
+func TestQuartzLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text     string
+		expected dialect
+		ok       bool
+	}{
+		{"0 0 12 ? * MON", dialectQuartz, true},
+		{"0 0 12 L * ? 2030", dialectQuartz, true},
+		{"0 12 ? * MON *", dialectAWS, true},
+		{"cron(0 12 * * ? *)", dialectAWS, true},
+		{"0 12 * * 1", "", false},
+		{"0 2 * * * curl https://example.com/?ping", "", false},
+		{"0 0 12 * * ?", dialectQuartz, true},
+		{"0 0 12 ? * ?", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := quartzLayout(tt.text)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("quartzLayout(%q) = %q, %v, expected %q, %v", tt.text, got, ok, tt.expected, tt.ok)
+		}
+	}
+
+	if tokens := quartzTokens("0 0 12 ? JUL 6#3"); strings.Join(tokens, " ") != "? 6#3" {
+		t.Errorf("Expected ? and 6#3 as Quartz tokens, got %q", tokens)
+	}
+}
+
+// TestDialectGuardConvert verifies that pasting a Quartz expression into the
+// standard raw input blocks the editor until it is converted.
+func TestDialectGuardConvert(t *testing.T) {
+	t.Parallel()
+
+	m := pasteRaw(t, initialModel(), "0 0 12 ? * MON")
+
+	if m.dialectGuard == nil || m.dialectGuard.origin != dialectQuartz || m.buildCronExpression() != initialCron {
+		t.Fatalf("Expected the guard with the fields untouched, got %+v and %q", m.dialectGuard, m.buildCronExpression())
+	}
+
+	if view := m.View(); !strings.Contains(view, "is a quartz expression, not standard") ||
+		!strings.Contains(view, "c  convert to standard: 0 12 * * MON") {
+		t.Errorf("Expected the guard in the view, got:\n%s", view)
+	}
+
+	m = pressKey(t, m, "x")
+	if m.dialectGuard == nil || m.rawInput.Value() != "0 0 12 ? * MON" {
+		t.Fatalf("Expected other keys to be ignored while the guard is shown, got %q", m.rawInput.Value())
+	}
+
+	m = pressKey(t, m, "c")
+	if m.dialectGuard != nil || m.rawInput.Value() != "0 12 * * MON" || m.buildCronExpression() != "0 12 * * MON" {
+		t.Errorf("Expected the converted expression, got raw %q and fields %q", m.rawInput.Value(), m.buildCronExpression())
+	}
+}
+
+// TestDialectGuardSwitch verifies that switching keeps the pasted text and
+// reads it in its own dialect.
+func TestDialectGuardSwitch(t *testing.T) {
+	t.Parallel()
+
+	m := pasteRaw(t, initialModel(), "cron(15 10 ? * 6 *)")
+	m = pressKey(t, m, "s")
+
+	if m.dialectGuard != nil || m.dialect != dialectAWS || m.rawInput.Value() != "cron(15 10 ? * 6 *)" {
+		t.Fatalf("Expected the raw input switched to aws, got %q in %s", m.rawInput.Value(), m.dialect)
+	}
+
+	if m.buildCronExpression() != "15 10 * * 5" || m.rawConflict != "" {
+		t.Errorf("Expected Friday in standard numbering, got %q (%s)", m.buildCronExpression(), m.rawConflict)
+	}
+}
+
+// TestDialectGuardUnconvertible verifies that syntax with no standard
+// equivalent can only be undone, and that typing it and pressing enter is
+// blocked the same way.
+func TestDialectGuardUnconvertible(t *testing.T) {
+	t.Parallel()
+
+	m := pasteRaw(t, initialModel(), "0 0 12 L * ?")
+
+	if m.dialectGuard == nil || m.dialectGuard.converted != "" || m.dialectGuard.readable {
+		t.Fatalf("Expected an unconvertible guard, got %+v", m.dialectGuard)
+	}
+
+	for _, key := range []string{"c", "s"} {
+		if m = pressKey(t, m, key); m.dialectGuard == nil {
+			t.Fatalf("Expected %q to be refused", key)
+		}
+	}
+
+	m = pressKey(t, m, "u")
+	if m.dialectGuard != nil || m.rawInput.Value() != initialCron {
+		t.Fatalf("Expected undo to restore the raw input, got %q", m.rawInput.Value())
+	}
+
+	m.rawInput.SetValue("")
+	m = typeRaw(t, m, "0 0 12 ? * MON")
+
+	if m.dialectGuard != nil || !strings.Contains(m.rawConflict, "reads as quartz, not standard") {
+		t.Errorf("Expected a conflict while typing, got %+v (%s)", m.dialectGuard, m.rawConflict)
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m = assertModelType(t, newModel); m.dialectGuard == nil || !m.rawMode {
+		t.Error("Expected enter to raise the guard instead of leaving raw mode")
+	}
+}
+
+// TestNextDialect verifies that alt+d rewrites the raw input in the next
+// dialect and that, once accepted, raw text is read in it.
+func TestNextDialect(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}, Alt: true})
+	m = assertModelType(t, newModel)
+
+	if m.dialect != dialectSeconds || m.rawInput.Value() != "0 20 4 * * *" {
+		t.Fatalf("Expected the seconds dialect, got %q in %s", m.rawInput.Value(), m.dialect)
+	}
+
+	m = pressKey(t, m, "a")
+
+	m.rawInput.SetValue("")
+	m = typeRaw(t, m, "0 30 5 * * 1")
+
+	if m.buildCronExpression() != "30 5 * * 1" || m.rawConflict != "" {
+		t.Errorf("Expected the fields read from seconds, got %q (%s)", m.buildCronExpression(), m.rawConflict)
+	}
+
+	m.plain = true
+	if !strings.Contains(m.View(), "dialect: seconds\n") {
+		t.Errorf("Expected the dialect in the plain view, got:\n%s", m.View())
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	reportFieldWidth = 9 // Width of the field name column in the conversion report
+	reportValueWidth = 9 // Width of the before and after value columns
+)
+
+//nolint:gochecknoglobals
+var (
+	// Order of the fields in the conversion report, across every dialect
+	reportFields = []string{"seconds", "minute", "hour", "day", "month", "weekday", "year"}
+)
+
+// fieldChange is how one field reads before and after a dialect switch
+type fieldChange struct {
+	name   string // Field name, e.g. "weekday" or "seconds"
+	before string // Value in the old dialect, "" when it has no such field
+	after  string // Value in the new dialect, "" when it has no such field
+}
+
+// status describes the change, "" when the field reads the same
+func (change fieldChange) status() string {
+	switch {
+	case change.before == change.after:
+		return ""
+	case change.before == "":
+		return "added"
+	case change.after == "":
+		return "dropped"
+	default:
+		return "changed"
+	}
+}
+
+// dialectReport lists what switching the raw input between dialects does to
+// each field. Accepted reports are kept so the switch can be undone.
+type dialectReport struct {
+	from    dialect       // Dialect switched from
+	to      dialect       // Dialect switched to
+	before  string        // Expression in the old dialect
+	after   string        // Expression in the new dialect, "" when it cannot be written
+	changes []fieldChange // Every field either dialect has, in report order
+	notes   []string      // Explanations of renumbered or dropped values
+	err     string        // Change required before the switch can be made, "" when it can
+}
+
+// dialectFieldValues names the fields of an expression written in a dialect
+func dialectFieldValues(expr string, d dialect) map[string]string {
+	trimmed := strings.TrimSpace(expr)
+	if d == dialectAWS {
+		trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "cron("), ")")
+	}
+
+	capability, _ := lookupCapability(d)
+	layout := capability.layout()
+	values := make(map[string]string)
+
+	for index, part := range strings.Fields(trimmed) {
+		if index < len(layout) {
+			values[layout[index]] = part
+		}
+	}
+
+	return values
+}
+
+// dialectExpression writes the fields' expression in a dialect
+func (m *model) dialectExpression(d dialect) (conversion, error) {
+	if isFiveFieldDialect(d) {
+		return conversion{expression: m.buildCronExpression(), standard: m.buildCronExpression()}, nil
+	}
+
+	return convertExpression(m.buildCronExpression(), dialectJenkins, d, m.hashSeed)
+}
+
+// newDialectReport compares the fields' expression in two dialects field by field
+func (m *model) newDialectReport(from, to dialect) *dialectReport {
+	report := &dialectReport{from: from, to: to}
+
+	if before, err := m.dialectExpression(from); err == nil {
+		report.before = before.expression
+	}
+
+	after, err := m.dialectExpression(to)
+	if err != nil {
+		report.err = err.Error()
+	} else {
+		report.after = after.expression
+		report.notes = after.notes
+	}
+
+	beforeValues := dialectFieldValues(report.before, from)
+	afterValues := dialectFieldValues(report.after, to)
+
+	for _, name := range reportFields {
+		change := fieldChange{name: name, before: beforeValues[name], after: afterValues[name]}
+		if change.before != "" || change.after != "" {
+			report.changes = append(report.changes, change)
+		}
+
+		if change.after == "?" && change.before != "?" {
+			report.notes = append(report.notes, fmt.Sprintf("%s %q written as \"?\": %s needs it in the unrestricted day field",
+				name, change.before, to))
+		}
+	}
+
+	return report
+}
+
+// requestDialectSwitch switches the raw input to another dialect and shows
+// the conversion report for the switch to be accepted or reverted. When the
+// expression cannot be written in the new dialect the report explains what
+// needs changing and nothing is switched.
+func (m *model) requestDialectSwitch(target dialect) {
+	m.dialectReport = m.newDialectReport(m.dialect, target)
+	if m.dialectReport.err == "" {
+		m.switchDialect(target)
+	}
+}
+
+// undoDialectSwitch reverts the last accepted dialect switch
+func (m *model) undoDialectSwitch() tea.Cmd {
+	if len(m.dialectHistory) == 0 {
+		return nil
+	}
+
+	last := m.dialectHistory[len(m.dialectHistory)-1]
+	m.dialectHistory = m.dialectHistory[:len(m.dialectHistory)-1]
+	m.switchDialect(last.from)
+
+	return nil
+}
+
+// handleDialectReportKey accepts or reverts the switch while its report is shown
+func (m *model) handleDialectReportKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	report := m.dialectReport
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "a", "enter":
+		if report.err != "" {
+			return m, nil
+		}
+
+		m.dialectHistory = append(m.dialectHistory, *report)
+	case "r":
+		if report.err == "" {
+			m.switchDialect(report.from)
+		}
+	default:
+		return m, nil
+	}
+
+	m.dialectReport = nil
+
+	return m, nil
+}
+
+// dialectReportLines lays out the report as a table of field changes
+// followed by the notes and the keys that resolve it
+func (m *model) dialectReportLines() []string {
+	report := m.dialectReport
+	lines := []string{fmt.Sprintf("%s → %s", report.from, report.to)}
+
+	for _, change := range report.changes {
+		line := fmt.Sprintf("%-*s %s", reportFieldWidth, change.name, orDash(change.before))
+		if report.err == "" {
+			line = fmt.Sprintf("%-*s %-*s %-*s %s", reportFieldWidth, change.name, reportValueWidth, orDash(change.before),
+				reportValueWidth, orDash(change.after), change.status())
+		}
+
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+
+	lines = append(lines, report.notes...)
+
+	if report.err != "" {
+		return append(lines, "required: "+report.err, fmt.Sprintf("r  stay in %s", report.from))
+	}
+
+	return append(lines, fmt.Sprintf("a  accept %s (ctrl+z undoes it later)", report.to), fmt.Sprintf("r  revert to %s", report.from))
+}
+
+// orDash stands in "-" for a field a dialect does not have
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+// renderDialectReport renders the pending conversion report
+func (m *model) renderDialectReport() string {
+	if m.dialectReport == nil {
+		return ""
+	}
+
+	return m.place(peekStyle.Render(strings.Join(m.dialectReportLines(), "\n"))) + "\n"
+}
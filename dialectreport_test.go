@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestNewDialectReport verifies that the report lists every field of both
+// dialects with what changed and why.
+func TestNewDialectReport(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("20 4 * * 1-5")
+
+	report := m.newDialectReport(dialectStandard, dialectQuartz)
+	if report.err != "" || report.before != "20 4 * * 1-5" || report.after != "0 20 4 ? * 2-6" {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+
+	expected := []fieldChange{
+		{name: "seconds", after: "0"},
+		{name: "minute", before: "20", after: "20"},
+		{name: "hour", before: "4", after: "4"},
+		{name: "day", before: "*", after: "?"},
+		{name: "month", before: "*", after: "*"},
+		{name: "weekday", before: "1-5", after: "2-6"},
+	}
+
+	if len(report.changes) != len(expected) {
+		t.Fatalf("Expected %d fields, got %+v", len(expected), report.changes)
+	}
+
+	for index, change := range report.changes {
+		if change != expected[index] {
+			t.Errorf("Field %d = %+v, expected %+v", index, change, expected[index])
+		}
+	}
+
+	statuses := []string{report.changes[0].status(), report.changes[1].status(), report.changes[5].status()}
+	if strings.Join(statuses, ",") != "added,,changed" {
+		t.Errorf("Unexpected statuses %q", statuses)
+	}
+
+	if notes := strings.Join(report.notes, "\n"); !strings.Contains(notes, "renumbered") || !strings.Contains(notes, `day "*" written as "?"`) {
+		t.Errorf("Expected the renumbering and ? explained, got:\n%s", notes)
+	}
+
+	back := m.newDialectReport(dialectQuartz, dialectStandard)
+	if back.changes[0].status() != "dropped" {
+		t.Errorf("Expected seconds to be dropped, got %+v", back.changes[0])
+	}
+}
+
+// TestDialectReportAcceptUndo verifies that an accepted switch is recorded
+// and that ctrl+z undoes it.
+func TestDialectReportAcceptUndo(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}, Alt: true})
+	m = assertModelType(t, newModel)
+
+	if m.dialectReport == nil || !strings.Contains(m.View(), "standard → seconds") {
+		t.Fatalf("Expected the conversion report in the view, got:\n%s", m.View())
+	}
+
+	m = pressKey(t, m, "x")
+	if m.dialectReport == nil {
+		t.Fatal("Expected other keys to be ignored while the report is shown")
+	}
+
+	m = pressKey(t, m, "a")
+	if m.dialectReport != nil || m.dialect != dialectSeconds || len(m.dialectHistory) != 1 {
+		t.Fatalf("Expected the switch accepted and recorded, got %s with %d entries", m.dialect, len(m.dialectHistory))
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	m = assertModelType(t, newModel)
+
+	if m.dialect != dialectStandard || m.rawInput.Value() != initialCron || len(m.dialectHistory) != 0 {
+		t.Errorf("Expected ctrl+z to restore standard, got %q in %s", m.rawInput.Value(), m.dialect)
+	}
+}
+
+// TestDialectReportRevert verifies that reverting restores the previous
+// dialect and that a switch the expression cannot make is refused.
+func TestDialectReportRevert(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+	m.requestDialectSwitch(dialectAWS)
+
+	if m.rawInput.Value() != "cron(20 4 * * ? *)" {
+		t.Fatalf("Expected the raw input in aws while the report is shown, got %q", m.rawInput.Value())
+	}
+
+	m = pressKey(t, m, "r")
+	if m.dialectReport != nil || m.dialect != dialectStandard || m.rawInput.Value() != initialCron || len(m.dialectHistory) != 0 {
+		t.Fatalf("Expected the switch reverted, got %q in %s", m.rawInput.Value(), m.dialect)
+	}
+
+	m.setExpression("0 12 1 * 1")
+	m.requestDialectSwitch(dialectQuartz)
+
+	if m.dialect != dialectStandard || m.dialectReport == nil || !strings.Contains(m.dialectReport.err, "cannot restrict both") {
+		t.Fatalf("Expected the switch refused with what is required, got %s and %+v", m.dialect, m.dialectReport)
+	}
+
+	m.plain = true
+	if view := m.View(); !strings.Contains(view, "required: ") || strings.Contains(view, "a  accept") {
+		t.Errorf("Expected only the required change in the view, got:\n%s", view)
+	}
+
+	if m = pressKey(t, m, "a"); m.dialectReport == nil {
+		t.Fatal("Expected accept to be refused")
+	}
+
+	if m = pressKey(t, m, "r"); m.dialectReport != nil || m.dialect != dialectStandard {
+		t.Errorf("Expected r to close the report in standard, got %s", m.dialect)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// descriptionOpenings are how every description starts, e.g. "At 09:00 AM"
+// or "Every 15 minutes". A comment right above a job starting the same way
+// is taken to describe its schedule; other comments are left alone.
+var descriptionOpenings = []string{"At ", "Every "} //nolint:gochecknoglobals
+
+// descriptionComment returns the index and text of the comment describing a
+// job's schedule on the line above it, reporting false when there is none
+func (doc *crontabDocument) descriptionComment(index int) (int, string, bool) {
+	above := doc.sources[index] - 1
+	if above < 0 || doc.removed[above] {
+		return 0, "", false
+	}
+
+	text, ok := strings.CutPrefix(strings.TrimSpace(doc.line(above)), "#")
+	if !ok {
+		return 0, "", false
+	}
+
+	text = strings.TrimSpace(text)
+	for _, opening := range descriptionOpenings {
+		if strings.HasPrefix(text, opening) {
+			return above, text, true
+		}
+	}
+
+	return 0, "", false
+}
+
+// setDescriptionComment rewrites the comment describing a job's schedule,
+// keeping the indentation, the #, the spacing after it, and the line ending
+func (doc *crontabDocument) setDescriptionComment(index int, description string) {
+	above, text, ok := doc.descriptionComment(index)
+	if !ok {
+		return
+	}
+
+	line := doc.syntax.lines[above].String()
+	start := strings.Index(line, text)
+	doc.syntax.lines[above] = parseCrontabSyntaxLine(line[:start] + description + line[start+len(text):])
+}
+
+// driftedComments returns the jobs whose description comment no longer
+// matches their schedule, as after someone edited the line by hand,
+// leaving out deleted jobs
+func (b *jobBrowser) driftedComments() []int {
+	if b.doc == nil {
+		return nil
+	}
+
+	var drifted []int
+
+	for index := range b.jobs {
+		if slices.Contains(b.trash, index) {
+			continue
+		}
+
+		if _, text, ok := b.doc.descriptionComment(index); ok && text != b.descriptions[index] {
+			drifted = append(drifted, index)
+		}
+	}
+
+	return drifted
+}
+
+// regenerateComment rewrites the selected job's description comment to
+// describe its schedule
+func (b *jobBrowser) regenerateComment() {
+	if !slices.Contains(b.driftedComments(), b.cursor) {
+		b.status = "no outdated comment above " + b.jobs[b.cursor].name
+
+		return
+	}
+
+	b.doc.setDescriptionComment(b.cursor, b.descriptions[b.cursor])
+	b.commentFixed = true
+	b.status = "regenerated the comment above " + b.jobs[b.cursor].name
+}
+
+// renderDrift flags each job whose description comment says something
+// other than its schedule does
+func (b *jobBrowser) renderDrift() string {
+	var builder strings.Builder
+
+	for _, index := range b.driftedComments() {
+		_, text, _ := b.doc.descriptionComment(index)
+		builder.WriteString(conflictStyle.Render(fmt.Sprintf("comment: job %d (%s) says %q but runs %q",
+			index+1, b.jobs[index].name, text, b.descriptions[index])) + "\n")
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestCommentDrift verifies that a description comment no longer matching
+// its job is flagged on load and regenerated with c, that other comments are
+// left alone, and that an edit in the editor is flagged too.
+func TestCommentDrift(t *testing.T) {
+	t.Parallel()
+
+	report := "# At 09:00 AM, Monday through Friday\n0 9 * * 1-5 report.sh\n"
+	text := "# backups\n  #  At 03:00 AM\r\n0 4 * * * backup.sh\n" + report
+
+	b, doc, err := newCrontabBrowser(crontabTarget{}, text)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	drifted := b.driftedComments()
+	if !slices.Equal(drifted, []int{0}) || !strings.Contains(b.status, "1 description comments") {
+		t.Fatalf("Expected backup.sh flagged on load, got %v and %q", drifted, b.status)
+	}
+
+	if view := b.View(); !strings.Contains(view, `says "At 03:00 AM" but runs "At 04:00 AM"`) {
+		t.Errorf("Expected the drift flagged in the list:\n%s", view)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+
+	expected := "# backups\n  #  At 04:00 AM\r\n0 4 * * * backup.sh\n" + report
+	if doc.text() != expected || !b.changed() || len(b.driftedComments()) != 0 {
+		t.Errorf("Expected the comment regenerated in place, got %q", doc.text())
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+	b.editor.setExpression("0 10 * * 1-5")
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if drifted = b.driftedComments(); !slices.Equal(drifted, []int{1}) {
+		t.Errorf("Expected report.sh flagged after its edit, got %v", drifted)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+const (
+	dstSearchDays   = 366                    // How far ahead to look for a daylight saving change
+	dstWindowBefore = 3 * 24 * time.Hour     // Runs listed before the change
+	dstWindowAfter  = 4 * 24 * time.Hour     // Runs listed after the change
+	maxDSTRuns      = 48                     // Most runs listed; frequent schedules keep those nearest the change
+	dstTimeLayout   = "Mon 2006-01-02 15:04" // Layout of the local and UTC run times
+)
+
+// dstTransition is a change of UTC offset in a time zone
+type dstTransition struct {
+	at           time.Time // First instant with the new offset
+	offsetBefore int       // UTC offset in seconds before the change
+	offsetAfter  int       // UTC offset in seconds after the change
+}
+
+// nextTransition finds the first offset change in the zone after now, to the
+// second. It reports false when the zone has none within a year.
+func nextTransition(location *time.Location, now time.Time) (dstTransition, bool) {
+	_, offset := now.In(location).Zone()
+
+	low := now
+	for range dstSearchDays {
+		high := low.Add(24 * time.Hour)
+		if _, next := high.In(location).Zone(); next != offset {
+			// Narrow the day down to the second the offset changes
+			for high.Sub(low) > time.Second {
+				middle := low.Add(high.Sub(low) / 2)
+				if _, current := middle.In(location).Zone(); current == offset {
+					low = middle
+				} else {
+					high = middle
+				}
+			}
+
+			_, after := high.In(location).Zone()
+
+			return dstTransition{at: high.Truncate(time.Second), offsetBefore: offset, offsetAfter: after}, true
+		}
+
+		low = high
+	}
+
+	return dstTransition{}, false
+}
+
+// affectedRuns returns the wall-clock times the schedule matches inside the
+// hour the change skips or repeats. The schedule is evaluated in UTC so the
+// wall-clock times can be walked without the zone getting in the way.
+func affectedRuns(schedule cronparser.Schedule, change dstTransition) []time.Time {
+	start := change.at.Add(time.Duration(min(change.offsetBefore, change.offsetAfter)) * time.Second)
+	end := change.at.Add(time.Duration(max(change.offsetBefore, change.offsetAfter)) * time.Second)
+
+	var runs []time.Time
+
+	for next := schedule.Next(start.UTC().Add(-time.Second)); next.Before(end) && len(runs) < maxDSTRuns; next = schedule.Next(next) {
+		runs = append(runs, next)
+	}
+
+	return runs
+}
+
+// dstRuns lists the runs in the week around the change. When there are more
+// than maxDSTRuns, the runs nearest the change on either side are kept.
+func dstRuns(schedule cronparser.Schedule, location *time.Location, change dstTransition) ([]time.Time, []time.Time) {
+	var before, after []time.Time
+
+	end := change.at.Add(dstWindowAfter)
+
+	for next := schedule.Next(change.at.Add(-dstWindowBefore).In(location)); next.Before(end); next = schedule.Next(next) {
+		if next.Before(change.at) {
+			before = append(before, next)
+			if len(before) > maxDSTRuns/2 {
+				before = before[1:]
+			}
+
+			continue
+		}
+
+		if len(after) == maxDSTRuns/2 {
+			break
+		}
+
+		after = append(after, next)
+	}
+
+	return before, after
+}
+
+// formatOffset formats a UTC offset such as +01:00
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// dstPreview lists every run in the week around the zone's next daylight
+// saving change with both local and UTC times, followed by the runs the
+// change skips or may repeat
+func dstPreview(expr string, location *time.Location, now time.Time) (string, error) {
+	fields, err := splitRawExpression(expr)
+	if err != nil {
+		return "", err
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	change, ok := nextTransition(location, now)
+	if !ok {
+		return fmt.Sprintf("%s has no daylight saving change in the next year\n", location), nil
+	}
+
+	var builder strings.Builder
+
+	direction, shift := "forward", change.offsetAfter-change.offsetBefore
+	if shift < 0 {
+		direction, shift = "back", -shift
+	}
+
+	wallBefore := change.at.Add(time.Duration(change.offsetBefore) * time.Second).UTC()
+	fmt.Fprintf(&builder, "Clocks in %s go %s %s at %s local (UTC%s to UTC%s)\n\n",
+		location, direction, formatGap(time.Duration(shift)*time.Second), wallBefore.Format(dstTimeLayout),
+		formatOffset(change.offsetBefore), formatOffset(change.offsetAfter))
+
+	before, after := dstRuns(schedule, location, change)
+	if len(before)+len(after) == 0 {
+		builder.WriteString("No runs in the week around the change\n")
+	}
+
+	writeRun := func(run time.Time) {
+		zone, _ := run.Zone()
+		fmt.Fprintf(&builder, "%s %-5s  %s UTC\n", run.Format(dstTimeLayout), zone, run.UTC().Format(dstTimeLayout))
+	}
+
+	for _, run := range before {
+		writeRun(run)
+	}
+
+	if len(before) > 0 && len(after) > 0 {
+		fmt.Fprintf(&builder, "-- clocks go %s %s --\n", direction, formatGap(time.Duration(shift)*time.Second))
+	}
+
+	for _, run := range after {
+		writeRun(run)
+	}
+
+	utcSchedule, err := standardParser.Parse("CRON_TZ=UTC " + strings.Join(fields, " "))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	if affected := affectedRuns(utcSchedule, change); len(affected) > 0 {
+		times := make([]string, 0, len(affected))
+		for _, run := range affected {
+			times = append(times, run.Format("15:04"))
+		}
+
+		if direction == "forward" {
+			fmt.Fprintf(&builder, "\nSkipped: %s local never happens on %s\n", strings.Join(times, ", "), wallBefore.Format("Mon 2006-01-02"))
+		} else {
+			fmt.Fprintf(&builder, "\nRepeated: %s local happens twice on %s; cron daemons differ on whether the job runs twice\n",
+				strings.Join(times, ", "), wallBefore.Format("Mon 2006-01-02"))
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// renderDSTPreview renders the daylight saving preview for the local zone
+func (m *model) renderDSTPreview() string {
+	if !m.showDST {
+		return ""
+	}
+
+	preview, err := dstPreview(m.buildCronExpression(), time.Local, time.Now())
+	if err != nil {
+		preview = err.Error()
+	}
+
+	return m.place(peekStyle.Render(strings.TrimRight(preview, "\n"))) + "\n"
+}
+
+// runDST prints the runs in the week around the next daylight saving change
+func runDST(args []string, stdout, stderr io.Writer) error {
+	var timezone string
+
+	flags := flag.NewFlagSet("dst", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the schedule is read in")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru dst [--timezone ZONE] EXPRESSION", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	preview, err := dstPreview(strings.Join(positional, " "), location, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, preview)
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestNextTransition verifies that the next offset change is found to the
+// second, and that zones without daylight saving report none.
+func TestNextTransition(t *testing.T) {
+	t.Parallel()
+
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	change, ok := nextTransition(lisbon, now)
+	if !ok {
+		t.Fatal("Expected a transition in Europe/Lisbon")
+	}
+
+	expected := time.Date(2025, time.March, 30, 1, 0, 0, 0, time.UTC)
+	if !change.at.Equal(expected) || change.offsetBefore != 0 || change.offsetAfter != 3600 {
+		t.Errorf("Expected change at %v from 0 to 3600, got %v from %d to %d",
+			expected, change.at, change.offsetBefore, change.offsetAfter)
+	}
+
+	if _, ok := nextTransition(time.UTC, now); ok {
+		t.Error("Expected no transition in UTC")
+	}
+}
+
+// TestDSTPreview verifies the runs listed around a change and the notes
+// about runs the change skips or repeats.
+func TestDSTPreview(t *testing.T) {
+	t.Parallel()
+
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		now      time.Time
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "spring forward skips the hour",
+			expr: "30 1 * * *",
+			now:  time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC),
+			contains: []string{
+				"Clocks in Europe/Lisbon go forward 1h at Sun 2025-03-30 01:00 local (UTC+00:00 to UTC+01:00)",
+				"Sat 2025-03-29 01:30 WET    Sat 2025-03-29 01:30 UTC",
+				"-- clocks go forward 1h --",
+				"Mon 2025-03-31 01:30 WEST   Mon 2025-03-31 00:30 UTC",
+				"Skipped: 01:30 local never happens on Sun 2025-03-30",
+			},
+		},
+		{
+			name: "fall back repeats the hour",
+			expr: "15,45 1 * * *",
+			now:  time.Date(2025, time.October, 1, 12, 0, 0, 0, time.UTC),
+			contains: []string{
+				"go back 1h at Sun 2025-10-26 02:00 local",
+				"Repeated: 01:15, 01:45 local happens twice on Sun 2025-10-26",
+			},
+		},
+		{
+			name:     "runs outside the changed hour",
+			expr:     "0 12 * * *",
+			now:      time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC),
+			contains: []string{"Sun 2025-03-30 12:00 WEST   Sun 2025-03-30 11:00 UTC"},
+			excludes: []string{"Skipped", "Repeated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			preview, err := dstPreview(tt.expr, lisbon, tt.now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, want := range tt.contains {
+				if !strings.Contains(preview, want) {
+					t.Errorf("Expected preview to contain %q, got:\n%s", want, preview)
+				}
+			}
+
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(preview, unwanted) {
+					t.Errorf("Expected preview not to contain %q, got:\n%s", unwanted, preview)
+				}
+			}
+		})
+	}
+}
+
+// TestDSTPreviewLimitsRuns verifies that frequent schedules only list the
+// runs nearest the change.
+func TestDSTPreviewLimitsRuns(t *testing.T) {
+	t.Parallel()
+
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	preview, err := dstPreview("* * * * *", lisbon, time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := strings.Count(preview, " UTC\n"); got != maxDSTRuns {
+		t.Errorf("Expected %d runs, got %d", maxDSTRuns, got)
+	}
+
+	if !strings.Contains(preview, "Sun 2025-03-30 00:59 WET ") || !strings.Contains(preview, "Sun 2025-03-30 02:00 WEST") {
+		t.Errorf("Expected the runs either side of the change, got:\n%s", preview)
+	}
+}
+
+// TestDSTPreviewWithoutChange verifies the message for zones that never
+// change their offset.
+func TestDSTPreviewWithoutChange(t *testing.T) {
+	t.Parallel()
+
+	preview, err := dstPreview("0 9 * * *", time.UTC, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if preview != "UTC has no daylight saving change in the next year\n" {
+		t.Errorf("Unexpected preview: %q", preview)
+	}
+}
+
+// TestRunDSTErrors verifies the usage, time zone, and expression errors.
+func TestRunDSTErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{nil, ErrUsage},
+		{[]string{"--timezone", "Mars/Base", "0 9 * * *"}, ErrInvalidValue},
+		{[]string{"--timezone", "UTC", "0 9 * *"}, ErrFieldCount},
+	}
+
+	for _, tt := range tests {
+		if err := runDST(tt.args, io.Discard, io.Discard); !errors.Is(err, tt.expected) {
+			t.Errorf("runDST(%q) = %v, expected %v", tt.args, err, tt.expected)
+		}
+	}
+}
+
+// TestDSTToggle verifies that ctrl+g shows and hides the preview.
+func TestDSTToggle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	m = assertModelType(t, newModel)
+
+	if !m.showDST || m.renderDSTPreview() == "" {
+		t.Error("Expected ctrl+g to show the daylight saving preview")
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	m = assertModelType(t, newModel)
+
+	if m.showDST || m.renderDSTPreview() != "" {
+		t.Error("Expected a second ctrl+g to hide the daylight saving preview")
+	}
+}
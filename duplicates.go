@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// duplicate is a job that runs on the same schedule, in the same time zone,
+// as an earlier job
+type duplicate struct {
+	first       int  // Index of the earlier job
+	second      int  // Index of the later job
+	sameCommand bool // Whether both also run the same command
+}
+
+// scheduleKey identifies the times a schedule selects, so schedules written
+// differently but running at the same times, such as "@daily" and
+// "0 0 * * 0-6", share a key. It is "" when the schedule is invalid.
+func scheduleKey(expr string) string {
+	fields, err := splitRawExpression(expr)
+	if err != nil {
+		return ""
+	}
+
+	sets := make([]fieldSet, len(fields))
+	for index, field := range fields {
+		if sets[index], err = expandField(field, index); err != nil {
+			return ""
+		}
+	}
+
+	// Cron matches either day field when both are restricted, and only the
+	// restricted one otherwise
+	days := "and"
+
+	dayStar, weekdayStar := fields[2] == "*", fields[fieldIndexWeekday] == "*"
+	if !dayStar && !weekdayStar {
+		days = "or"
+
+		if sets[2] == fullFieldSet(2) || sets[fieldIndexWeekday] == fullFieldSet(fieldIndexWeekday) {
+			days, sets[2], sets[fieldIndexWeekday] = "and", fullFieldSet(2), fullFieldSet(fieldIndexWeekday)
+		}
+	}
+
+	parts := []string{days}
+	for _, set := range sets {
+		parts = append(parts, set.compactString())
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// findDuplicates pairs each job with the first earlier job running on the
+// same schedule in the same time zone, leaving out jobs skip reports. A nil
+// skip keeps every job.
+func findDuplicates(jobs []browserJob, skip func(index int) bool) []duplicate {
+	var found []duplicate
+
+	firsts := make(map[string]int)
+
+	for index, job := range jobs {
+		if skip != nil && skip(index) {
+			continue
+		}
+
+		key := scheduleKey(job.expr)
+		if key == "" {
+			continue
+		}
+
+		if job.location != nil {
+			key += " " + job.location.String()
+		}
+
+		first, ok := firsts[key]
+		if !ok {
+			firsts[key] = index
+
+			continue
+		}
+
+		found = append(found, duplicate{first: first, second: index, sameCommand: jobWork(job) == jobWork(jobs[first])})
+	}
+
+	return found
+}
+
+// jobWork is what a job runs, for telling duplicates apart: its command with
+// spacing ignored, or its name when it has none, as for CronJobs
+func jobWork(job browserJob) string {
+	if job.command == "" {
+		return job.name
+	}
+
+	return strings.Join(strings.Fields(job.command), " ")
+}
+
+// renderDuplicates lists the jobs that repeat the schedule of an earlier
+// one, leaving out deleted jobs
+func (b *jobBrowser) renderDuplicates() string {
+	trashed := func(index int) bool { return slices.Contains(b.trash, index) }
+	label := func(index int) string { return fmt.Sprintf("job %d (%s)", index+1, b.jobs[index].name) }
+
+	var builder strings.Builder
+
+	for _, found := range findDuplicates(b.jobs, trashed) {
+		builder.WriteString(conflictStyle.Render("duplicate: "+describeDuplicate(found, label)) + "\n")
+	}
+
+	return builder.String()
+}
+
+// describeDuplicate says how a job repeats an earlier one, naming each with
+// label
+func describeDuplicate(found duplicate, label func(index int) string) string {
+	if found.sameCommand {
+		return fmt.Sprintf("%s repeats %s: same schedule and command", label(found.second), label(found.first))
+	}
+
+	return fmt.Sprintf("%s runs on the same schedule as %s with a different command", label(found.second), label(found.first))
+}
+
+// crontabScheduledJobs reads the jobs of a crontab for duplicate detection,
+// returning the line number of each. Jobs run in the zone of the CRON_TZ
+// assignment before them. Lines that do not parse are left to lint to report.
+func crontabScheduledJobs(text string) ([]browserJob, []int) {
+	var (
+		jobs  []browserJob
+		lines []int
+	)
+
+	location := time.Local
+
+	for index, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(strings.TrimSuffix(line, "\r"))
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "@reboot") {
+			continue
+		}
+
+		if token := strings.Fields(trimmed)[0]; isEnvAssignment(token) && token == trimmed {
+			if name, zone, _ := strings.Cut(token, "="); name == "CRON_TZ" {
+				location = crontabZone(strings.Trim(zone, `"'`))
+			}
+
+			continue
+		}
+
+		parsed, err := parseCrontabLine(trimmed)
+		if err != nil || parsed.command == "" {
+			continue
+		}
+
+		jobs = append(jobs, browserJob{
+			name:     parsed.command,
+			expr:     strings.Join(parsed.fields, " "),
+			command:  parsed.command,
+			location: location,
+		})
+		lines = append(lines, index+1)
+	}
+
+	return jobs, lines
+}
+
+// crontabZone loads a CRON_TZ zone, local when empty, keeping an unknown one
+// by name so jobs in it are still told apart from jobs in other zones
+func crontabZone(name string) *time.Location {
+	if name == "" {
+		return time.Local
+	}
+
+	if location, err := time.LoadLocation(name); err == nil {
+		return location
+	}
+
+	return time.FixedZone(name, 0)
+}
+
+// lintDuplicates describes the jobs of a crontab file that run on the same
+// schedule as an earlier one, and counts those that also run the same
+// command. YAML and workspace files are not checked.
+func lintDuplicates(path, text string) ([]string, int) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml", ".json":
+		return nil, 0
+	}
+
+	jobs, lines := crontabScheduledJobs(text)
+	label := func(index int) string { return fmt.Sprintf("line %d", lines[index]) }
+
+	var (
+		messages []string
+		repeated int
+	)
+
+	for _, found := range findDuplicates(jobs, nil) {
+		messages = append(messages, describeDuplicate(found, label))
+
+		if found.sameCommand {
+			repeated++
+		}
+	}
+
+	return messages, repeated
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestScheduleKey verifies that schedules running at the same times share a
+// key however they are written, and that others do not.
+func TestScheduleKey(t *testing.T) {
+	t.Parallel()
+
+	same := [][]string{
+		{"@daily", "0 0 * * *", "0 0 1-31 * *", "0 0 * * 0-6"},
+		{"0 */6 * * *", "0 0,6,12,18 * * *", "0 0-23/6 * * *"},
+		{"0 9 * * 1-5", "0 9 * * MON-FRI", "0 9 * * 1,2,3,4,5"},
+		{"0 9 * * 0", "0 9 * * 7", "0 9 * * SUN"},
+		{"0 9 1-31 * 1-5", "0 9 * * *"},
+	}
+
+	for _, group := range same {
+		for _, expr := range group[1:] {
+			if scheduleKey(expr) != scheduleKey(group[0]) {
+				t.Errorf("Expected %q and %q to share a key", expr, group[0])
+			}
+		}
+	}
+
+	different := [][2]string{
+		{"0 9 1 * *", "0 9 * * 1"},
+		{"0 9 1 * 1", "0 9 1 * *"},
+		{"0 9 * * *", "9 0 * * *"},
+	}
+
+	for _, pair := range different {
+		if scheduleKey(pair[0]) == scheduleKey(pair[1]) {
+			t.Errorf("Expected %q and %q to differ", pair[0], pair[1])
+		}
+	}
+
+	if key := scheduleKey("61 * * * *"); key != "" {
+		t.Errorf("Expected no key for an invalid schedule, got %q", key)
+	}
+}
+
+// TestCrontabDuplicates verifies that repeated jobs are paired with the
+// first job on their schedule, and that jobs in other CRON_TZ zones are not.
+func TestCrontabDuplicates(t *testing.T) {
+	t.Parallel()
+
+	text := "MAILTO=ops\n" +
+		"0 0 * * * backup.sh\n" +
+		"@daily   backup.sh\n" +
+		"0 0 * * 0-6 report.sh\n" +
+		"CRON_TZ=UTC\n" +
+		"0 0 * * * backup.sh\n" +
+		"61 0 * * * broken.sh\n"
+
+	messages, repeated := lintDuplicates("crontab", text)
+
+	expected := []string{
+		"line 3 repeats line 2: same schedule and command",
+		"line 4 runs on the same schedule as line 2 with a different command",
+	}
+
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") || repeated != 1 {
+		t.Errorf("Unexpected duplicates %q (%d repeated), expected %q", messages, repeated, expected)
+	}
+
+	if messages, _ = lintDuplicates("workflow.yml", text); messages != nil {
+		t.Errorf("Expected YAML files not checked, got %q", messages)
+	}
+}
+
+// TestRunLintDuplicates verifies that lint reports duplicates and fails only
+// for jobs repeating both schedule and command.
+func TestRunLintDuplicates(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	if err := os.WriteFile(path, []byte("0 2 * * * backup.sh\n0 2 * * * backup.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	err := runCommand([]string{"lint", path}, &stdout, &stderr)
+	if !errors.Is(err, ErrLintFindings) || !strings.Contains(err.Error(), "1 duplicated") {
+		t.Errorf("Expected a duplicate finding, got %v", err)
+	}
+
+	if !strings.Contains(stderr.String(), path+": line 2 repeats line 1") {
+		t.Errorf("Expected the duplicate reported, got %q", stderr.String())
+	}
+
+	if err := os.WriteFile(path, []byte("0 2 * * * backup.sh\n0 2 * * * report.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	stderr.Reset()
+
+	if err := runCommand([]string{"lint", path}, &stdout, &stderr); err != nil || !strings.Contains(stderr.String(), "different command") {
+		t.Errorf("Expected a passing lint with a report, got %v and %q", err, stderr.String())
+	}
+}
+
+// TestBrowserDuplicates verifies that the job list shows duplicates, and
+// stops once one of them is deleted.
+func TestBrowserDuplicates(t *testing.T) {
+	t.Parallel()
+
+	b, _, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n*/5 * * * * poll.sh\n@daily backup.sh\n0 2 * * * backup.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if view := b.View(); !strings.Contains(view, "duplicate: job 4 (backup.sh) repeats job 1 (backup.sh)") ||
+		strings.Contains(view, "duplicate: job 3") {
+		t.Errorf("Expected job 4 shown as a duplicate, got:\n%s", view)
+	}
+
+	b.trash = []int{3}
+
+	if view := b.View(); strings.Contains(view, "duplicate:") {
+		t.Errorf("Expected no duplicates once deleted, got:\n%s", view)
+	}
+}
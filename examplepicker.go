@@ -0,0 +1,274 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	examplePickerRows  = 12 // Matches listed at once
+	examplePickerWidth = 30 // Width of the filter input
+)
+
+// popularExample is a named expression from the classic examples list
+type popularExample struct {
+	name string // What the expression does, e.g. "every quarter"
+	expr string // Five-field expression
+}
+
+// popularExamples are the expressions people look up most, in the order of
+// the classic list: minutes, hours, days, weeks, months, and years
+//
+//nolint:gochecknoglobals
+var popularExamples = []popularExample{
+	{"every minute", "* * * * *"},
+	{"every 2 minutes", "*/2 * * * *"},
+	{"every even minute", "*/2 * * * *"},
+	{"every uneven minute", "1-59/2 * * * *"},
+	{"every 3 minutes", "*/3 * * * *"},
+	{"every 4 minutes", "*/4 * * * *"},
+	{"every 5 minutes", "*/5 * * * *"},
+	{"every 10 minutes", "*/10 * * * *"},
+	{"every 15 minutes", "*/15 * * * *"},
+	{"every quarter hour", "*/15 * * * *"},
+	{"every 20 minutes", "*/20 * * * *"},
+	{"every 30 minutes", "*/30 * * * *"},
+	{"every half hour", "*/30 * * * *"},
+	{"every hour", "0 * * * *"},
+	{"every hour at 30 minutes past", "30 * * * *"},
+	{"every 2 hours", "0 */2 * * *"},
+	{"every even hour", "0 */2 * * *"},
+	{"every uneven hour", "0 1-23/2 * * *"},
+	{"every 3 hours", "0 */3 * * *"},
+	{"every 4 hours", "0 */4 * * *"},
+	{"every 6 hours", "0 */6 * * *"},
+	{"every 8 hours", "0 */8 * * *"},
+	{"every 12 hours", "0 */12 * * *"},
+	{"twice a day", "0 0,12 * * *"},
+	{"every hour from 9 to 5", "0 9-17 * * *"},
+	{"every 5 minutes during business hours", "*/5 9-17 * * 1-5"},
+	{"every day", "0 0 * * *"},
+	{"every night at midnight", "0 0 * * *"},
+	{"every day at 1am", "0 1 * * *"},
+	{"every day at 2am", "0 2 * * *"},
+	{"every morning at 8am", "0 8 * * *"},
+	{"every day at noon", "0 12 * * *"},
+	{"every evening at 6pm", "0 18 * * *"},
+	{"every weekday", "0 0 * * 1-5"},
+	{"every weekday at 9am", "0 9 * * 1-5"},
+	{"every weekend", "0 0 * * 6,0"},
+	{"every week", "0 0 * * 0"},
+	{"every Monday", "0 0 * * 1"},
+	{"every Tuesday", "0 0 * * 2"},
+	{"every Wednesday", "0 0 * * 3"},
+	{"every Thursday", "0 0 * * 4"},
+	{"every Friday", "0 0 * * 5"},
+	{"every Friday at 5pm", "0 17 * * 5"},
+	{"every Saturday", "0 0 * * 6"},
+	{"every Sunday", "0 0 * * 0"},
+	{"every month", "0 0 1 * *"},
+	{"every 15th of the month", "0 0 15 * *"},
+	{"every other month", "0 0 1 */2 *"},
+	{"every quarter", "0 0 1 */3 *"},
+	{"every 6 months", "0 0 1 */6 *"},
+	{"every year", "0 0 1 1 *"},
+	{"every Christmas", "0 0 25 12 *"},
+}
+
+// examplePicker lists the popular examples matching a filter typed as a
+// fuzzy search, so "5m" finds "every 5 minutes"
+type examplePicker struct {
+	filter  textinput.Model  // Filter typed to narrow the list
+	matches []popularExample // Examples matching the filter, best first
+	cursor  int              // Index into matches of the highlighted example
+}
+
+// fuzzyScore reports whether the letters of query appear in text in order,
+// ignoring case and spaces, and scores the match: letters right after the
+// previous match or at the start of a word count more
+func fuzzyScore(query, text string) (int, bool) {
+	query = strings.ToLower(strings.ReplaceAll(query, " ", ""))
+	text = strings.ToLower(text)
+
+	score, position, previous := 0, 0, -2
+
+	for _, letter := range query {
+		index := strings.IndexRune(text[position:], letter)
+		if index < 0 {
+			return 0, false
+		}
+
+		index += position
+
+		score++
+		if index == previous+1 {
+			score += 2
+		}
+
+		if index == 0 || !unicode.IsLetter(rune(text[index-1])) && !unicode.IsDigit(rune(text[index-1])) {
+			score++
+		}
+
+		previous, position = index, index+len(string(letter))
+	}
+
+	return score, true
+}
+
+// refilter lists the examples matching the filter by name or by expression,
+// best first, then shortest first, so "quarter" puts "every quarter" ahead
+// of "every quarter hour"
+func (p *examplePicker) refilter() {
+	type scored struct {
+		example popularExample
+		score   int
+	}
+
+	var found []scored
+
+	for _, example := range popularExamples {
+		nameScore, byName := fuzzyScore(p.filter.Value(), example.name)
+		exprScore, byExpr := fuzzyScore(p.filter.Value(), example.expr)
+
+		if byName || byExpr {
+			found = append(found, scored{example: example, score: max(nameScore, exprScore)})
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b scored) int {
+		return cmp.Or(b.score-a.score, len(a.example.name)-len(b.example.name))
+	})
+
+	p.matches = p.matches[:0]
+	for _, match := range found {
+		p.matches = append(p.matches, match.example)
+	}
+
+	p.cursor = min(p.cursor, max(0, len(p.matches)-1))
+}
+
+// openExamplePicker lists every popular example with the filter focused
+func (m *model) openExamplePicker() tea.Cmd {
+	filter := textinput.New()
+	filter.Placeholder = "type to filter, e.g. 5 min or weekday"
+	filter.Width = examplePickerWidth
+
+	m.examplePicker = &examplePicker{filter: filter}
+	m.examplePicker.refilter()
+
+	return m.examplePicker.filter.Focus()
+}
+
+// handleExamplePickerKey filters and moves through the examples, loading
+// the highlighted one into the fields on enter
+func (m *model) handleExamplePickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.examplePicker
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.examplePicker = nil
+
+		return m, nil
+	case "up":
+		picker.cursor = max(0, picker.cursor-1)
+
+		return m, nil
+	case "down":
+		picker.cursor = min(max(0, len(picker.matches)-1), picker.cursor+1)
+
+		return m, nil
+	case "enter":
+		if len(picker.matches) == 0 {
+			return m, nil
+		}
+
+		m.examplePicker = nil
+		m.setExpression(picker.matches[picker.cursor].expr)
+
+		return m, m.setFocus(0)
+	}
+
+	var cmd tea.Cmd
+
+	picker.filter, cmd = picker.filter.Update(msg)
+	picker.refilter()
+
+	return m, cmd
+}
+
+// rows lists the matches around the highlighted one as
+// "name  expression" rows, and the index of the highlighted row
+func (p *examplePicker) rows() ([]string, int) {
+	width := 0
+	for _, example := range p.matches {
+		width = max(width, len(example.name))
+	}
+
+	start := max(0, min(p.cursor-examplePickerRows/2, len(p.matches)-examplePickerRows))
+	end := min(len(p.matches), start+examplePickerRows)
+
+	rows := make([]string, 0, end-start)
+	for _, example := range p.matches[start:end] {
+		rows = append(rows, fmt.Sprintf("%-*s  %s", width, example.name, example.expr))
+	}
+
+	return rows, p.cursor - start
+}
+
+// renderExamplePicker renders the filter and the matching examples in place
+// of the fields
+func (m *model) renderExamplePicker() string {
+	picker := m.examplePicker
+
+	var builder strings.Builder
+
+	builder.WriteString(m.place(focusedLabelStyle.Render("Popular examples")) + "\n\n")
+	builder.WriteString(m.place(picker.filter.View()) + "\n\n")
+
+	rows, highlighted := picker.rows()
+	if len(rows) == 0 {
+		builder.WriteString(m.place(labelStyle.Render("no example matches")) + "\n")
+	}
+
+	for index, row := range rows {
+		if index == highlighted {
+			builder.WriteString(m.place(focusedLabelStyle.Render("> "+row)) + "\n")
+		} else {
+			builder.WriteString(m.place(labelStyle.Render("  "+row)) + "\n")
+		}
+	}
+
+	help := fmt.Sprintf("%d of %d · up/down: choose · enter: load · esc: back to the fields", len(picker.matches), len(popularExamples))
+	builder.WriteString(m.place(helpStyle.Render(help)) + "\n")
+
+	return builder.String()
+}
+
+// examplePickerLines lists the filter and matches for the plain view
+func (m *model) examplePickerLines() []string {
+	rows, highlighted := m.examplePicker.rows()
+	lines := []string{"examples filter: " + m.examplePicker.filter.Value()}
+
+	for index, row := range rows {
+		marker := "  "
+		if index == highlighted {
+			marker = "> "
+		}
+
+		lines = append(lines, marker+row)
+	}
+
+	return lines
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestPopularExamplesParse verifies that every popular example is a valid
+// expression with a description.
+func TestPopularExamplesParse(t *testing.T) {
+	t.Parallel()
+
+	for _, example := range popularExamples {
+		if _, err := describeExpression(example.expr); err != nil {
+			t.Errorf("%s: %q does not parse: %v", example.name, example.expr, err)
+		}
+	}
+}
+
+// TestFuzzyScore verifies subsequence matching, ignoring case and spaces,
+// and that adjacent letters and word starts score higher.
+func TestFuzzyScore(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		query, text string
+		matches     bool
+	}{
+		{"", "every minute", true},
+		{"5 min", "every 5 minutes", true},
+		{"QUART", "every quarter", true},
+		{"wkdy", "every weekday", true},
+		{"*/5", "*/5 * * * *", true},
+		{"yearly", "every year", false},
+		{"minute every", "every minute", false},
+	} {
+		if _, ok := fuzzyScore(test.query, test.text); ok != test.matches {
+			t.Errorf("fuzzyScore(%q, %q) matched = %v, expected %v", test.query, test.text, ok, test.matches)
+		}
+	}
+
+	adjacent, _ := fuzzyScore("hour", "every hour")
+	scattered, _ := fuzzyScore("hour", "every half hour at noon run")
+
+	if plural, _ := fuzzyScore("hour", "every 3 hours"); plural <= scattered || adjacent != plural {
+		t.Errorf("Expected whole words to score higher, got %d and %d against %d", adjacent, plural, scattered)
+	}
+}
+
+// TestExamplePicker verifies that filtering puts the best match first and
+// that enter loads the highlighted example into the fields.
+func TestExamplePicker(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e"), Alt: true})
+
+	if m.examplePicker == nil || len(m.examplePicker.matches) != len(popularExamples) {
+		t.Fatal("Expected alt+e to list every popular example")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("quarter")})
+
+	if first := m.examplePicker.matches[0]; first.name != "every quarter" {
+		t.Errorf("Expected \"every quarter\" first, got %q", first.name)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.examplePicker != nil || m.buildCronExpression() != "0 0 1 */3 *" {
+		t.Errorf("Expected \"0 0 1 */3 *\" in the fields, got %q", m.buildCronExpression())
+	}
+}
+
+// TestExamplePickerEscape verifies that esc and a filter matching nothing
+// leave the fields as they were.
+func TestExamplePickerEscape(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	before := m.buildCronExpression()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e"), Alt: true})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzz")})
+
+	if !strings.Contains(m.View(), "no example matches") {
+		t.Errorf("Expected no matches to be shown, got:\n%s", m.View())
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.examplePicker != nil || m.buildCronExpression() != before {
+		t.Errorf("Expected %q kept, got %q", before, m.buildCronExpression())
+	}
+}
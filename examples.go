@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	exampleInterval = 2 * time.Second // How long each field example is shown
+)
+
+//nolint:gochecknoglobals
+var (
+	// Example values cycled through in the help panel for each field
+	fieldExamples = [][]string{
+		{"*/15", "0,30", "5-10", "0-30/10"},
+		{"*/6", "9-17", "0,12", "22-23"},
+		{"1", "1-7", "*/10", "1,15"},
+		{"*/3", "JAN,JUL", "6-8", "DEC"},
+		{"MON-FRI", "0,6", "*/2", "SAT"},
+	}
+
+	exampleStyle = lipgloss.NewStyle().
+			Foreground(colorCyan)
+
+	exampleMarkStyle = lipgloss.NewStyle().
+				Foreground(colorYellow).
+				Bold(true)
+
+	exampleDotStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#444444"))
+)
+
+// exampleTick advances the field example animation. The generation ties a tick
+// to the help session that started it so stale tickers stop on their own.
+type exampleTick struct {
+	generation int
+}
+
+// exampleTickCmd schedules the next example animation frame
+func exampleTickCmd(generation int) tea.Cmd {
+	return tea.Tick(exampleInterval, func(time.Time) tea.Msg {
+		return exampleTick{generation: generation}
+	})
+}
+
+// toggleHelp shows or hides the help panel, starting a fresh example
+// animation each time it is shown
+func (m *model) toggleHelp() tea.Cmd {
+	m.showHelp = !m.showHelp
+	if !m.showHelp {
+		return nil
+	}
+
+	m.exampleGeneration++
+	m.exampleIndex = 0
+
+	return exampleTickCmd(m.exampleGeneration)
+}
+
+// handleExampleTick moves to the next example while the help panel is open
+func (m *model) handleExampleTick(msg exampleTick) tea.Cmd {
+	if !m.showHelp || msg.generation != m.exampleGeneration {
+		return nil
+	}
+
+	m.exampleIndex++
+
+	return exampleTickCmd(m.exampleGeneration)
+}
+
+// currentExample returns the example value for the focused field and the
+// set of values it expands to
+func (m *model) currentExample() (string, fieldSet) {
+	if m.focusIndex < 0 || m.focusIndex >= len(fieldExamples) {
+		return "", 0
+	}
+
+	examples := fieldExamples[m.focusIndex]
+	example := examples[m.exampleIndex%len(examples)]
+
+	set, err := expandField(example, m.focusIndex)
+	if err != nil {
+		return "", 0
+	}
+
+	return example, set
+}
+
+// renderExample renders the focused field's current example with its
+// expansion and a strip marking the selected values across the field's range
+func (m *model) renderExample() string {
+	if !m.showHelp {
+		return ""
+	}
+
+	example, set := m.currentExample()
+	if example == "" {
+		return ""
+	}
+
+	summary := exampleStyle.Render("e.g. " + example + " → " + set.String())
+
+	full := fullFieldSet(m.focusIndex)
+
+	var strip strings.Builder
+
+	for _, value := range full.Values() {
+		if set.Has(value) {
+			strip.WriteString(exampleMarkStyle.Render("●"))
+		} else {
+			strip.WriteString(exampleDotStyle.Render("·"))
+		}
+	}
+
+	return m.place(summary) + "\n" + m.place(strip.String()) + "\n"
+}
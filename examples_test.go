@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestExampleAnimationCyclesWhileHelpIsOpen verifies that opening help starts the
+// example ticker, ticks advance the example, and stale tickers stop.
+func TestExampleAnimationCyclesWhileHelpIsOpen(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 80
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	m = assertModelType(t, newModel)
+
+	if cmd == nil {
+		t.Fatal("Expected opening help to start the example ticker")
+	}
+
+	if !strings.Contains(m.View(), "e.g. */15 → 0,15,30,45") {
+		t.Error("Expected the first minute example with its expansion")
+	}
+
+	newModel, cmd = m.Update(exampleTick{generation: m.exampleGeneration})
+	m = assertModelType(t, newModel)
+
+	if cmd == nil || m.exampleIndex != 1 {
+		t.Errorf("Expected a tick to advance the example and reschedule, index %d", m.exampleIndex)
+	}
+
+	if !strings.Contains(m.View(), "e.g. 0,30 → 0,30") {
+		t.Error("Expected the second minute example after a tick")
+	}
+
+	// A tick from a previous help session is ignored
+	_, cmd = m.Update(exampleTick{generation: m.exampleGeneration - 1})
+	if cmd != nil || m.exampleIndex != 1 {
+		t.Error("Expected a stale tick to be ignored")
+	}
+
+	// Closing help stops the animation
+	m.toggleHelp()
+
+	if cmd := m.handleExampleTick(exampleTick{generation: m.exampleGeneration}); cmd != nil {
+		t.Error("Expected no further ticks once help is closed")
+	}
+}
+
+// TestExamplesAreValid verifies that every shipped example expands without error.
+func TestExamplesAreValid(t *testing.T) {
+	t.Parallel()
+
+	for fieldIndex, examples := range fieldExamples {
+		for _, example := range examples {
+			if _, err := expandField(example, fieldIndex); err != nil {
+				t.Errorf("Example %q for %s is invalid: %v", example, fieldNames[fieldIndex], err)
+			}
+
+			if !isValidCronPart(example, fieldIndex) {
+				t.Errorf("Example %q for %s fails field validation", example, fieldNames[fieldIndex])
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// explanation is the human-readable reading of an expression in some dialect
+type explanation struct {
+	description string    // Natural-language description
+	next        time.Time // Next time the schedule fires after the reference time
+}
+
+// explainSpec describes a parsed expression and finds its next run after now.
+// Seconds are included when the dialect has them.
+func explainSpec(spec cronSpec, now time.Time) (explanation, error) {
+	expr := strings.Join(spec.fields, " ")
+	if spec.seconds != "" {
+		expr = spec.seconds + " " + expr
+	}
+
+	description, err := describeExpression(expr)
+	if err != nil {
+		return explanation{}, err
+	}
+
+	schedule, err := specSchedule(spec)
+	if err != nil {
+		return explanation{}, err
+	}
+
+	return explanation{description: description, next: schedule.Next(now)}, nil
+}
+
+// specSchedule parses an expression into a schedule, with seconds when the
+// dialect has them
+func specSchedule(spec cronSpec) (cronparser.Schedule, error) {
+	expr := strings.Join(spec.fields, " ")
+	parser := standardParser
+
+	if spec.seconds != "" {
+		expr = spec.seconds + " " + expr
+		parser = secondsParser
+	}
+
+	schedule, err := parser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	return schedule, nil
+}
+
+// runExplain prints the description and next run of an expression in any
+// dialect, or with --quiet only the description, for use in other scripts
+func runExplain(args []string, stdout, stderr io.Writer) error {
+	var (
+		from, seed string
+		quiet      bool
+	)
+
+	flags := flag.NewFlagSet("explain", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.BoolVar(&quiet, "quiet", false, "print only the description")
+	flags.BoolVar(&quiet, "q", false, "shorthand for --quiet")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru explain [--dialect DIALECT] [--quiet] EXPRESSION", ErrUsage)
+	}
+
+	fromDialect, err := parseDialect(from)
+	if err != nil {
+		return err
+	}
+
+	spec, notes, err := parseSpec(strings.Join(positional, " "), fromDialect, seed)
+	if err != nil {
+		return err
+	}
+
+	if spec.year != "" && spec.year != "*" {
+		notes = append(notes, fmt.Sprintf("year %q is not included in the description or next run", spec.year))
+	}
+
+	result, err := explainSpec(spec, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, result.description)
+
+	if quiet {
+		return nil
+	}
+
+	fmt.Fprintln(stdout, "next: "+result.next.Format("2006-01-02 15:04:05"))
+
+	for _, note := range notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+
+	return nil
+}
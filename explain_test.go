@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestExplainSpec verifies descriptions and next runs with and without seconds.
+func TestExplainSpec(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	spec, _, err := parseSpec("30 0 9 * * 1-5", dialectAzure, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, err := explainSpec(spec, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.description != "At 09:00:30 AM, Monday through Friday" {
+		t.Errorf("Unexpected description %q", result.description)
+	}
+
+	if !result.next.Equal(time.Date(2025, 1, 1, 9, 0, 30, 0, time.UTC)) {
+		t.Errorf("Unexpected next run %v", result.next)
+	}
+
+	result, err = explainSpec(cronSpec{fields: strings.Fields("0 12 * * *")}, now)
+	if err != nil || result.next.Hour() != 12 || result.next.Second() != 0 {
+		t.Errorf("Expected the next noon without seconds, got %v, %v", result.next, err)
+	}
+}
+
+// TestRunExplainCommand verifies the explain output, the quiet output,
+// dialect hints, and usage errors.
+func TestRunExplainCommand(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	if err := runCommand([]string{"explain", "--dialect", "azure", "0 */5 * * * *"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(stdout.String(), "Every 5 minutes\nnext: ") {
+		t.Errorf("Unexpected output %q", stdout.String())
+	}
+
+	err := runCommand([]string{"explain", "--dialect", "azure", "*/5 * * * *"}, &stdout, &stderr)
+	if !errors.Is(err, ErrFieldCount) || !strings.Contains(err.Error(), `did you mean "0 */5 * * * *"`) {
+		t.Errorf("Expected a hint to add seconds, got %v", err)
+	}
+
+	stderr.Reset()
+
+	if err := runCommand([]string{"explain", "--dialect", "quartz", "0 0 12 ? * MON 2030"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stderr.String(), `note: year "2030"`) {
+		t.Errorf("Expected a note about the year, got %q", stderr.String())
+	}
+
+	for _, flag := range []string{"--quiet", "-q"} {
+		stdout.Reset()
+		stderr.Reset()
+
+		if err := runCommand([]string{"explain", flag, "--dialect", "quartz", "0 0 12 ? * MON 2030"}, &stdout, &stderr); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if stdout.String() != "At 12:00 PM, only on Monday\n" || stderr.Len() != 0 {
+			t.Errorf("%s: expected only the description, got %q and %q", flag, stdout.String(), stderr.String())
+		}
+	}
+
+	if err := runCommand([]string{"explain"}, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultExportName    = "crontab-guru-job" // Job name used when --name is not given
+	defaultExportCommand = "/path/to/command" // Command used when --command is not given
+	defaultExportZone    = "UTC"              // Time zone used when --timezone is not given
+)
+
+// ErrUnknownFormat is returned for an export format that is not supported
+var ErrUnknownFormat = errors.New("unknown export format") //nolint:gochecknoglobals
+
+// exportJob describes the job being exported: its schedule and what it runs
+type exportJob struct {
+	fields   []string // Minute, hour, day, month, and weekday fields
+	name     string   // Job name or label, e.g. "backup"
+	command  string   // Shell command the job runs
+	timezone string   // IANA time zone the schedule is read in
+}
+
+// exporter renders a job in a format another scheduler understands
+type exporter struct {
+	name    string                          // Format name passed to --format
+	summary string                          // One-line description shown in help
+	render  func(exportJob) (string, error) // Renders the job
+}
+
+// exporters returns the export formats in the order help lists them
+func exporters() []exporter {
+	return []exporter{
+		{name: "launchd", summary: "macOS LaunchAgent plist with StartCalendarInterval", render: renderLaunchd},
+		{name: "terraform-eventbridge", summary: "Terraform aws_cloudwatch_event_rule", render: renderTerraformEventBridge},
+		{name: "terraform-scheduler", summary: "Terraform aws_scheduler_schedule", render: renderTerraformScheduler},
+		{name: "terraform-gcp", summary: "Terraform google_cloud_scheduler_job", render: renderTerraformGCP},
+		{name: "terraform-azure", summary: "Terraform app setting for an Azure Functions timer", render: renderTerraformAzure},
+		{name: "gitlab", summary: "GitLab pipeline schedule with a pipeline_schedules API call", render: renderGitLab},
+		{name: "spring", summary: "Spring @Scheduled method with a seconds field", render: renderSpring},
+		{name: "node-cron", summary: "node-cron schedule call", render: renderNodeCron},
+		{name: "apscheduler", summary: "APScheduler CronTrigger.from_crontab with named weekdays", render: renderAPScheduler},
+		{name: "robfig-cron", summary: "Go robfig/cron AddFunc call with CRON_TZ", render: renderRobfig},
+		{name: "healthchecks", summary: "Healthchecks.io check with a grace period for the run frequency", render: renderHealthchecks},
+		{name: "sentry", summary: "Sentry Crons monitor check-in with a suggested margin", render: renderSentry},
+		{name: "prometheus", summary: "Prometheus alert rule for missed runs after the longest gap", render: renderPrometheus},
+		{name: "markdown", summary: "Markdown snippet with the description and a table of the next runs", render: renderMarkdownExport},
+		{name: "summary", summary: "Markdown summary of the next 10 runs with UTC beside them, for PRs and incident docs", render: renderSummaryExport},
+		{name: "ics", summary: "iCalendar event with an RRULE, or the next runs as events", render: renderICSExport},
+	}
+}
+
+// findExporter looks up an export format by name
+func findExporter(name string) (exporter, error) {
+	names := make([]string, 0, len(exporters()))
+
+	for _, candidate := range exporters() {
+		if strings.EqualFold(candidate.name, name) {
+			return candidate, nil
+		}
+
+		names = append(names, candidate.name)
+	}
+
+	return exporter{}, fmt.Errorf("%w: %q (expected one of %s)", ErrUnknownFormat, name, strings.Join(names, ", "))
+}
+
+// cycleCopyFormat switches what y copies: the bare expression, then each export format in turn
+func (m *model) cycleCopyFormat() {
+	m.copyFormat = (m.copyFormat + 1) % (len(exporters()) + 1)
+}
+
+// copyFormatName returns the export format y copies, "" for the bare expression
+func (m *model) copyFormatName() string {
+	if m.copyFormat == 0 {
+		return ""
+	}
+
+	return exporters()[m.copyFormat-1].name
+}
+
+// copyHint describes the copy shortcut, naming the export format when one is selected
+func (m *model) copyHint() string {
+	if !m.keys.Copy.Enabled() {
+		return "copying is unbound"
+	}
+
+	copyKey := m.keys.Copy.Help().Key
+	if name := m.copyFormatName(); name != "" {
+		return copyKey + " to copy as " + name
+	}
+
+	return copyKey + " to copy"
+}
+
+// exportText renders the current expression in the export format selected for copying
+func (m *model) exportText() (string, error) {
+	job, err := newExportJob(m.buildCronExpression(), defaultExportName, defaultExportCommand, defaultExportZone)
+	if err != nil {
+		return "", err
+	}
+
+	return exporters()[m.copyFormat-1].render(job)
+}
+
+// newExportJob builds a job from a standard expression, expanding macros and
+// validating every field and the time zone
+func newExportJob(expr string, name string, command string, timezone string) (exportJob, error) {
+	fields, err := splitRawExpression(expr)
+	if err != nil {
+		return exportJob{}, err
+	}
+
+	if err := validateStandardFields(fields); err != nil {
+		return exportJob{}, err
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return exportJob{}, fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	return exportJob{fields: fields, name: name, command: command, timezone: timezone}, nil
+}
+
+// runExport renders an expression in another scheduler's format
+func runExport(args []string, stdout, stderr io.Writer) error {
+	var format, name, command, timezone string
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&format, "format", "", "export format")
+	flags.StringVar(&name, "name", defaultExportName, "job name or label")
+	flags.StringVar(&command, "command", defaultExportCommand, "command the job runs")
+	flags.StringVar(&timezone, "timezone", defaultExportZone, "IANA time zone the schedule is read in")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 || format == "" {
+		return fmt.Errorf("%w: crontab-guru export --format FORMAT EXPRESSION", ErrUsage)
+	}
+
+	target, err := findExporter(format)
+	if err != nil {
+		return err
+	}
+
+	job, err := newExportJob(strings.Join(positional, " "), name, command, timezone)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := target.render(job)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, rendered)
+
+	return nil
+}
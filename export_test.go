@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRunExportCommand verifies that export renders the expression in the
+// requested format with the job name and command.
+func TestRunExportCommand(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	args := []string{"export", "30 2 * * *", "--format", "launchd", "--name", "com.example.job", "--command", "run.sh"}
+	if err := runCommand(args, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"com.example.job", "<string>run.sh</string>", "<integer>30</integer>"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Expected output to contain %q:\n%s", want, stdout.String())
+		}
+	}
+}
+
+// TestRunExportErrors verifies errors for missing arguments, unknown formats,
+// and invalid expressions.
+func TestRunExportErrors(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{[]string{"export", "* * * * *"}, ErrUsage},
+		{[]string{"export", "--format", "launchd"}, ErrUsage},
+		{[]string{"export", "--format", "cronjob", "* * * * *"}, ErrUnknownFormat},
+		{[]string{"export", "--format", "launchd", "* * *"}, ErrFieldCount},
+		{[]string{"export", "--format", "launchd", "61 * * * *"}, ErrInvalidValue},
+		{[]string{"export", "--format", "gitlab", "--timezone", "Mars/Olympus", "* * * * *"}, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		if err := runCommand(tt.args, &stdout, &stderr); !errors.Is(err, tt.expected) {
+			t.Errorf("runCommand(%q) expected %v, got %v", tt.args, tt.expected, err)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// fieldSet is a bitset of the values selected by a cron field. Every field
+// range fits in 64 bits, with bit n set when value n is selected.
+type fieldSet uint64
+
+// fieldBounds is the inclusive range of values a cron field accepts
+type fieldBounds struct {
+	min int
+	max int
+}
+
+//nolint:gochecknoglobals
+var (
+	// Accepted value ranges per field; the weekday field also accepts 7 for Sunday
+	fieldRanges = []fieldBounds{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+	// Canonical month and weekday abbreviations, indexed from their first value
+	monthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+	// Month and weekday names written out, in the order of the abbreviations
+	monthFullNames = []string{
+		"JANUARY", "FEBRUARY", "MARCH", "APRIL", "MAY", "JUNE",
+		"JULY", "AUGUST", "SEPTEMBER", "OCTOBER", "NOVEMBER", "DECEMBER",
+	}
+	weekdayFullNames = []string{"SUNDAY", "MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY"}
+)
+
+// Has reports whether value is selected
+func (s fieldSet) Has(value int) bool {
+	return value >= 0 && value < 64 && s&(1<<uint(value)) != 0
+}
+
+// Len returns the number of selected values
+func (s fieldSet) Len() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Values returns the selected values in ascending order
+func (s fieldSet) Values() []int {
+	values := make([]int, 0, s.Len())
+
+	for remaining := uint64(s); remaining != 0; remaining &= remaining - 1 {
+		values = append(values, bits.TrailingZeros64(remaining))
+	}
+
+	return values
+}
+
+// String formats the selected values as a comma-separated list
+func (s fieldSet) String() string {
+	values := s.Values()
+	parts := make([]string, 0, len(values))
+
+	for _, value := range values {
+		parts = append(parts, strconv.Itoa(value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// compactString formats the selected values with runs of three or more
+// consecutive values collapsed into ranges, e.g. "1-5,7,9"
+func (s fieldSet) compactString() string {
+	values := s.Values()
+	parts := make([]string, 0, len(values))
+
+	for start := 0; start < len(values); {
+		end := start
+		for end+1 < len(values) && values[end+1] == values[end]+1 {
+			end++
+		}
+
+		switch {
+		case end-start >= 2:
+			parts = append(parts, strconv.Itoa(values[start])+"-"+strconv.Itoa(values[end]))
+		case end > start:
+			parts = append(parts, strconv.Itoa(values[start]), strconv.Itoa(values[end]))
+		default:
+			parts = append(parts, strconv.Itoa(values[start]))
+		}
+
+		start = end + 1
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// shift returns the set with every value moved by offset
+func (s fieldSet) shift(offset int) fieldSet {
+	var shifted fieldSet
+
+	for _, value := range s.Values() {
+		if moved := value + offset; moved >= 0 && moved < 64 {
+			shifted |= 1 << uint(moved)
+		}
+	}
+
+	return shifted
+}
+
+// fullFieldSet returns the set of every value a field accepts, with
+// Sunday only represented as 0 in the weekday field
+func fullFieldSet(fieldIndex int) fieldSet {
+	bounds := fieldRanges[fieldIndex]
+	if fieldIndex == fieldIndexWeekday {
+		bounds.max = 6
+	}
+
+	var set fieldSet
+	for value := bounds.min; value <= bounds.max; value++ {
+		set |= 1 << uint(value)
+	}
+
+	return set
+}
+
+// expandField expands a cron field value such as "1-10/2,MON" into the set of
+// values it selects. Empty values and "*" select the whole field. Weekday 7
+// is folded into 0 since both mean Sunday.
+func expandField(value string, fieldIndex int) (fieldSet, error) {
+	if fieldIndex < 0 || fieldIndex >= len(fieldRanges) {
+		return 0, fmt.Errorf("%w: field %d", ErrInvalidValue, fieldIndex)
+	}
+
+	if value == "" {
+		value = "*"
+	}
+
+	var set fieldSet
+
+	for item := range strings.SplitSeq(value, ",") {
+		itemSet, err := expandFieldItem(item, fieldIndex)
+		if err != nil {
+			return 0, err
+		}
+
+		set |= itemSet
+	}
+
+	if fieldIndex == fieldIndexWeekday && set.Has(7) {
+		set = set&^(1<<7) | 1
+	}
+
+	return set, nil
+}
+
+// expandFieldItem expands a single list item: "*", "n", "a-b", with an optional "/step"
+func expandFieldItem(item string, fieldIndex int) (fieldSet, error) {
+	bounds := fieldRanges[fieldIndex]
+	invalid := fmt.Errorf("%w: %s %q", ErrInvalidValue, fieldNames[fieldIndex], item)
+
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		parsed, err := strconv.Atoi(stepPart)
+		if err != nil || parsed < 1 {
+			return 0, invalid
+		}
+
+		step = parsed
+	}
+
+	start, end := bounds.min, bounds.max
+	if fieldIndex == fieldIndexWeekday && rangePart == "*" {
+		end = 6 // "*" in the weekday field covers Sunday once
+	}
+
+	if rangePart != "*" {
+		low, high, isRange := strings.Cut(rangePart, "-")
+
+		var err error
+		if start, err = parseFieldValue(low, fieldIndex); err != nil {
+			return 0, invalid
+		}
+
+		switch {
+		case isRange:
+			if end, err = parseFieldValue(high, fieldIndex); err != nil {
+				return 0, invalid
+			}
+		case !hasStep:
+			end = start // A bare value; "n/step" runs to the end of the field
+		}
+	}
+
+	if start < bounds.min || end > bounds.max || start > end {
+		return 0, invalid
+	}
+
+	var set fieldSet
+	for current := start; current <= end; current += step {
+		set |= 1 << uint(current)
+	}
+
+	return set, nil
+}
+
+// parseFieldValue parses a single number or, for the month and weekday
+// fields, a three-letter name
+func parseFieldValue(token string, fieldIndex int) (int, error) {
+	if number, err := strconv.Atoi(token); err == nil {
+		return number, nil
+	}
+
+	upper := strings.ToUpper(token)
+
+	switch fieldIndex {
+	case fieldIndexMonth:
+		for index, name := range monthNames {
+			if upper == name {
+				return index + 1, nil
+			}
+		}
+	case fieldIndexWeekday:
+		for index, name := range weekdayNames {
+			if upper == name {
+				return index, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrInvalidValue, token)
+}
+
+// nameAbbreviation returns the three-letter name a month or weekday word
+// stands for, in either case. Strict matching takes only the abbreviation or
+// the full name, as systemd does; otherwise any word starting with an
+// abbreviation does, so "Tues" and "JANUX" match too.
+func nameAbbreviation(word string, fieldIndex int, strict bool) (string, bool) {
+	names := fieldNameList(fieldIndex)
+	upper := strings.ToUpper(word)
+
+	if names == nil || len(upper) < minAbbrevLength {
+		return "", false
+	}
+
+	index := slices.Index(names, upper[:minAbbrevLength])
+	if index < 0 {
+		return "", false
+	}
+
+	fullNames := monthFullNames
+	if fieldIndex == fieldIndexWeekday {
+		fullNames = weekdayFullNames
+	}
+
+	if strict && upper != names[index] && upper != fullNames[index] {
+		return "", false
+	}
+
+	return names[index], true
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestExpandField verifies expansion of wildcards, lists, ranges, steps,
+// and month/weekday names into the set of selected values.
+func TestExpandField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value      string
+		fieldIndex int
+		expected   string
+	}{
+		{"*/15", 0, "0,15,30,45"},
+		{"0-30/10", 0, "0,10,20,30"},
+		{"5", 0, "5"},
+		{"5/20", 0, "5,25,45"},
+		{"1-3,2-4", 1, "1,2,3,4"},
+		{"", 1, "0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23"},
+		{"*/10", 2, "1,11,21,31"},
+		{"JAN,jul", 3, "1,7"},
+		{"*/3", 3, "1,4,7,10"},
+		{"MON-FRI", 4, "1,2,3,4,5"},
+		{"7", 4, "0"},
+		{"5-7", 4, "0,5,6"},
+		{"*", 4, "0,1,2,3,4,5,6"},
+	}
+
+	for _, tt := range tests {
+		set, err := expandField(tt.value, tt.fieldIndex)
+		if err != nil {
+			t.Errorf("expandField(%q, %d) returned error: %v", tt.value, tt.fieldIndex, err)
+
+			continue
+		}
+
+		if set.String() != tt.expected {
+			t.Errorf("expandField(%q, %d) = %s, expected %s", tt.value, tt.fieldIndex, set, tt.expected)
+		}
+	}
+}
+
+// TestExpandFieldErrors verifies that out-of-range values, reversed ranges,
+// bad steps, and names in the wrong field are rejected.
+func TestExpandFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value      string
+		fieldIndex int
+	}{
+		{"60", 0},
+		{"30-5", 0},
+		{"*/0", 0},
+		{"*/", 0},
+		{"24", 1},
+		{"0", 2},
+		{"13", 3},
+		{"MON", 3},
+		{"JAN", 4},
+		{"8", 4},
+		{"1,,2", 0},
+		{"x", 0},
+	}
+
+	for _, tt := range tests {
+		if _, err := expandField(tt.value, tt.fieldIndex); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("expandField(%q, %d) expected ErrInvalidValue, got %v", tt.value, tt.fieldIndex, err)
+		}
+	}
+
+	if _, err := expandField("*", 9); err == nil {
+		t.Error("Expected an error for an unknown field index")
+	}
+}
+
+// TestFieldSetOperations verifies the bitset helpers.
+func TestFieldSetOperations(t *testing.T) {
+	t.Parallel()
+
+	set, err := expandField("1,5,9", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !set.Has(5) || set.Has(4) || set.Has(-1) || set.Has(64) {
+		t.Error("Unexpected membership results")
+	}
+
+	if set.Len() != 3 {
+		t.Errorf("Expected 3 values, got %d", set.Len())
+	}
+
+	if fullFieldSet(fieldIndexWeekday).Len() != 7 {
+		t.Errorf("Expected 7 weekdays, got %d", fullFieldSet(fieldIndexWeekday).Len())
+	}
+
+	if fullFieldSet(0).Len() != 60 {
+		t.Errorf("Expected 60 minutes, got %d", fullFieldSet(0).Len())
+	}
+
+	compact, err := expandField("1-5,7,9,10", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if compact.compactString() != "1-5,7,9,10" {
+		t.Errorf("Expected compact form 1-5,7,9,10, got %s", compact.compactString())
+	}
+
+	if shifted := set.shift(-1).compactString(); shifted != "0,4,8" {
+		t.Errorf("Expected shifted set 0,4,8, got %s", shifted)
+	}
+}
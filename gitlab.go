@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	gitlabURL = "https://gitlab.example.com" // Placeholder instance URL in the API call
+	gitlabRef = "main"                       // Branch the scheduled pipeline runs on
+)
+
+// shellQuote quotes a value for POSIX shells using single quotes
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// renderGitLab renders a GitLab pipeline schedule: the interval pattern and
+// cron timezone to enter in the UI, and a curl call creating the schedule
+// through the pipeline_schedules API
+func renderGitLab(job exportJob) (string, error) {
+	pattern := strings.Join(job.fields, " ")
+
+	var builder strings.Builder
+
+	builder.WriteString("# GitLab pipeline schedule (Build > Pipeline schedules > New schedule)\n")
+	fmt.Fprintf(&builder, "#   Interval pattern: %s\n", pattern)
+	fmt.Fprintf(&builder, "#   Cron timezone:    %s\n", job.timezone)
+	builder.WriteString("#\n")
+	builder.WriteString("# The pattern is read in the cron timezone, not the runner's or the server's,\n")
+	builder.WriteString("# and follows its daylight saving changes. Self-managed instances start\n")
+	builder.WriteString("# scheduled pipelines when the pipeline schedule worker runs (every 10 minutes\n")
+	builder.WriteString("# by default), so a pipeline can start a few minutes after the pattern fires.\n")
+	builder.WriteString("\n")
+	builder.WriteString("curl --request POST \\\n")
+	builder.WriteString("  --header \"PRIVATE-TOKEN: $GITLAB_TOKEN\" \\\n")
+	fmt.Fprintf(&builder, "  --form description=%s \\\n", shellQuote(job.name))
+	fmt.Fprintf(&builder, "  --form ref=%s \\\n", shellQuote(gitlabRef))
+	fmt.Fprintf(&builder, "  --form cron=%s \\\n", shellQuote(pattern))
+	fmt.Fprintf(&builder, "  --form cron_timezone=%s \\\n", shellQuote(job.timezone))
+	builder.WriteString("  --form active=true \\\n")
+	fmt.Fprintf(&builder, "  \"%s/api/v4/projects/$PROJECT_ID/pipeline_schedules\"\n", gitlabURL)
+
+	return builder.String(), nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRenderGitLab verifies that the GitLab export carries the pattern and
+// timezone into both the UI settings and the API call, quoting values for
+// the shell.
+func TestRenderGitLab(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("*/5 9-17 * * MON-FRI", "Ops' sync", "sync.sh", "America/New_York")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rendered, err := renderGitLab(job)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"#   Interval pattern: */5 9-17 * * MON-FRI",
+		"#   Cron timezone:    America/New_York",
+		"every 10 minutes",
+		`--form description='Ops'\'' sync'`,
+		"--form cron='*/5 9-17 * * MON-FRI'",
+		"--form cron_timezone='America/New_York'",
+		"/api/v4/projects/$PROJECT_ID/pipeline_schedules",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected %q in:\n%s", want, rendered)
+		}
+	}
+}
+
+// TestShellQuote verifies single-quoting, including embedded quotes.
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"", "''"},
+		{"* * * * *", "'* * * * *'"},
+		{"it's", `'it'\''s'`},
+		{"$HOME", "'$HOME'"},
+	}
+
+	for _, tt := range tests {
+		if got := shellQuote(tt.value); got != tt.expected {
+			t.Errorf("shellQuote(%q) = %q, expected %q", tt.value, got, tt.expected)
+		}
+	}
+}
@@ -14,6 +14,7 @@ require (
 	github.com/lnquy/cron v1.1.1
 	github.com/mattn/go-isatty v0.0.22
 	github.com/robfig/cron/v3 v3.0.1
+	golang.org/x/image v0.39.0
 )
 
 require (
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// guruLinkShown is how long a link no browser could open stays shown, long
+// enough to select and copy it
+const guruLinkShown = 10 * time.Second
+
+// guruLink returns the crontab.guru page of an expression. The site reads
+// the expression from the fragment with its fields joined by underscores,
+// and any character a fragment cannot hold is percent-encoded.
+func guruLink(expr string) string {
+	link := url.URL{Scheme: "https", Host: "crontab.guru", Path: "/", Fragment: strings.Join(strings.Fields(expr), "_")}
+
+	return link.String()
+}
+
+// urlOpener opens a link, returning once it is on its way
+type urlOpener func(link string) error
+
+// browserCommand returns the command line opening a link in the default
+// browser of an operating system. On Windows, rundll32 opens it rather than
+// start, which is a cmd builtin that would read & and ^ in the link itself.
+func browserCommand(goos, link string) []string {
+	switch goos {
+	case "darwin":
+		return []string{"open", link}
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler", link}
+	default:
+		return []string{"xdg-open", link}
+	}
+}
+
+// openInBrowser opens a link in the default browser without waiting for it
+func openInBrowser(link string) error {
+	argv := browserCommand(runtime.GOOS, link)
+
+	cmd := exec.Command(argv[0], argv[1:]...) //nolint:gosec // A fixed opener given a link built by guruLink
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run %s: %w", argv[0], err)
+	}
+
+	go cmd.Wait() //nolint:errcheck // The opener's exit status says nothing about the browser
+
+	return nil
+}
+
+// openGuru opens the expression on crontab.guru, or shows the link to copy
+// when no browser can be started, as over SSH
+func (m *model) openGuru() tea.Cmd {
+	link := guruLink(m.buildCronExpression())
+	shown := time.Second
+
+	if err := m.openURL(link); err != nil {
+		m.copyMessage = "No browser to open " + link
+		shown = guruLinkShown
+	} else {
+		m.copyMessage = "Opened " + link
+	}
+
+	return tea.Tick(shown, func(time.Time) tea.Msg {
+		return clearCopyMessage{}
+	})
+}
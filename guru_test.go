@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestGuruLink verifies that fields are joined by underscores and that
+// characters a fragment cannot hold are percent-encoded.
+func TestGuruLink(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"*/5 * * * *":      "https://crontab.guru/#*/5_*_*_*_*",
+		"0 9-17 * * 1-5":   "https://crontab.guru/#0_9-17_*_*_1-5",
+		"0 0 1,15 JAN MON": "https://crontab.guru/#0_0_1,15_JAN_MON",
+		"0 0 * * 5#3":      "https://crontab.guru/#0_0_*_*_5%233",
+		"@daily":           "https://crontab.guru/#@daily",
+	}
+
+	for expr, expected := range tests {
+		if link := guruLink(expr); link != expected {
+			t.Errorf("guruLink(%q) = %q, expected %q", expr, link, expected)
+		}
+	}
+}
+
+// TestBrowserCommand verifies the opener used on each operating system.
+func TestBrowserCommand(t *testing.T) {
+	t.Parallel()
+
+	link := "https://crontab.guru/#*_*_*_*_*"
+
+	tests := map[string][]string{
+		"linux":   {"xdg-open", link},
+		"freebsd": {"xdg-open", link},
+		"darwin":  {"open", link},
+		"windows": {"rundll32", "url.dll,FileProtocolHandler", link},
+	}
+
+	for goos, expected := range tests {
+		if argv := browserCommand(goos, link); !slices.Equal(argv, expected) {
+			t.Errorf("browserCommand(%q) = %q, expected %q", goos, argv, expected)
+		}
+	}
+}
+
+// TestOpenGuru verifies that alt+o opens the link of the fields, and shows
+// the link when no browser can be started.
+func TestOpenGuru(t *testing.T) {
+	t.Parallel()
+
+	var opened []string
+
+	m := initialModel()
+	m.setExpression("30 2 * * 1-5")
+	m.openURL = func(link string) error {
+		opened = append(opened, link)
+
+		return nil
+	}
+
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o"), Alt: true}); cmd == nil {
+		t.Error("Expected a command clearing the message")
+	}
+
+	expected := "https://crontab.guru/#30_2_*_*_1-5"
+	if !slices.Equal(opened, []string{expected}) || m.copyMessage != "Opened "+expected {
+		t.Errorf("Expected %q opened, got %q with %q", expected, opened, m.copyMessage)
+	}
+
+	m.openURL = func(string) error { return errors.New("exec: \"xdg-open\": executable file not found in $PATH") }
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o"), Alt: true})
+
+	if !strings.HasPrefix(m.copyMessage, "No browser") || !strings.HasSuffix(m.copyMessage, expected) {
+		t.Errorf("Expected the link shown, got %q", m.copyMessage)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	hoursPerDay       = 24 // Hour buckets in a day
+	histogramBarWidth = 40 // Width of the longest bar in the day view
+)
+
+// Shades of the week view cells from no jobs to the busiest hour
+var histogramShades = []string{"··", "░░", "▒▒", "▓▓", "██"} //nolint:gochecknoglobals
+
+// loadCounts is how many jobs run in each hour over a week
+type loadCounts struct {
+	day  [hoursPerDay]int              // Jobs running in each hour on any day
+	week [daysPerWeek][hoursPerDay]int // Jobs running in each hour of each weekday, Sunday first
+}
+
+// countLoad counts the jobs that run in each hour of the week starting at
+// now. A job running many times within an hour counts once there.
+func countLoad(jobs []clashJob, now time.Time) (loadCounts, error) {
+	var counts loadCounts
+
+	until := now.AddDate(0, 0, daysPerWeek)
+
+	for _, job := range jobs {
+		schedule, err := standardParser.Parse(job.expr)
+		if err != nil {
+			return counts, fmt.Errorf("%w: %s: %w", ErrCronParse, job.name, err)
+		}
+
+		var (
+			day  [hoursPerDay]bool
+			week [daysPerWeek][hoursPerDay]bool
+		)
+
+		sampled := 0
+
+		for next := range occurrences(context.Background(), schedule, now) {
+			if !next.Before(until) || sampled == maxClashRuns {
+				break
+			}
+
+			day[next.Hour()] = true
+			week[next.Weekday()][next.Hour()] = true
+			sampled++
+		}
+
+		for hour := range hoursPerDay {
+			if day[hour] {
+				counts.day[hour]++
+			}
+
+			for weekday := range daysPerWeek {
+				if week[weekday][hour] {
+					counts.week[weekday][hour]++
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// renderDayHistogram draws a bar per hour of the day, scaled to the busiest hour
+func renderDayHistogram(counts loadCounts) string {
+	busiest := 0
+	for _, count := range counts.day {
+		busiest = max(busiest, count)
+	}
+
+	var builder strings.Builder
+
+	for hour, count := range counts.day {
+		bar := ""
+		if count > 0 {
+			bar = strings.Repeat("█", max(1, count*histogramBarWidth/busiest)) + " "
+		}
+
+		fmt.Fprintf(&builder, "%02d  %s%d\n", hour, bar, count)
+	}
+
+	return builder.String()
+}
+
+// renderWeekHistogram draws a weekday by hour grid, shading each cell by how
+// close it is to the busiest hour of the week
+func renderWeekHistogram(counts loadCounts) string {
+	busiest := 0
+
+	for _, hours := range counts.week {
+		for _, count := range hours {
+			busiest = max(busiest, count)
+		}
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("   ")
+
+	for hour := range hoursPerDay {
+		fmt.Fprintf(&builder, " %02d", hour)
+	}
+
+	builder.WriteString("\n")
+
+	for weekday, hours := range counts.week {
+		builder.WriteString(weekdayNames[weekday][:1] + strings.ToLower(weekdayNames[weekday][1:]))
+
+		for _, count := range hours {
+			shade := 0
+			if count > 0 {
+				shade = (count*(len(histogramShades)-1) + busiest - 1) / busiest
+			}
+
+			builder.WriteString(" " + histogramShades[shade])
+		}
+
+		builder.WriteString("\n")
+	}
+
+	fmt.Fprintf(&builder, "%s busiest hour (%d)\n", histogramShades[len(histogramShades)-1], busiest)
+
+	return builder.String()
+}
+
+// runHistogram prints how many crontab jobs run in each hour of the day, or
+// of each weekday with --week
+func runHistogram(args []string, stdout, stderr io.Writer) error {
+	var (
+		week     bool
+		timezone string
+	)
+
+	flags := flag.NewFlagSet("histogram", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&week, "week", false, "show a weekday by hour grid")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the crontab is read in")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) != 1 {
+		return fmt.Errorf("%w: crontab-guru histogram [--week] [--timezone ZONE] CRONTAB", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	text, err := readCrontab(paths[0])
+	if err != nil {
+		return err
+	}
+
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", paths[0], err)
+	}
+
+	counts, err := countLoad(jobs, time.Now().In(location))
+	if err != nil {
+		return err
+	}
+
+	if week {
+		fmt.Fprint(stdout, renderWeekHistogram(counts))
+	} else {
+		fmt.Fprint(stdout, renderDayHistogram(counts))
+	}
+
+	return nil
+}
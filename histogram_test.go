@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestCountLoad verifies that each job counts once per hour it runs in, by
+// hour of the day and by hour of each weekday.
+func TestCountLoad(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+	jobs := []clashJob{
+		{name: "backup", expr: "0 2 * * *"},
+		{name: "poll", expr: "*/5 2 * * *"},
+		{name: "report", expr: "30 2 * * 1-5"},
+		{name: "weekly", expr: "0 9 * * 0"},
+	}
+
+	counts, err := countLoad(jobs, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if counts.day[2] != 3 || counts.day[9] != 1 || counts.day[3] != 0 {
+		t.Errorf("Unexpected day counts %v", counts.day)
+	}
+
+	if counts.week[time.Monday][2] != 3 || counts.week[time.Saturday][2] != 2 || counts.week[time.Sunday][9] != 1 ||
+		counts.week[time.Monday][9] != 0 {
+		t.Errorf("Unexpected week counts %v", counts.week)
+	}
+
+	if _, err := countLoad([]clashJob{{name: "bad", expr: "61 * * * *"}}, now); !errors.Is(err, ErrCronParse) {
+		t.Errorf("Expected ErrCronParse, got %v", err)
+	}
+}
+
+// TestRenderHistograms verifies that day bars scale to the busiest hour and
+// that week cells are shaded by load.
+func TestRenderHistograms(t *testing.T) {
+	t.Parallel()
+
+	var counts loadCounts
+
+	counts.day[2] = 4
+	counts.day[3] = 1
+	counts.week[time.Monday][2] = 4
+	counts.week[time.Monday][3] = 1
+
+	day := strings.Split(renderDayHistogram(counts), "\n")
+	if len(day) != hoursPerDay+1 || day[2] != "02  "+strings.Repeat("█", histogramBarWidth)+" 4" ||
+		day[3] != "03  "+strings.Repeat("█", histogramBarWidth/4)+" 1" || day[4] != "04  0" {
+		t.Errorf("Unexpected day histogram:\n%s", strings.Join(day, "\n"))
+	}
+
+	week := strings.Split(renderWeekHistogram(counts), "\n")
+	if !strings.HasPrefix(week[0], "    00 01 02") || !strings.HasPrefix(week[2], "Mon ·· ·· ██ ░░ ··") ||
+		week[8] != "██ busiest hour (4)" {
+		t.Errorf("Unexpected week histogram:\n%s", strings.Join(week, "\n"))
+	}
+}
+
+// TestRunHistogram verifies the day and week views of a crontab file.
+func TestRunHistogram(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	if err := os.WriteFile(path, []byte("MAILTO=ops\n0 2 * * * a.sh\n5 2 * * * b.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout bytes.Buffer
+
+	if err := runHistogram([]string{"--timezone", "UTC", path}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "02  "+strings.Repeat("█", histogramBarWidth)+" 2\n") {
+		t.Errorf("Expected two jobs at 02, got:\n%s", stdout.String())
+	}
+
+	stdout.Reset()
+
+	if err := runHistogram([]string{path, "--week"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "Sun ") || !strings.Contains(stdout.String(), "busiest hour (2)") {
+		t.Errorf("Expected the week grid, got:\n%s", stdout.String())
+	}
+
+	if err := runHistogram(nil, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage without a crontab, got %v", err)
+	}
+}
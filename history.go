@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	historyDirName   = "history"                       // Directory under the data directory holding the repository
+	historyAuthor    = "crontab-guru"                  // Committer name set in the repository
+	historyEmail     = "crontab-guru@localhost"        // Committer email set in the repository
+	historyLogFormat = "commit %h  %ad%n%n%w(0,4,4)%B" // Format of each commit in the history view
+	historyDate      = "format:%Y-%m-%d %H:%M"         // Format of commit dates in the history view
+)
+
+// historyRepo is the git repository written crontabs are committed to, one
+// file per crontab. The zero value keeps no history.
+type historyRepo struct {
+	dir string        // Repository directory, "" when history is off
+	run commandRunner // Runs git
+}
+
+// openHistory returns the history repository when the config turns history
+// on, and the zero repository otherwise
+func openHistory(cfg config) (historyRepo, error) {
+	if !cfg.History {
+		return historyRepo{}, nil
+	}
+
+	dir, err := historyDir()
+	if err != nil {
+		return historyRepo{}, err
+	}
+
+	return historyRepo{dir: dir, run: execRunner}, nil
+}
+
+// openCrontabKeeping returns where crontabs are backed up before they are
+// written, and the history they are committed to after
+func openCrontabKeeping() (string, historyRepo, error) {
+	root, err := backupRoot()
+	if err != nil {
+		return "", historyRepo{}, err
+	}
+
+	cfg, err := loadConfig(defaultConfigPath())
+	if err != nil {
+		return "", historyRepo{}, err
+	}
+
+	history, err := openHistory(cfg)
+
+	return root, history, err
+}
+
+// historyDir returns the directory of the history repository
+func historyDir() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, historyDirName), nil
+}
+
+// git runs a git command in the repository
+func (h historyRepo) git(args ...string) (string, error) {
+	return h.run(append([]string{"git", "-C", h.dir}, args...), "")
+}
+
+// record commits the text a crontab was written with, describing the jobs
+// that changed in the message. The repository is created on first use, and
+// text the crontab already had is not committed again.
+func (h historyRepo) record(key, text string) error {
+	if h.dir == "" {
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(h.dir, ".git")); errors.Is(err, fs.ErrNotExist) {
+		if err := h.init(); err != nil {
+			return err
+		}
+	}
+
+	name := url.PathEscape(key) + backupExtension
+	path := filepath.Join(h.dir, name)
+
+	previous, err := os.ReadFile(path) //nolint:gosec // The path is in the history repository
+	if err == nil && string(previous) == text {
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(text), 0o600); err != nil {
+		return fmt.Errorf("failed to write the history of %s: %w", key, err)
+	}
+
+	if _, err := h.git("add", "--", name); err != nil {
+		return err
+	}
+
+	_, err = h.git("commit", "--quiet", "--message", historyMessage(key, string(previous), text), "--", name)
+
+	return err
+}
+
+// init creates the repository with its own committer and without signing,
+// so commits do not depend on the user's git identity or keys
+func (h historyRepo) init() error {
+	if err := os.MkdirAll(h.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create the history repository: %w", err)
+	}
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"config", "user.name", historyAuthor},
+		{"config", "user.email", historyEmail},
+		{"config", "commit.gpgsign", "false"},
+	} {
+		if _, err := h.git(args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// historyMessage describes a save: the crontab in the subject, and each job
+// added or removed with its description in the body. A job whose schedule
+// changed is listed as removed and added again.
+func historyMessage(key, previous, text string) string {
+	var body []string
+
+	for _, line := range diffLines(previous, text) {
+		jobs, err := crontabJobs(line[2:])
+		if err != nil || len(jobs) != 1 {
+			continue
+		}
+
+		change := "added"
+		if strings.HasPrefix(line, "-") {
+			change = "removed"
+		}
+
+		body = append(body, fmt.Sprintf("%s %s %s: %s", change, jobs[0].expr, jobs[0].name, describeJob(jobs[0].expr)))
+	}
+
+	subject := "Save " + key
+	if len(body) == 0 {
+		return subject
+	}
+
+	return subject + "\n\n" + strings.Join(body, "\n")
+}
+
+// log returns the commits of a crontab with their diffs, newest first, ""
+// when it has none
+func (h historyRepo) log(key string) (string, error) {
+	name := url.PathEscape(key) + backupExtension
+	if _, err := os.Stat(filepath.Join(h.dir, name)); errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+
+	return h.git("log", "--patch", "--no-color", "--date="+historyDate, "--format="+historyLogFormat, "--", name)
+}
+
+// runHistory shows how a crontab, or a crontab file, changed over the saves
+// committed to the history repository
+func runHistory(args []string, stdout, stderr io.Writer) error {
+	var target crontabTarget
+
+	flags := flag.NewFlagSet("history", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&target.user, "user", "", "show the history of this user's crontab")
+	flags.StringVar(&target.host, "host", "", "show the history of the crontab on this host, e.g. deploy@server")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 1 || (len(positional) == 1 && target != (crontabTarget{})) {
+		return fmt.Errorf("%w: crontab-guru history [--user USER] [--host USER@HOST] [FILE]", ErrUsage)
+	}
+
+	if err := target.validate(); err != nil {
+		return err
+	}
+
+	key := target.String()
+	if len(positional) == 1 {
+		key = fileBackupKey(positional[0])
+	}
+
+	dir, err := historyDir()
+	if err != nil {
+		return err
+	}
+
+	log, err := historyRepo{dir: dir, run: execRunner}.log(key)
+	if err != nil {
+		return err
+	}
+
+	if log == "" {
+		fmt.Fprintf(stdout, "no history of %s; set \"history\": true in %s to keep it\n", key, defaultConfigPath())
+
+		return nil
+	}
+
+	fmt.Fprint(stdout, log)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestHistoryMessage verifies that a save names the crontab and describes
+// each job added or removed, skipping other lines.
+func TestHistoryMessage(t *testing.T) {
+	t.Parallel()
+
+	message := historyMessage("your crontab",
+		"MAILTO=ops\n0 2 * * * backup.sh\n*/5 * * * * poll.sh\n",
+		"MAILTO=dev\n30 3 * * * backup.sh\n*/5 * * * * poll.sh\n")
+
+	expected := "Save your crontab\n\n" +
+		"removed 0 2 * * * backup.sh: At 02:00 AM\n" +
+		"added 30 3 * * * backup.sh: At 03:30 AM"
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nexpected:\n%s", message, expected)
+	}
+
+	if message = historyMessage("your crontab", "# old\n", "# new\n"); message != "Save your crontab" {
+		t.Errorf("Expected only a subject, got %q", message)
+	}
+}
+
+// TestHistoryRecord verifies that saves are committed to a repository made on
+// first use, that unchanged text is not committed again, and that the log
+// shows each save with its diff.
+func TestHistoryRecord(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	history := historyRepo{dir: t.TempDir(), run: execRunner}
+
+	if log, err := history.log("your crontab"); err != nil || log != "" {
+		t.Fatalf("Expected no history, got %q, %v", log, err)
+	}
+
+	for _, text := range []string{"0 2 * * * backup.sh\n", "0 2 * * * backup.sh\n", "0 3 * * * backup.sh\n"} {
+		if err := history.record("your crontab", text); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	log, err := history.log("your crontab")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if count := strings.Count(log, "Save your crontab"); count != 2 {
+		t.Errorf("Expected two commits, got %d:\n%s", count, log)
+	}
+
+	if !strings.Contains(log, "added 0 3 * * * backup.sh: At 03:00 AM") || !strings.Contains(log, "+0 3 * * * backup.sh") {
+		t.Errorf("Expected the change described and diffed, got:\n%s", log)
+	}
+
+	if (historyRepo{}).record("your crontab", "") != nil {
+		t.Error("Expected no history kept when it is off")
+	}
+}
+
+// TestRunHistoryUsage verifies that a file cannot be combined with a user or
+// host, and that only one crontab is shown at a time.
+func TestRunHistoryUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{{"--user", "www-data", "crontab.txt"}, {"a.txt", "b.txt"}} {
+		if err := runHistory(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runHistory(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	defaultICSCount    = 10                // Occurrences listed when the schedule has no RRULE
+	defaultICSDuration = 15 * time.Minute  // Length of each calendar event
+	icsLineLimit       = 75                // Longest content line before folding, in octets
+	icsTimeLayout      = "20060102T150405" // Local date-time form of DTSTART and DTSTAMP
+	icsZoneYears       = 10                // Years of time zone changes the VTIMEZONE lists after the first run
+)
+
+//nolint:gochecknoglobals
+var (
+	// RRULE weekday codes indexed from Sunday as 0
+	icsWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+	// RRULE parts for each field, from the minute to the weekday
+	icsRuleParts = []string{"BYMINUTE", "BYHOUR", "BYMONTHDAY", "BYMONTH", "BYDAY"}
+)
+
+// icsText escapes a value for an iCalendar TEXT property
+func icsText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(value)
+}
+
+// icsLine folds a content line at 75 octets, as RFC 5545 requires, and ends
+// it with CRLF. Lines are only split between UTF-8 sequences.
+func icsLine(builder *strings.Builder, line string) {
+	limit := icsLineLimit
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		builder.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1 // Continuation lines start with a space
+	}
+
+	builder.WriteString(line + "\r\n")
+}
+
+// icsOffset formats a UTC offset in seconds as TZOFFSETFROM and TZOFFSETTO
+// take it, e.g. +0100 or -0330
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+
+	offset := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		offset += fmt.Sprintf("%02d", seconds%60)
+	}
+
+	return offset
+}
+
+// icsTimezone writes the VTIMEZONE a TZID refers to, as RFC 5545 requires:
+// an observance for the offset in effect at start and one for each change
+// over the next icsZoneYears years, taken from the time zone database.
+// Importers carry the last observance on past the end of the list.
+func icsTimezone(builder *strings.Builder, location *time.Location, start time.Time) {
+	icsLine(builder, "BEGIN:VTIMEZONE")
+	icsLine(builder, "TZID:"+location.String())
+
+	start = start.In(location)
+	limit := start.AddDate(icsZoneYears, 0, 0)
+
+	onset, _ := start.ZoneBounds()
+	_, offsetFrom := start.Zone()
+
+	if onset.IsZero() {
+		onset = time.Unix(0, 0).In(location) // A zone that never changes
+	} else {
+		_, offsetFrom = onset.Add(-time.Second).Zone()
+	}
+
+	for {
+		name, offset := onset.Zone()
+
+		kind := "STANDARD"
+		if onset.IsDST() {
+			kind = "DAYLIGHT"
+		}
+
+		icsLine(builder, "BEGIN:"+kind)
+		icsLine(builder, "DTSTART:"+onset.In(time.FixedZone(name, offsetFrom)).Format(icsTimeLayout))
+		icsLine(builder, "TZOFFSETFROM:"+icsOffset(offsetFrom))
+		icsLine(builder, "TZOFFSETTO:"+icsOffset(offset))
+		icsLine(builder, "TZNAME:"+icsText(name))
+		icsLine(builder, "END:"+kind)
+
+		_, end := onset.ZoneBounds()
+		if end.IsZero() || end.After(limit) {
+			break
+		}
+
+		onset, offsetFrom = end, offset
+	}
+
+	icsLine(builder, "END:VTIMEZONE")
+}
+
+// icsRule returns the RRULE for the job's schedule. It reports false when
+// both day fields are restricted, since cron fires when either matches and
+// RRULE parts only ever narrow the set.
+func icsRule(fields []string) (string, bool) {
+	if fields[2] != "*" && fields[fieldIndexWeekday] != "*" {
+		return "", false
+	}
+
+	parts := []string{"FREQ=DAILY"}
+
+	for index, field := range fields {
+		if field == "*" {
+			continue
+		}
+
+		set, err := expandField(field, index)
+		if err != nil {
+			return "", false
+		}
+
+		values := strings.Split(set.String(), ",")
+		if index == fieldIndexWeekday {
+			for position, value := range set.Values() {
+				values[position] = icsWeekdays[value]
+			}
+		}
+
+		parts = append(parts, icsRuleParts[index]+"="+strings.Join(values, ","))
+	}
+
+	return strings.Join(parts, ";"), true
+}
+
+// renderICS renders a calendar with the job's schedule after now: one
+// recurring event when the schedule maps onto an RRULE, otherwise the next
+// count occurrences as separate events
+func renderICS(job exportJob, now time.Time, count int, duration time.Duration) (string, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return "", fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(job.fields, " "))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	next := schedule.Next(now.In(location))
+	if next.IsZero() {
+		return "", fmt.Errorf("%w: %s never runs", ErrInvalidValue, strings.Join(job.fields, " "))
+	}
+
+	var builder strings.Builder
+
+	icsLine(&builder, "BEGIN:VCALENDAR")
+	icsLine(&builder, "VERSION:2.0")
+	icsLine(&builder, "PRODID:-//techquestsdev//crontab-guru//EN")
+	icsLine(&builder, "CALSCALE:GREGORIAN")
+
+	stamp := now.UTC().Format(icsTimeLayout) + "Z"
+	description := icsText(fmt.Sprintf("%s\nSchedule: %s (%s)", job.command, strings.Join(job.fields, " "), job.timezone))
+
+	writeEvent := func(start time.Time, uid string, rule string) {
+		icsLine(&builder, "BEGIN:VEVENT")
+		icsLine(&builder, "UID:"+uid)
+		icsLine(&builder, "DTSTAMP:"+stamp)
+
+		if rule != "" {
+			// Keep the local time zone so the rule follows daylight saving changes
+			icsLine(&builder, "DTSTART;TZID="+job.timezone+":"+start.Format(icsTimeLayout))
+			icsLine(&builder, "RRULE:"+rule)
+		} else {
+			icsLine(&builder, "DTSTART:"+start.UTC().Format(icsTimeLayout)+"Z")
+		}
+
+		icsLine(&builder, fmt.Sprintf("DURATION:PT%dM", int(duration.Minutes())))
+		icsLine(&builder, "SUMMARY:"+icsText(job.name))
+		icsLine(&builder, "DESCRIPTION:"+description)
+		icsLine(&builder, "END:VEVENT")
+	}
+
+	uid := terraformName(job.name)
+
+	if rule, ok := icsRule(job.fields); ok {
+		icsTimezone(&builder, location, next)
+		writeEvent(next, fmt.Sprintf("%s-%d@crontab-guru", uid, next.Unix()), rule)
+	} else {
+		for range count {
+			writeEvent(next, fmt.Sprintf("%s-%d@crontab-guru", uid, next.Unix()), "")
+
+			if next = schedule.Next(next); next.IsZero() {
+				break
+			}
+		}
+	}
+
+	icsLine(&builder, "END:VCALENDAR")
+
+	return builder.String(), nil
+}
+
+// renderICSExport renders a calendar starting now with the default count and duration
+func renderICSExport(job exportJob) (string, error) {
+	return renderICS(job, time.Now(), defaultICSCount, defaultICSDuration)
+}
+
+// runICS writes an iCalendar file of an expression's upcoming runs to stdout
+func runICS(args []string, stdout, stderr io.Writer) error {
+	var (
+		name, command, timezone string
+		count                   int
+		duration                time.Duration
+	)
+
+	flags := flag.NewFlagSet("ics", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&name, "name", defaultExportName, "event title")
+	flags.StringVar(&command, "command", defaultExportCommand, "command shown in the event description")
+	flags.StringVar(&timezone, "timezone", defaultExportZone, "IANA time zone the schedule is read in")
+	flags.IntVar(&count, "count", defaultICSCount, "occurrences to list when the schedule has no RRULE")
+	flags.DurationVar(&duration, "duration", defaultICSDuration, "length of each event")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru ics [--count N] EXPRESSION", ErrUsage)
+	}
+
+	if count < 1 || duration < time.Minute {
+		return fmt.Errorf("%w: --count must be at least 1 and --duration at least 1m", ErrUsage)
+	}
+
+	job, err := newExportJob(strings.Join(positional, " "), name, command, timezone)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderICS(job, time.Now(), count, duration)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, rendered)
+
+	return nil
+}
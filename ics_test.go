@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRenderICSRule verifies that a schedule without both day fields
+// restricted becomes one recurring event in its local time zone, which the
+// calendar defines with its daylight saving changes.
+func TestRenderICSRule(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "Nightly backup", "backup.sh; notify", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC) // A Friday
+
+	rendered, err := renderICS(job, now, 3, 30*time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/Lisbon\r\nBEGIN:STANDARD\r\nDTSTART:20241027T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0000\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20250330T010000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\nTZNAME:WEST\r\n",
+		"DTSTART;TZID=Europe/Lisbon:20250310T023000\r\n",
+		"RRULE:FREQ=DAILY;BYMINUTE=30;BYHOUR=2;BYDAY=MO,TU,WE,TH,FR\r\n",
+		"DURATION:PT30M\r\n",
+		"SUMMARY:Nightly backup\r\n",
+		`DESCRIPTION:backup.sh\; notify\nSchedule: 30 2 * * 1-5 (Europe/Lisbon)`,
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected %q in:\n%s", want, rendered)
+		}
+	}
+
+	if got := strings.Count(rendered, "BEGIN:VEVENT"); got != 1 {
+		t.Errorf("Expected one recurring event, got %d", got)
+	}
+
+	job, err = newExportJob("0 0 30 2 *", "never", "true", "UTC")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := renderICS(job, now, 3, 30*time.Minute); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Expected ErrInvalidValue for a schedule that never runs, got %v", err)
+	}
+}
+
+// TestRenderICSOccurrences verifies that a schedule matching either day field
+// falls back to listing the next runs in UTC.
+func TestRenderICSOccurrences(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("0 9 1 * MON", "report", "report.sh", "UTC")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	rendered, err := renderICS(job, now, 3, defaultICSDuration)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(rendered, "RRULE") {
+		t.Errorf("Expected no RRULE when day and weekday are both set:\n%s", rendered)
+	}
+
+	for _, want := range []string{"DTSTART:20250310T090000Z", "DTSTART:20250317T090000Z", "DTSTART:20250324T090000Z"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected %q in:\n%s", want, rendered)
+		}
+	}
+
+	if got := strings.Count(rendered, "BEGIN:VEVENT"); got != 3 {
+		t.Errorf("Expected 3 events, got %d", got)
+	}
+}
+
+// TestICSLineFolding verifies that long lines are folded at 75 octets
+// without splitting a UTF-8 sequence.
+func TestICSLineFolding(t *testing.T) {
+	t.Parallel()
+
+	var builder strings.Builder
+
+	icsLine(&builder, "SUMMARY:"+strings.Repeat("é", 60))
+
+	for line := range strings.SplitSeq(strings.TrimSuffix(builder.String(), "\r\n"), "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Errorf("Line longer than %d octets: %q", icsLineLimit, line)
+		}
+
+		if !strings.HasSuffix(line, "é") {
+			t.Errorf("Expected the fold between characters, got %q", line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(builder.String(), "\r\n ", "")
+	if unfolded != "SUMMARY:"+strings.Repeat("é", 60)+"\r\n" {
+		t.Errorf("Expected unfolding to restore the line, got %q", unfolded)
+	}
+}
+
+// TestRunICSCommand verifies the ics subcommand and its argument checks.
+func TestRunICSCommand(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	if err := runCommand([]string{"ics", "--name", "sync", "0 * * * *"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "RRULE:FREQ=DAILY;BYMINUTE=0") {
+		t.Errorf("Expected an hourly rule, got:\n%s", stdout.String())
+	}
+
+	for _, args := range [][]string{{"ics"}, {"ics", "--count", "0", "* * * * *"}, {"ics", "--duration", "10s", "* * * * *"}} {
+		if err := runCommand(args, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+			t.Errorf("runCommand(%q) expected ErrUsage, got %v", args, err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrImportRows is returned when some rows of an import could not be imported
+var ErrImportRows = errors.New("rows failed to import") //nolint:gochecknoglobals
+
+// requiredColumns are the CSV columns every import needs; command, timezone, owner, and group are optional
+//
+//nolint:gochecknoglobals
+var requiredColumns = []string{"name", "schedule"}
+
+// rowError is a validation error for one CSV row
+type rowError struct {
+	row int   // Line number in the file, counting the header as line 1
+	err error // What is wrong with the row
+}
+
+// Error formats the row number and problem, e.g. "row 3: missing name"
+func (e rowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.row, e.err)
+}
+
+// importCSV reads jobs from CSV with a header row naming the columns, adding
+// valid rows to the workspace. Rows that are invalid or whose name is already
+// taken are skipped and reported. It returns the number of rows read and imported.
+func importCSV(reader io.Reader, ws *workspace) (int, int, []rowError, error) {
+	records := csv.NewReader(reader)
+	records.FieldsPerRecord = -1
+	records.TrimLeadingSpace = true
+
+	header, err := records.Read()
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("%w: reading header: %w", ErrInvalidWorkspace, err)
+	}
+
+	columns := make(map[string]int)
+
+	for index, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = index
+	}
+
+	for _, required := range requiredColumns {
+		if _, ok := columns[required]; !ok {
+			return 0, 0, nil, fmt.Errorf("%w: header is missing the %q column", ErrInvalidWorkspace, required)
+		}
+	}
+
+	var (
+		rows     int
+		imported int
+		problems []rowError
+	)
+
+	for row := 2; ; row++ {
+		record, err := records.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		rows++
+
+		if err != nil {
+			problems = append(problems, rowError{row: row, err: err})
+
+			continue
+		}
+
+		entry := importEntry(record, columns)
+
+		if err := validateEntry(entry); err != nil {
+			problems = append(problems, rowError{row: row, err: err})
+
+			continue
+		}
+
+		if ws.find(entry.Name) >= 0 {
+			problems = append(problems, rowError{
+				row: row,
+				err: fmt.Errorf("%w: name %q already exists", ErrInvalidWorkspace, entry.Name),
+			})
+
+			continue
+		}
+
+		ws.Entries = append(ws.Entries, entry)
+		imported++
+	}
+
+	return rows, imported, problems, nil
+}
+
+// importEntry maps a CSV record to an entry using the header's column positions
+func importEntry(record []string, columns map[string]int) workspaceEntry {
+	value := func(column string) string {
+		index, ok := columns[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[index])
+	}
+
+	return workspaceEntry{
+		Name:     value("name"),
+		Schedule: value("schedule"),
+		Command:  value("command"),
+		Timezone: value("timezone"),
+		Owner:    value("owner"),
+		Group:    value("group"),
+	}
+}
+
+// runImport imports jobs from a CSV file into the workspace
+func runImport(args []string, stdout, stderr io.Writer) error {
+	var (
+		path   string
+		dryRun bool
+	)
+
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&path, "workspace", defaultWorkspacePath(), "workspace file to import into")
+	flags.BoolVar(&dryRun, "dry-run", false, "validate the rows without saving")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("%w: crontab-guru import [--workspace FILE] [--dry-run] FILE.csv", ErrUsage)
+	}
+
+	ws, err := loadWorkspace(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(positional[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", positional[0], err)
+	}
+	defer func() { _ = file.Close() }()
+
+	rows, imported, problems, err := importCSV(file, &ws)
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range problems {
+		fmt.Fprintln(stderr, problem.Error())
+	}
+
+	if !dryRun && imported > 0 {
+		if err := saveWorkspace(path, ws); err != nil {
+			return err
+		}
+	}
+
+	summary := "imported %d of %d rows into %s\n"
+	if dryRun {
+		summary = "%d of %d rows would be imported into %s\n"
+	}
+
+	fmt.Fprintf(stdout, summary, imported, rows, path)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %d skipped", ErrImportRows, len(problems))
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+const importFixture = `Owner,Name,Schedule,Command,Timezone
+ops,backup,30 2 * * *,backup.sh,Europe/Lisbon
+ops,bad,61 * * * *,x,
+bi,,@daily,y,
+bi,report,0 9 * * MON,report.sh,Mars/Olympus
+ops,Backup,@hourly,z,
+`
+
+// TestImportCSV verifies that columns are matched by header name and that
+// invalid or duplicate rows are reported with their row numbers.
+func TestImportCSV(t *testing.T) {
+	t.Parallel()
+
+	var ws workspace
+
+	rows, imported, problems, err := importCSV(strings.NewReader(importFixture), &ws)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if rows != 5 || imported != 1 || len(ws.Entries) != 1 {
+		t.Fatalf("Expected 1 of 5 rows imported, got %d of %d", imported, rows)
+	}
+
+	expected := workspaceEntry{Name: "backup", Schedule: "30 2 * * *", Command: "backup.sh", Timezone: "Europe/Lisbon", Owner: "ops"}
+	if ws.Entries[0] != expected {
+		t.Errorf("Unexpected entry %+v", ws.Entries[0])
+	}
+
+	wantRows := []int{3, 4, 5, 6}
+	if len(problems) != len(wantRows) {
+		t.Fatalf("Expected %d problems, got %v", len(wantRows), problems)
+	}
+
+	for index, problem := range problems {
+		if problem.row != wantRows[index] || !strings.HasPrefix(problem.Error(), "row ") {
+			t.Errorf("Unexpected problem %v", problem)
+		}
+	}
+
+	if _, _, _, err := importCSV(strings.NewReader("name,command\nx,y\n"), &ws); !errors.Is(err, ErrInvalidWorkspace) {
+		t.Errorf("Expected a missing schedule column to be rejected, got %v", err)
+	}
+}
+
+// TestRunImportCommand verifies that import saves valid rows, reports the rest,
+// and leaves the workspace untouched in a dry run.
+func TestRunImportCommand(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "jobs.csv")
+	wsPath := filepath.Join(dir, workspaceFileName)
+
+	if err := os.WriteFile(csvPath, []byte(importFixture), 0o600); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	err := runCommand([]string{"import", "--dry-run", "--workspace", wsPath, csvPath}, &stdout, &stderr)
+	if !errors.Is(err, ErrImportRows) {
+		t.Errorf("Expected ErrImportRows, got %v", err)
+	}
+
+	if _, statErr := os.Stat(wsPath); statErr == nil {
+		t.Error("Expected a dry run not to write the workspace")
+	}
+
+	if !strings.Contains(stderr.String(), `row 3: invalid value in field: minute "61"`) {
+		t.Errorf("Expected per-row errors on stderr, got %q", stderr.String())
+	}
+
+	_ = runCommand([]string{"import", "--workspace", wsPath, csvPath}, &stdout, &stderr)
+
+	ws, err := loadWorkspace(wsPath)
+	if err != nil || len(ws.Entries) != 1 {
+		t.Errorf("Expected one saved entry, got %+v, %v", ws, err)
+	}
+
+	if err := runCommand([]string{"import", "--workspace", wsPath}, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+}
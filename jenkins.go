@@ -0,0 +1,262 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"crypto/md5" //nolint:gosec // Matches the digest Jenkins uses to seed H; not used for security
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	javaRandomMultiplier = 0x5DEECE66D // java.util.Random LCG multiplier
+	javaRandomAddend     = 0xB         // java.util.Random LCG addend
+	javaRandomMask       = 1<<48 - 1   // java.util.Random keeps 48 bits of state
+	jenkinsHashDayMax    = 28          // Jenkins limits a bare H in the day field to 1-28
+)
+
+//nolint:gochecknoglobals
+var (
+	// Jenkins macros are hashed so jobs sharing a macro do not all fire at once
+	jenkinsMacros = map[string]string{
+		"@yearly":   "H H H H *",
+		"@annually": "H H H H *",
+		"@monthly":  "H H H * *",
+		"@weekly":   "H H * * H",
+		"@daily":    "H H * * *",
+		"@midnight": "H H(0-2) * * *",
+		"@hourly":   "H * * * *",
+	}
+)
+
+// javaRandom reproduces java.util.Random so H values resolve exactly as Jenkins resolves them
+type javaRandom struct {
+	seed int64
+}
+
+// newJavaRandom seeds a generator the way new java.util.Random(seed) does
+func newJavaRandom(seed int64) *javaRandom {
+	return &javaRandom{seed: (seed ^ javaRandomMultiplier) & javaRandomMask}
+}
+
+// next returns the next pseudorandom value with the given number of bits
+func (r *javaRandom) next(bits uint) int32 {
+	r.seed = (r.seed*javaRandomMultiplier + javaRandomAddend) & javaRandomMask
+
+	return int32(uint64(r.seed) >> (48 - bits)) //nolint:gosec // Truncation mirrors Java's int cast
+}
+
+// nextInt returns a value in [0, bound) like Random.nextInt(bound)
+func (r *javaRandom) nextInt(bound int32) int32 {
+	value := r.next(31)
+	limit := bound - 1
+
+	if bound&limit == 0 {
+		return int32((int64(bound) * int64(value)) >> 31) //nolint:gosec // Result is below bound
+	}
+
+	// Reject values from the final partial block; the int32 overflow is intentional
+	for candidate := value; ; candidate = r.next(31) {
+		value = candidate % bound
+		if candidate-value+limit >= 0 {
+			return value
+		}
+	}
+}
+
+// jenkinsHash returns the generator Jenkins derives from a job name: an MD5
+// digest folded into eight bytes seeds a java.util.Random
+func jenkinsHash(seed string) *javaRandom {
+	digest := md5.Sum([]byte(seed)) //nolint:gosec // See import comment
+
+	for index := 8; index < len(digest); index++ {
+		digest[index%8] ^= digest[index]
+	}
+
+	var folded int64
+	for index := range 8 {
+		folded = folded<<8 + int64(digest[index])
+	}
+
+	return newJavaRandom(folded)
+}
+
+// hasJenkinsHash reports whether an expression uses Jenkins H tokens or hashed macros
+func hasJenkinsHash(expr string) bool {
+	trimmed := strings.TrimSpace(expr)
+	if _, ok := jenkinsMacros[strings.ToLower(trimmed)]; ok {
+		return true
+	}
+
+	for _, field := range strings.Fields(trimmed) {
+		for item := range strings.SplitSeq(field, ",") {
+			// No month or weekday name starts with H, so the prefix is unambiguous
+			if strings.HasPrefix(strings.ToUpper(item), "H") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasHashItem reports whether any list item in a field value is an H token
+func hasHashItem(value string) bool {
+	for item := range strings.SplitSeq(value, ",") {
+		if strings.HasPrefix(item, "H") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateHashValue validates a field value containing H tokens, checking
+// each H item's range and step and every other item as a regular value
+func validateHashValue(value string, fieldIndex int) bool {
+	for item := range strings.SplitSeq(value, ",") {
+		if !strings.HasPrefix(item, "H") {
+			if item == "" || !isValidCronPart(item, fieldIndex) {
+				return false
+			}
+
+			continue
+		}
+
+		if _, err := resolveHashItem(item[1:], fieldIndex, newJavaRandom(0)); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveJenkinsHash replaces every H token in a Jenkins expression with the
+// concrete values Jenkins would pick for a job named seed. Tokens are hashed
+// left to right from one generator, as Jenkins does, so every H in the line
+// depends on the ones before it.
+func resolveJenkinsHash(expr string, seed string) (string, error) {
+	trimmed := strings.TrimSpace(expr)
+	if expanded, ok := jenkinsMacros[strings.ToLower(trimmed)]; ok {
+		trimmed = expanded
+	}
+
+	fields := strings.Fields(trimmed)
+	if len(fields) != numCronFields {
+		return "", fmt.Errorf("%w: expected %d, got %d", ErrFieldCount, numCronFields, len(fields))
+	}
+
+	hash := jenkinsHash(seed)
+
+	for fieldIndex, field := range fields {
+		items := strings.Split(field, ",")
+
+		for itemIndex, item := range items {
+			if !strings.HasPrefix(strings.ToUpper(item), "H") {
+				continue
+			}
+
+			resolved, err := resolveHashItem(item[1:], fieldIndex, hash)
+			if err != nil {
+				return "", err
+			}
+
+			items[itemIndex] = resolved
+		}
+
+		fields[fieldIndex] = strings.Join(items, ",")
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+// resolveHashItem resolves the remainder of an H token: "", "/step", "(a-b)", or "(a-b)/step"
+func resolveHashItem(rest string, fieldIndex int, hash *javaRandom) (string, error) {
+	invalid := fmt.Errorf("%w: %s %q", ErrInvalidValue, fieldNames[fieldIndex], "H"+rest)
+
+	start, end := fieldRanges[fieldIndex].min, fieldRanges[fieldIndex].max
+
+	switch fieldIndex {
+	case 2:
+		end = jenkinsHashDayMax
+	case fieldIndexWeekday:
+		end = 6
+	}
+
+	if strings.HasPrefix(rest, "(") {
+		closing := strings.Index(rest, ")")
+		if closing < 0 {
+			return "", invalid
+		}
+
+		low, high, ok := strings.Cut(rest[1:closing], "-")
+		if !ok {
+			return "", invalid
+		}
+
+		var errLow, errHigh error
+
+		start, errLow = strconv.Atoi(low)
+		end, errHigh = strconv.Atoi(high)
+
+		bounds := fieldRanges[fieldIndex]
+		if errLow != nil || errHigh != nil || start < bounds.min || end > bounds.max || start > end {
+			return "", invalid
+		}
+
+		rest = rest[closing+1:]
+	}
+
+	if rest == "" || rest == "/1" {
+		// A bare H picks one value rather than stepping by one
+		return strconv.Itoa(start + int(hash.nextInt(int32(end-start+1)))), nil //nolint:gosec // Field ranges are small
+	}
+
+	stepText, ok := strings.CutPrefix(rest, "/")
+	if !ok {
+		return "", invalid
+	}
+
+	step, err := strconv.Atoi(stepText)
+	if err != nil || step < 2 || step > end-start+1 {
+		return "", invalid
+	}
+
+	first := start + int(hash.nextInt(int32(step))) //nolint:gosec // Steps are bounded by the field range
+
+	return fmt.Sprintf("%d-%d/%d", first, end, step), nil
+}
+
+// hashNote explains how H tokens in the current expression resolve
+func (m *model) hashNote() string {
+	if m.hashResolved == "" {
+		return ""
+	}
+
+	job := fmt.Sprintf("job %q", m.hashSeed)
+	if m.hashSeed == "" {
+		job = "an unnamed job (set --seed to the job name)"
+	}
+
+	return fmt.Sprintf("H spreads load with a stable value per job: %s for %s", m.hashResolved, job)
+}
+
+// applyHashResolution replaces the fields with the values H resolves to for the seed
+func (m *model) applyHashResolution() tea.Cmd {
+	if m.hashResolved == "" {
+		return nil
+	}
+
+	for index, value := range strings.Fields(m.hashResolved) {
+		m.inputs[index].SetValue(value)
+	}
+
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestJavaRandomMatchesJava verifies the generator against values produced by java.util.Random.
+func TestJavaRandomMatchesJava(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		seed     int64
+		expected int32
+	}{
+		{42, -1170105035},
+		{0, -1155484576},
+	}
+
+	for _, tt := range tests {
+		if got := newJavaRandom(tt.seed).next(32); got != tt.expected {
+			t.Errorf("new Random(%d).nextInt() = %d, expected %d", tt.seed, got, tt.expected)
+		}
+	}
+
+	random := newJavaRandom(7)
+	for range 1000 {
+		if value := random.nextInt(28); value < 0 || value >= 28 {
+			t.Fatalf("nextInt(28) returned %d, outside [0, 28)", value)
+		}
+	}
+}
+
+// TestResolveJenkinsHash verifies that H tokens resolve deterministically per
+// seed, stay within their ranges, and leave other fields untouched.
+func TestResolveJenkinsHash(t *testing.T) {
+	t.Parallel()
+
+	resolved, err := resolveJenkinsHash("H H(9-17) H * H/2", "nightly-build")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	again, err := resolveJenkinsHash("H H(9-17) H * H/2", "nightly-build")
+	if err != nil || again != resolved {
+		t.Errorf("Expected the same seed to resolve the same way, got %q and %q", resolved, again)
+	}
+
+	fields := strings.Fields(resolved)
+	if len(fields) != numCronFields || fields[3] != "*" {
+		t.Fatalf("Unexpected resolution %q", resolved)
+	}
+
+	ranges := []fieldBounds{{0, 59}, {9, 17}, {1, 28}, {1, 12}, {0, 6}}
+	for index, field := range fields {
+		set, err := expandField(field, index)
+		if err != nil {
+			t.Fatalf("Resolved field %q is invalid: %v", field, err)
+		}
+
+		for _, value := range set.Values() {
+			if value < ranges[index].min || value > ranges[index].max {
+				t.Errorf("Field %d resolved to %d, outside %v", index, value, ranges[index])
+			}
+		}
+	}
+
+	if macro, err := resolveJenkinsHash("@daily", "nightly-build"); err != nil || strings.Contains(macro, "H") {
+		t.Errorf("Expected @daily to resolve to concrete values, got %q (%v)", macro, err)
+	}
+
+	if !hasJenkinsHash("H * * * *") || !hasJenkinsHash("@hourly") || hasJenkinsHash("0 0 * * THU") {
+		t.Error("Unexpected hasJenkinsHash results")
+	}
+
+	for _, invalid := range []string{"H(5) * * * *", "H(30-10) * * * *", "H/0 * * * *", "Hx * * * *"} {
+		if _, err := resolveJenkinsHash(invalid, "job"); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("resolveJenkinsHash(%q) expected ErrInvalidValue, got %v", invalid, err)
+		}
+	}
+}
+
+// TestHashFieldValidation verifies that H tokens are accepted in every field
+// with valid ranges and steps, and rejected when malformed.
+func TestHashFieldValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value      string
+		fieldIndex int
+		expected   bool
+	}{
+		{"H", 0, true},
+		{"H/15", 0, true},
+		{"H(0-29)/10", 0, true},
+		{"H,30", 0, true},
+		{"H(9-17)", 1, true},
+		{"H", 2, true},
+		{"H", fieldIndexWeekday, true},
+		{"H(", 0, false},
+		{"H/0", 0, false},
+		{"H(0-99)", 0, false},
+		{"H,", 0, false},
+		{"H,JAN", 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := isValidCronPart(tt.value, tt.fieldIndex); got != tt.expected {
+			t.Errorf("isValidCronPart(%q, %d) = %v, expected %v", tt.value, tt.fieldIndex, got, tt.expected)
+		}
+	}
+}
+
+// TestHashScheduleAndResolve verifies that the editor describes H expressions
+// using the seed and that ctrl+e writes the resolved values into the fields.
+func TestHashScheduleAndResolve(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.hashSeed = "nightly"
+	m.inputs[0].SetValue("H")
+	m.inputs[1].SetValue("H(0-5)")
+	m.updateDescription()
+
+	expected, err := resolveJenkinsHash("H H(0-5) * * *", "nightly")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if m.err != nil || m.nextRun == "" || m.hashResolved != expected {
+		t.Fatalf("Expected a schedule for %q, got resolved %q, err %v", expected, m.hashResolved, m.err)
+	}
+
+	if !strings.Contains(m.hashNote(), `job "nightly"`) || !strings.Contains(m.View(), expected) {
+		t.Error("Expected the view to explain the resolved values for the job")
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = assertModelType(t, newModel)
+
+	if m.buildCronExpression() != expected || m.hashResolved != "" {
+		t.Errorf("Expected ctrl+e to apply %q, got %q", expected, m.buildCronExpression())
+	}
+
+	if m.applyHashResolution() != nil {
+		t.Error("Expected nothing to resolve once H is gone")
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+	"github.com/mattn/go-isatty"
+)
+
+// ErrK8sManifest is returned when CronJobs cannot be read from a manifest
+var ErrK8sManifest = errors.New("invalid Kubernetes manifest") //nolint:gochecknoglobals
+
+//nolint:gochecknoglobals
+var (
+	// A "key: value" line of a YAML manifest
+	yamlKeyLine = regexp.MustCompile(`^(\s*)([A-Za-z]+)(\s*:\s*)(.*)$`)
+
+	// Server-managed metadata left out of patched JSON manifests so they apply cleanly
+	k8sServerMetadata = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "uid"}
+)
+
+// k8sCronJob is a CronJob read from kubectl or a manifest
+type k8sCronJob struct {
+	namespace string // Namespace, "" when the manifest leaves it out
+	name      string // CronJob name
+	schedule  string // spec.schedule as written
+	timeZone  string // spec.timeZone, "" for the controller's time zone
+	line      int    // Index of the schedule line in a YAML manifest
+	item      int    // Index of the object in a JSON manifest
+}
+
+// String names the CronJob as namespace/name
+func (job k8sCronJob) String() string {
+	if job.namespace == "" {
+		return job.name
+	}
+
+	return job.namespace + "/" + job.name
+}
+
+// k8sManifest is a set of CronJobs and the text they were read from, so
+// edited schedules can be written back into it
+type k8sManifest struct {
+	jobs    []k8sCronJob     // CronJobs in the order they were read
+	lines   []string         // Lines of a YAML manifest, nil for JSON
+	items   []map[string]any // Objects of a JSON manifest, nil for YAML
+	changed map[int]bool     // CronJobs whose schedule was edited
+}
+
+// readK8sManifest reads the CronJobs of a JSON manifest, such as the output
+// of kubectl get -o json, or of a YAML manifest with one or more documents
+func readK8sManifest(text string) (*k8sManifest, error) {
+	if strings.HasPrefix(strings.TrimSpace(text), "{") {
+		return readK8sJSON(text)
+	}
+
+	return readK8sYAML(text), nil
+}
+
+// k8sString looks up a string nested under keys, "" when it is missing
+func k8sString(object map[string]any, keys ...string) string {
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := object[key].(map[string]any)
+		if !ok {
+			return ""
+		}
+
+		object = nested
+	}
+
+	value, _ := object[keys[len(keys)-1]].(string)
+
+	return value
+}
+
+// readK8sJSON reads a single CronJob or a List of them
+func readK8sJSON(text string) (*k8sManifest, error) {
+	var document map[string]any
+	if err := json.Unmarshal([]byte(text), &document); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrK8sManifest, err)
+	}
+
+	items := []any{document}
+	if list, ok := document["items"].([]any); ok {
+		items = list
+	}
+
+	manifest := &k8sManifest{changed: make(map[int]bool)}
+
+	for _, entry := range items {
+		item, ok := entry.(map[string]any)
+		if !ok || item["kind"] != "CronJob" {
+			continue
+		}
+
+		manifest.jobs = append(manifest.jobs, k8sCronJob{
+			namespace: k8sString(item, "metadata", "namespace"),
+			name:      k8sString(item, "metadata", "name"),
+			schedule:  k8sString(item, "spec", "schedule"),
+			timeZone:  k8sString(item, "spec", "timeZone"),
+			item:      len(manifest.items),
+		})
+		manifest.items = append(manifest.items, item)
+	}
+
+	return manifest, nil
+}
+
+// splitYAMLValue separates a YAML scalar from a trailing comment, removing
+// its quotes
+func splitYAMLValue(value string) (string, string) {
+	value = strings.TrimRight(value, " \t")
+	if value != "" && (value[0] == '"' || value[0] == '\'') {
+		if closing := strings.IndexByte(value[1:], value[0]); closing >= 0 {
+			return value[1 : closing+1], value[closing+2:]
+		}
+	}
+
+	if before, after, ok := strings.Cut(value, " #"); ok {
+		return strings.TrimRight(before, " \t"), " #" + after
+	}
+
+	return value, ""
+}
+
+// readK8sYAML finds the CronJobs of a YAML manifest line by line: kind at the
+// top level, name and namespace directly under metadata, and schedule and
+// timeZone directly under spec, so the job template's own keys are skipped
+func readK8sYAML(text string) *k8sManifest {
+	manifest := &k8sManifest{lines: strings.Split(text, "\n"), changed: make(map[int]bool)}
+
+	var (
+		kind    string
+		job     k8sCronJob
+		section string // Top-level key the current line is under
+		indent  int    // Indentation of the section's keys, -1 until its first key
+	)
+
+	flush := func() {
+		if kind == "CronJob" && job.line >= 0 {
+			manifest.jobs = append(manifest.jobs, job)
+		}
+
+		kind, job, section = "", k8sCronJob{line: -1}, ""
+	}
+
+	flush()
+
+	for index, line := range manifest.lines {
+		body := strings.TrimSuffix(line, "\r")
+		if strings.HasPrefix(body, "---") {
+			flush()
+
+			continue
+		}
+
+		match := yamlKeyLine.FindStringSubmatch(body)
+		if match == nil {
+			continue
+		}
+
+		depth, key, value := len(match[1]), match[2], match[4]
+		if depth == 0 {
+			section, indent = key, -1
+
+			if key == "kind" {
+				kind, _ = splitYAMLValue(value)
+			}
+
+			continue
+		}
+
+		if indent < 0 {
+			indent = depth
+		}
+
+		if depth != indent {
+			continue
+		}
+
+		scalar, _ := splitYAMLValue(value)
+
+		switch section + "." + key {
+		case "metadata.name":
+			job.name = scalar
+		case "metadata.namespace":
+			job.namespace = scalar
+		case "spec.schedule":
+			job.schedule, job.line = scalar, index
+		case "spec.timeZone":
+			job.timeZone = scalar
+		}
+	}
+
+	flush()
+
+	return manifest
+}
+
+// setSchedule writes an edited schedule into the CronJob's line or object
+func (manifest *k8sManifest) setSchedule(index int, expr string) {
+	job := &manifest.jobs[index]
+	job.schedule = expr
+	manifest.changed[index] = true
+
+	if manifest.lines == nil {
+		spec, _ := manifest.items[job.item]["spec"].(map[string]any)
+		spec["schedule"] = expr
+
+		return
+	}
+
+	line := manifest.lines[job.line]
+	carriageReturn := strings.HasSuffix(line, "\r")
+	match := yamlKeyLine.FindStringSubmatch(strings.TrimSuffix(line, "\r"))
+	_, comment := splitYAMLValue(match[4])
+
+	line = match[1] + match[2] + match[3] + `"` + expr + `"` + comment
+	if carriageReturn {
+		line += "\r"
+	}
+
+	manifest.lines[job.line] = line
+}
+
+// render prints the patched manifest: the whole YAML file with the edited
+// schedules, or a JSON List of the edited CronJobs without server-managed
+// fields, ready for kubectl apply
+func (manifest *k8sManifest) render() (string, error) {
+	if manifest.lines != nil {
+		return strings.Join(manifest.lines, "\n"), nil
+	}
+
+	var items []map[string]any
+
+	for index, job := range manifest.jobs {
+		if !manifest.changed[index] {
+			continue
+		}
+
+		item := manifest.items[job.item]
+		delete(item, "status")
+
+		if metadata, ok := item["metadata"].(map[string]any); ok {
+			for _, key := range k8sServerMetadata {
+				delete(metadata, key)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	data, err := json.MarshalIndent(map[string]any{"apiVersion": "v1", "kind": "List", "items": items}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to write the manifest: %w", err)
+	}
+
+	return string(data) + "\n", nil
+}
+
+// browserJobs lists the CronJobs for the job browser with their indexes in
+// the manifest, reporting the ones whose schedule or time zone cannot be read
+func (manifest *k8sManifest) browserJobs(stderr io.Writer) ([]browserJob, []int) {
+	var (
+		jobs    []browserJob
+		indexes []int
+	)
+
+	for index, job := range manifest.jobs {
+		fields, err := splitRawExpression(job.schedule)
+		if err == nil {
+			err = validateStandardFields(fields)
+		}
+
+		if err != nil {
+			fmt.Fprintf(stderr, "skipping %s: %v\n", job, err)
+
+			continue
+		}
+
+		location := time.UTC
+		if job.timeZone != "" {
+			if location, err = time.LoadLocation(job.timeZone); err != nil {
+				fmt.Fprintf(stderr, "skipping %s: %v: timezone %q\n", job, ErrInvalidValue, job.timeZone)
+
+				continue
+			}
+		}
+
+		jobs = append(jobs, browserJob{name: job.String(), expr: strings.Join(fields, " "), location: location})
+		indexes = append(indexes, index)
+	}
+
+	return jobs, indexes
+}
+
+// runK8s dispatches the k8s subcommands
+func runK8s(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] != "import" {
+		return fmt.Errorf("%w: crontab-guru k8s import [--namespace NS] [MANIFEST]", ErrUsage)
+	}
+
+	return runK8sImport(args[1:], stdout, stderr)
+}
+
+// runK8sImport lists the CronJobs of a cluster or a manifest, lets their
+// schedules be edited, and prints the patched manifest
+func runK8sImport(args []string, stdout, stderr io.Writer) error {
+	var namespace string
+
+	flags := flag.NewFlagSet("k8s import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&namespace, "namespace", "", "read CronJobs from this namespace only, instead of all of them")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) > 1 || (len(paths) == 1 && namespace != "") {
+		return fmt.Errorf("%w: crontab-guru k8s import [--namespace NS] [MANIFEST]", ErrUsage)
+	}
+
+	// The browser draws on stderr so the manifest can be redirected from stdout
+	if !isatty.IsTerminal(os.Stderr.Fd()) {
+		return fmt.Errorf("%w: crontab-guru k8s import needs a terminal", ErrUsage)
+	}
+
+	source, text := "all namespaces", ""
+
+	if len(paths) == 1 {
+		source = paths[0]
+		text, err = readCrontab(paths[0])
+	} else {
+		argv := []string{"kubectl", "get", "cronjobs", "--all-namespaces", "-o", "json"}
+		if namespace != "" {
+			source, argv = namespace, []string{"kubectl", "get", "cronjobs", "--namespace", namespace, "-o", "json"}
+		}
+
+		text, err = execRunner(argv, "")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readK8sManifest(text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+
+	jobs, indexes := manifest.browserJobs(stderr)
+	if len(jobs) == 0 {
+		fmt.Fprintf(stderr, "no CronJobs in %s\n", source)
+
+		return nil
+	}
+
+	browser := newJobBrowser("CronJobs in "+source, "print the manifest", jobs, func(index int, expr, _ string) {
+		manifest.setSchedule(indexes[index], expr)
+	})
+
+	if browser.calendars, err = configCalendars(defaultConfigPath()); err != nil {
+		return err
+	}
+
+	options := []tea.ProgramOption{tea.WithOutput(os.Stderr)}
+	if !isatty.IsTerminal(os.Stdin.Fd()) {
+		options = append(options, tea.WithInputTTY())
+	}
+
+	final, err := tea.NewProgram(browser, options...).Run()
+	if err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	if result, ok := final.(*jobBrowser); !ok || !result.save {
+		return nil
+	}
+
+	patched, err := manifest.render()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, patched)
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+const k8sTestYAML = `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: backup
+  namespace: ops
+spec:
+  schedule: "0 2 * * *" # nightly
+  timeZone: Europe/Lisbon
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: backup
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  schedule: "0 3 * * *"
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: poll
+spec:
+  schedule: '@hourly'
+`
+
+const k8sTestJSON = `{
+  "apiVersion": "v1",
+  "kind": "List",
+  "items": [
+    {
+      "apiVersion": "batch/v1",
+      "kind": "CronJob",
+      "metadata": {"name": "report", "namespace": "web", "uid": "1234", "resourceVersion": "99"},
+      "spec": {"schedule": "30 6 * * 1-5", "timeZone": "America/New_York"},
+      "status": {"lastScheduleTime": "2025-01-01T06:30:00Z"}
+    },
+    {
+      "apiVersion": "batch/v1",
+      "kind": "CronJob",
+      "metadata": {"name": "cleanup", "namespace": "web"},
+      "spec": {"schedule": "0 0 * * 0"}
+    }
+  ]
+}`
+
+// TestReadK8sYAML verifies that only CronJobs are read from a multi-document
+// manifest, with their names, namespaces, schedules, and time zones.
+func TestReadK8sYAML(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := readK8sManifest(k8sTestYAML)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(manifest.jobs) != 2 {
+		t.Fatalf("Expected two CronJobs, got %+v", manifest.jobs)
+	}
+
+	backup, poll := manifest.jobs[0], manifest.jobs[1]
+	if backup.String() != "ops/backup" || backup.schedule != "0 2 * * *" || backup.timeZone != "Europe/Lisbon" ||
+		backup.line != 6 {
+		t.Errorf("Unexpected backup CronJob %+v", backup)
+	}
+
+	if poll.String() != "poll" || poll.schedule != "@hourly" || poll.timeZone != "" {
+		t.Errorf("Unexpected poll CronJob %+v", poll)
+	}
+
+	jobs, _ := manifest.browserJobs(io.Discard)
+	if len(jobs) != 2 || jobs[1].expr != "0 * * * *" || jobs[0].location.String() != "Europe/Lisbon" {
+		t.Errorf("Unexpected browser jobs %+v", jobs)
+	}
+}
+
+// TestPatchK8sYAML verifies that an edited schedule replaces only its line,
+// keeping the comment after it.
+func TestPatchK8sYAML(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := readK8sManifest(k8sTestYAML)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	manifest.setSchedule(0, "15 2 * * *")
+
+	patched, err := manifest.render()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := strings.Replace(k8sTestYAML, `schedule: "0 2 * * *" # nightly`, `schedule: "15 2 * * *" # nightly`, 1)
+	if patched != expected {
+		t.Errorf("Unexpected patched manifest:\n%s", patched)
+	}
+}
+
+// TestPatchK8sJSON verifies that kubectl output is read and that the patched
+// manifest lists only the edited CronJobs, without server-managed fields.
+func TestPatchK8sJSON(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := readK8sManifest(k8sTestJSON)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(manifest.jobs) != 2 || manifest.jobs[0].String() != "web/report" || manifest.jobs[0].timeZone != "America/New_York" {
+		t.Fatalf("Unexpected CronJobs %+v", manifest.jobs)
+	}
+
+	manifest.setSchedule(0, "45 6 * * 1-5")
+
+	patched, err := manifest.render()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var list struct {
+		Kind  string `json:"kind"`
+		Items []struct {
+			Metadata map[string]any `json:"metadata"`
+			Spec     map[string]any `json:"spec"`
+			Status   map[string]any `json:"status"`
+		} `json:"items"`
+	}
+
+	if err := json.Unmarshal([]byte(patched), &list); err != nil {
+		t.Fatalf("Patched manifest is not JSON: %v\n%s", err, patched)
+	}
+
+	if list.Kind != "List" || len(list.Items) != 1 {
+		t.Fatalf("Expected a List of the edited CronJob, got:\n%s", patched)
+	}
+
+	item := list.Items[0]
+	if item.Spec["schedule"] != "45 6 * * 1-5" || item.Metadata["uid"] != nil || item.Metadata["resourceVersion"] != nil ||
+		item.Status != nil || item.Metadata["name"] != "report" {
+		t.Errorf("Unexpected patched CronJob:\n%s", patched)
+	}
+
+	if _, err := readK8sManifest("{not json"); !errors.Is(err, ErrK8sManifest) {
+		t.Errorf("Expected ErrK8sManifest, got %v", err)
+	}
+}
+
+// TestRunK8sUsage verifies that k8s only takes the import subcommand and a
+// single manifest.
+func TestRunK8sUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{
+		nil,
+		{"export"},
+		{"import", "a.yaml", "b.yaml"},
+		{"import", "--namespace", "web", "a.yaml"},
+	} {
+		if err := runK8s(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runK8s(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keyMap holds the editor's key bindings. The keys setting of the config
+// file replaces the keys of any of them by action name, see bindings.
+type keyMap struct {
+	NextField      key.Binding // Focus the next field
+	PrevField      key.Binding // Focus the previous field
+	Increment      key.Binding // Raise the number in the focused field
+	Decrement      key.Binding // Lower the number in the focused field
+	Copy           key.Binding // Copy the expression or its export
+	ClearField     key.Binding // Empty the focused field
+	ClearFields    key.Binding // Set every field to *
+	RestoreDefault key.Binding // Load the default expression
+	Notation       key.Binding // Write months and weekdays as names or numbers
+	Peek           key.Binding // Show the full value of the focused field
+	Raw            key.Binding // Edit the whole expression as text
+	Dials          key.Binding // Show the hour and minute dials
+	ResolveHash    key.Binding // Replace Jenkins H tokens with their values
+	Scratchpad     key.Binding // Open the session scratchpad
+	CopyFormat     key.Binding // Choose what Copy copies
+	Chips          key.Binding // Show the weekday and month chips
+	Collapse       key.Binding // Collapse overlapping list items
+	Simplify       key.Binding // Rewrite fields with their shortest equivalents
+	DST            key.Binding // Show runs around the next DST change
+	Compat         key.Binding // Show the scheduler compatibility matrix
+	Pin            key.Binding // Pin the next runs
+	LogSuffix      key.Binding // Append a logging suffix to the command
+	Wizard         key.Binding // Build the expression by answering questions
+	Examples       key.Binding // Load one of the popular examples
+	Guru           key.Binding // Open the expression on crontab.guru
+	NewTab         key.Binding // Open a tab from the expression
+	CloseTab       key.Binding // Close the current tab
+	PrevTab        key.Binding // Switch to the previous tab
+	NextTab        key.Binding // Switch to the next tab
+	Stagger        key.Binding // Move the minutes of clashing tabs apart
+	NextDialect    key.Binding // Switch the dialect of the raw input
+	UndoDialect    key.Binding // Undo the last dialect switch
+	ScrollUp       key.Binding // Scroll a view taller than the terminal up
+	ScrollDown     key.Binding // Scroll a view taller than the terminal down
+	Help           key.Binding // Show the help panel
+	Quit           key.Binding // Leave the editor
+}
+
+// keyConfig lists the keys of editor actions by action name
+type keyConfig map[string][]string
+
+// namedBinding is a binding with the name the keys setting calls it by
+type namedBinding struct {
+	name    string       // Action name, e.g. "next_field"
+	binding *key.Binding // Binding in the key map
+}
+
+// defaultKeyMap returns the built-in key bindings
+func defaultKeyMap() keyMap {
+	return keyMap{
+		NextField:      key.NewBinding(key.WithKeys("tab", " ", "enter"), key.WithHelp("tab/space/enter", "next field")),
+		PrevField:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous field")),
+		Increment:      key.NewBinding(key.WithKeys("alt+up"), key.WithHelp("alt+up", "raise the number in the field")),
+		Decrement:      key.NewBinding(key.WithKeys("alt+down"), key.WithHelp("alt+down", "lower the number in the field")),
+		Copy:           key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy expression")),
+		ClearField:     key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "clear the field")),
+		ClearFields:    key.NewBinding(key.WithKeys("alt+u"), key.WithHelp("alt+u", "clear every field to *")),
+		RestoreDefault: key.NewBinding(key.WithKeys("alt+r"), key.WithHelp("alt+r", "restore the default expression")),
+		Notation:       key.NewBinding(key.WithKeys("alt+t"), key.WithHelp("alt+t", "write months and weekdays as names or numbers")),
+		Peek:           key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "peek full field value")),
+		Raw:            key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "edit raw expression")),
+		Dials:          key.NewBinding(key.WithKeys("ctrl+o"), key.WithHelp("ctrl+o", "toggle hour/minute dials")),
+		ResolveHash:    key.NewBinding(key.WithKeys("ctrl+e"), key.WithHelp("ctrl+e", "replace Jenkins H with values")),
+		Scratchpad:     key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "session scratchpad")),
+		CopyFormat:     key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "choose what is copied")),
+		Chips:          key.NewBinding(key.WithKeys("ctrl+t"), key.WithHelp("ctrl+t", "weekday/month chips (keys 1-9, 0, -, =)")),
+		Collapse:       key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "collapse overlapping list items")),
+		Simplify:       key.NewBinding(key.WithKeys("alt+c"), key.WithHelp("alt+c", "apply the simpler equivalent of the fields")),
+		DST:            key.NewBinding(key.WithKeys("ctrl+g"), key.WithHelp("ctrl+g", "runs around the next DST change")),
+		Compat:         key.NewBinding(key.WithKeys("alt+m"), key.WithHelp("alt+m", "scheduler compatibility matrix")),
+		Pin:            key.NewBinding(key.WithKeys("alt+p"), key.WithHelp("alt+p", "pin the next runs to compare with edits")),
+		LogSuffix:      key.NewBinding(key.WithKeys("alt+l"), key.WithHelp("alt+l", "append a logging suffix to the command")),
+		Wizard:         key.NewBinding(key.WithKeys("alt+g"), key.WithHelp("alt+g", "build the expression by answering questions")),
+		Examples:       key.NewBinding(key.WithKeys("alt+e"), key.WithHelp("alt+e", "load one of the popular examples")),
+		Guru:           key.NewBinding(key.WithKeys("alt+o"), key.WithHelp("alt+o", "open the expression on crontab.guru")),
+		NewTab:         key.NewBinding(key.WithKeys("alt+n"), key.WithHelp("alt+n", "open a tab")),
+		CloseTab:       key.NewBinding(key.WithKeys("alt+w"), key.WithHelp("alt+w", "close the tab")),
+		PrevTab:        key.NewBinding(key.WithKeys("alt+left"), key.WithHelp("alt+left", "previous tab")),
+		NextTab:        key.NewBinding(key.WithKeys("alt+right"), key.WithHelp("alt+right", "next tab (alt+1-9 picks one)")),
+		Stagger:        key.NewBinding(key.WithKeys("alt+s"), key.WithHelp("alt+s", "stagger clashing tabs")),
+		NextDialect:    key.NewBinding(key.WithKeys("alt+d"), key.WithHelp("alt+d", "switch the raw input dialect")),
+		UndoDialect:    key.NewBinding(key.WithKeys("ctrl+z"), key.WithHelp("ctrl+z", "undo the last dialect switch")),
+		ScrollUp:       key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "scroll up when the view is taller than the terminal")),
+		ScrollDown:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "scroll down when the view is taller than the terminal")),
+		Help:           key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
+		Quit:           key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp("esc/ctrl+c", "quit")),
+	}
+}
+
+// bindings lists the bindings by action name, in the order the help panel
+// shows them
+func (k *keyMap) bindings() []namedBinding {
+	return []namedBinding{
+		{"next_field", &k.NextField},
+		{"prev_field", &k.PrevField},
+		{"increment", &k.Increment},
+		{"decrement", &k.Decrement},
+		{"copy", &k.Copy},
+		{"clear_field", &k.ClearField},
+		{"clear_fields", &k.ClearFields},
+		{"restore_default", &k.RestoreDefault},
+		{"notation", &k.Notation},
+		{"peek", &k.Peek},
+		{"raw", &k.Raw},
+		{"dials", &k.Dials},
+		{"resolve_hash", &k.ResolveHash},
+		{"scratchpad", &k.Scratchpad},
+		{"copy_format", &k.CopyFormat},
+		{"chips", &k.Chips},
+		{"collapse", &k.Collapse},
+		{"simplify", &k.Simplify},
+		{"dst", &k.DST},
+		{"compat", &k.Compat},
+		{"pin", &k.Pin},
+		{"log_suffix", &k.LogSuffix},
+		{"wizard", &k.Wizard},
+		{"examples", &k.Examples},
+		{"guru", &k.Guru},
+		{"new_tab", &k.NewTab},
+		{"close_tab", &k.CloseTab},
+		{"prev_tab", &k.PrevTab},
+		{"next_tab", &k.NextTab},
+		{"stagger", &k.Stagger},
+		{"next_dialect", &k.NextDialect},
+		{"undo_dialect", &k.UndoDialect},
+		{"scroll_up", &k.ScrollUp},
+		{"scroll_down", &k.ScrollDown},
+		{"help", &k.Help},
+		{"quit", &k.Quit},
+	}
+}
+
+// newKeyMap returns the default key map with the keys of the named actions
+// replaced. An empty list unbinds an action, so its key can be typed into
+// the fields, and "space" names the space bar. Unknown actions and keys
+// bound to two actions are rejected.
+func newKeyMap(overrides keyConfig) (keyMap, error) {
+	keys := defaultKeyMap()
+	bindings := keys.bindings()
+
+	for name, names := range overrides {
+		index := slices.IndexFunc(bindings, func(named namedBinding) bool { return named.name == name })
+		if index < 0 {
+			return keys, fmt.Errorf("%w: keys: unknown action %q", ErrInvalidConfig, name)
+		}
+
+		pressed := make([]string, 0, len(names))
+		for _, keyName := range names {
+			if keyName == "space" {
+				keyName = " "
+			}
+
+			pressed = append(pressed, keyName)
+		}
+
+		binding := bindings[index].binding
+		binding.SetKeys(pressed...)
+		binding.SetEnabled(len(pressed) > 0)
+		binding.SetHelp(strings.ReplaceAll(strings.Join(pressed, "/"), " ", "space"), binding.Help().Desc)
+	}
+
+	owners := make(map[string]string)
+
+	for _, named := range bindings {
+		for _, keyName := range named.binding.Keys() {
+			if owner, ok := owners[keyName]; ok {
+				return keys, fmt.Errorf("%w: keys: %q is bound to both %s and %s", ErrInvalidConfig, keyName, owner, named.name)
+			}
+
+			owners[keyName] = named.name
+		}
+	}
+
+	return keys, nil
+}
+
+// helpLines returns a line for each bound action, e.g. "y: copy expression"
+func (k *keyMap) helpLines() []string {
+	var lines []string
+
+	for _, named := range k.bindings() {
+		if named.binding.Enabled() {
+			help := named.binding.Help()
+			lines = append(lines, help.Key+": "+help.Desc)
+		}
+	}
+
+	return lines
+}
+
+// helpLines returns the cron syntax followed by the key bindings, for the
+// help panel
+func (m *model) helpLines() []string {
+	return append(slices.Clone(helpText), m.keys.helpLines()...)
+}
+
+// stepField raises or lowers the number in the focused field by delta,
+// wrapping around its range. An empty field or * starts from the first
+// value when raised and the last when lowered; anything else is left alone.
+func (m *model) stepField(delta int) tea.Cmd {
+	input := &m.inputs[m.focusIndex]
+	bounds := fieldRanges[m.focusIndex]
+	if m.focusIndex == fieldIndexWeekday {
+		bounds.max-- // 7 is Sunday again
+	}
+
+	var value int
+
+	switch current := input.Value(); current {
+	case "", "*":
+		value = bounds.min
+		if delta < 0 {
+			value = bounds.max
+		}
+	default:
+		number, err := strconv.Atoi(current)
+		if err != nil || number < bounds.min || number > bounds.max {
+			return nil
+		}
+
+		size := bounds.max - bounds.min + 1
+		value = bounds.min + ((number-bounds.min+delta)%size+size)%size
+	}
+
+	input.SetValue(strconv.Itoa(value))
+	input.CursorEnd()
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
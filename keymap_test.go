@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestNewKeyMap verifies that the keys setting replaces the keys of named
+// actions and their help, and that unknown actions and keys bound twice are
+// rejected.
+func TestNewKeyMap(t *testing.T) {
+	t.Parallel()
+
+	keys, err := newKeyMap(keyConfig{"next_field": {"l", "space"}, "copy": {}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !slices.Equal(keys.NextField.Keys(), []string{"l", " "}) || keys.Copy.Enabled() {
+		t.Errorf("Expected l and space to move on and copy unbound, got %v and %v", keys.NextField.Keys(), keys.Copy.Keys())
+	}
+
+	lines := keys.helpLines()
+	if !slices.Contains(lines, "l/space: next field") || slices.Contains(lines, "y: copy expression") {
+		t.Errorf("Expected the help to follow the bindings, got %v", lines)
+	}
+
+	if _, err := newKeyMap(keyConfig{"yank": {"y"}}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected an unknown action rejected, got %v", err)
+	}
+
+	if _, err := newKeyMap(keyConfig{"increment": {"ctrl+r"}}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected a key bound twice rejected, got %v", err)
+	}
+}
+
+// TestVimKeys verifies vim-style bindings from the config: h and l move
+// between fields, k and j step the number, and y is typed once unbound.
+func TestVimKeys(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"keys": {"prev_field": ["h"], "next_field": ["l", "tab"], "increment": ["k"], "decrement": ["j"], "copy": ["ctrl+y"]}}`)
+
+	opts, err := parseOptions([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+
+	m = pressKey(t, m, "l")
+	m = pressKey(t, m, "k")
+
+	if m.focusIndex != fieldIndexHour || m.buildCronExpression() != "20 5 * * *" {
+		t.Errorf("Expected l to move to the hour and k to raise it, got %d and %q", m.focusIndex, m.buildCronExpression())
+	}
+
+	m = pressKey(t, m, "h")
+	for range 21 {
+		m = pressKey(t, m, "j")
+	}
+
+	if m.focusIndex != fieldIndexMinute || m.buildCronExpression() != "59 5 * * *" {
+		t.Errorf("Expected h to move back and j to wrap the minute, got %d and %q", m.focusIndex, m.buildCronExpression())
+	}
+
+	if !strings.Contains(m.copyHint(), "ctrl+y to copy") {
+		t.Errorf("Expected the copy hint to name ctrl+y, got %q", m.copyHint())
+	}
+
+	m.inputs[fieldIndexMinute].SetValue("")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	if m.inputs[fieldIndexMinute].Value() != "y" {
+		t.Errorf("Expected y typed into the field, got %q", m.inputs[fieldIndexMinute].Value())
+	}
+}
+
+// TestStepField verifies that raising and lowering wraps around the field's
+// range, starts a * field at its ends, and leaves lists alone.
+func TestStepField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		field    int
+		delta    int
+		expected string
+	}{
+		{"23", fieldIndexHour, 1, "0"},
+		{"1", fieldIndexDay, -1, "31"},
+		{"*", fieldIndexMonth, 1, "1"},
+		{"", fieldIndexWeekday, -1, "6"},
+		{"1,2", fieldIndexMinute, 1, "1,2"},
+		{"JAN", fieldIndexMonth, 1, "JAN"},
+	}
+
+	for _, test := range tests {
+		m := initialModel()
+		m.setFocus(test.field)
+		m.inputs[test.field].SetValue(test.value)
+		m.stepField(test.delta)
+
+		if value := m.inputs[test.field].Value(); value != test.expected {
+			t.Errorf("stepField(%d) on %q = %q, expected %q", test.delta, test.value, value, test.expected)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	maxLaunchdIntervals = 1000 // Most calendar intervals written before giving up on a plist
+	launchdHeader       = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrTooManyIntervals is returned when a schedule needs more calendar intervals than is practical
+	ErrTooManyIntervals = errors.New("too many calendar intervals")
+
+	// StartCalendarInterval keys for the minute, hour, day, month, and weekday fields
+	launchdKeys = []string{"Minute", "Hour", "Day", "Month", "Weekday"}
+)
+
+// launchdInterval is one StartCalendarInterval dictionary; fields absent from
+// the map match every value, like "*" in cron
+type launchdInterval map[int]int
+
+// launchdIntervals converts cron fields into calendar intervals. Each
+// interval holds a single value per key, so lists, ranges, and steps become
+// the cross product of their values. When both the day and weekday fields are
+// restricted, cron runs when either matches, so each gets its own intervals.
+func launchdIntervals(fields []string) ([]launchdInterval, error) {
+	sets := make([]fieldSet, numCronFields)
+
+	for index, field := range fields {
+		set, err := expandField(field, index)
+		if err != nil {
+			return nil, err
+		}
+
+		// A field selecting every value is left out, matching everything
+		if set == fullFieldSet(index) {
+			set = 0
+		}
+
+		sets[index] = set
+	}
+
+	if sets[2] != 0 && sets[fieldIndexWeekday] != 0 {
+		byDay := append([]fieldSet(nil), sets...)
+		byDay[fieldIndexWeekday] = 0
+
+		byWeekday := append([]fieldSet(nil), sets...)
+		byWeekday[2] = 0
+
+		intervals, err := crossIntervals(byDay, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return crossIntervals(byWeekday, intervals)
+	}
+
+	return crossIntervals(sets, nil)
+}
+
+// crossIntervals appends to intervals the cross product of the restricted sets
+func crossIntervals(sets []fieldSet, intervals []launchdInterval) ([]launchdInterval, error) {
+	product := []launchdInterval{{}}
+
+	for index, set := range sets {
+		if set == 0 {
+			continue
+		}
+
+		next := make([]launchdInterval, 0, len(product)*set.Len())
+
+		for _, interval := range product {
+			for _, value := range set.Values() {
+				extended := make(launchdInterval, len(interval)+1)
+				for key, existing := range interval {
+					extended[key] = existing
+				}
+
+				extended[index] = value
+				next = append(next, extended)
+			}
+		}
+
+		if len(next)+len(intervals) > maxLaunchdIntervals {
+			return nil, fmt.Errorf("%w: more than %d needed; simplify the schedule or split it into several jobs",
+				ErrTooManyIntervals, maxLaunchdIntervals)
+		}
+
+		product = next
+	}
+
+	return append(intervals, product...), nil
+}
+
+// renderLaunchd renders a LaunchAgent plist that runs the job's command on the
+// job's schedule. A schedule that maps to one calendar interval is written as a
+// single dictionary, otherwise as an array of dictionaries.
+func renderLaunchd(job exportJob) (string, error) {
+	intervals, err := launchdIntervals(job.fields)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(launchdHeader)
+	builder.WriteString("<dict>\n")
+	builder.WriteString("\t<key>Label</key>\n")
+	builder.WriteString("\t<string>" + xmlEscape(job.name) + "</string>\n")
+	builder.WriteString("\t<key>ProgramArguments</key>\n")
+	builder.WriteString("\t<array>\n")
+	builder.WriteString("\t\t<string>/bin/sh</string>\n")
+	builder.WriteString("\t\t<string>-c</string>\n")
+	builder.WriteString("\t\t<string>" + xmlEscape(job.command) + "</string>\n")
+	builder.WriteString("\t</array>\n")
+	builder.WriteString("\t<key>StartCalendarInterval</key>\n")
+
+	if len(intervals) == 1 {
+		writeLaunchdInterval(&builder, intervals[0], "\t")
+	} else {
+		builder.WriteString("\t<array>\n")
+
+		for _, interval := range intervals {
+			writeLaunchdInterval(&builder, interval, "\t\t")
+		}
+
+		builder.WriteString("\t</array>\n")
+	}
+
+	builder.WriteString("</dict>\n")
+	builder.WriteString("</plist>\n")
+
+	return builder.String(), nil
+}
+
+// writeLaunchdInterval writes one calendar interval dictionary at the given indentation
+func writeLaunchdInterval(builder *strings.Builder, interval launchdInterval, indent string) {
+	builder.WriteString(indent + "<dict>\n")
+
+	for index, key := range launchdKeys {
+		value, ok := interval[index]
+		if !ok {
+			continue
+		}
+
+		builder.WriteString(indent + "\t<key>" + key + "</key>\n")
+		builder.WriteString(indent + "\t<integer>" + strconv.Itoa(value) + "</integer>\n")
+	}
+
+	builder.WriteString(indent + "</dict>\n")
+}
+
+// xmlEscape escapes text for use inside an XML element
+func xmlEscape(text string) string {
+	var buffer bytes.Buffer
+
+	_ = xml.EscapeText(&buffer, []byte(text)) // Writing to a bytes.Buffer cannot fail
+
+	return buffer.String()
+}
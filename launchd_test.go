@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestLaunchdIntervals verifies the number and content of calendar intervals
+// for single values, lists, wildcards, and restricted day and weekday fields.
+func TestLaunchdIntervals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected int
+	}{
+		{"30 2 * * *", 1},
+		{"* * * * *", 1},
+		{"0 9,17 * * *", 2},
+		{"0 9 * * 1-5", 5},
+		{"0 0 1,15 * 1", 3},
+		{"*/15 * * * *", 4},
+	}
+
+	for _, tt := range tests {
+		intervals, err := launchdIntervals(strings.Fields(tt.expr))
+		if err != nil {
+			t.Errorf("launchdIntervals(%q) returned error: %v", tt.expr, err)
+
+			continue
+		}
+
+		if len(intervals) != tt.expected {
+			t.Errorf("launchdIntervals(%q) = %d intervals, expected %d", tt.expr, len(intervals), tt.expected)
+		}
+	}
+
+	intervals, err := launchdIntervals(strings.Fields("0 0 1 * 1"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Cron runs when either the day or the weekday matches, so neither interval may hold both
+	for _, interval := range intervals {
+		_, hasDay := interval[2]
+		_, hasWeekday := interval[fieldIndexWeekday]
+
+		if hasDay == hasWeekday {
+			t.Errorf("Expected each interval to restrict only the day or the weekday, got %v", interval)
+		}
+	}
+
+	if _, err := launchdIntervals(strings.Fields("* * 1-31 * 1-5")); err != nil {
+		t.Errorf("Expected a full day field to be treated as unrestricted, got %v", err)
+	}
+
+	if _, err := launchdIntervals(strings.Fields("*/2 */2 * * 1-5")); !errors.Is(err, ErrTooManyIntervals) {
+		t.Errorf("Expected ErrTooManyIntervals, got %v", err)
+	}
+}
+
+// TestRenderLaunchd verifies that the plist is well-formed XML with the label,
+// the escaped command, and a single interval dictionary when one suffices.
+func TestRenderLaunchd(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("@daily", "com.example.backup", `backup.sh && echo "<done>"`, defaultExportZone)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	plist, err := renderLaunchd(job)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(plist))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Fatalf("Expected well-formed XML, got %v:\n%s", err, plist)
+			}
+
+			break
+		}
+	}
+
+	for _, want := range []string{
+		"<string>com.example.backup</string>",
+		"&amp;&amp;",
+		"&lt;done&gt;",
+		"<key>StartCalendarInterval</key>\n\t<dict>",
+		"<key>Hour</key>\n\t\t<integer>0</integer>",
+	} {
+		if !strings.Contains(plist, want) {
+			t.Errorf("Expected plist to contain %q:\n%s", want, plist)
+		}
+	}
+
+	job.fields = strings.Fields("0 9 * * 1-5")
+
+	plist, err = renderLaunchd(job)
+	if err != nil || strings.Count(plist, "<key>Weekday</key>") != 5 || !strings.Contains(plist, "\t<array>\n\t\t<dict>") {
+		t.Errorf("Expected an array of five interval dictionaries, got %v:\n%s", err, plist)
+	}
+}
@@ -0,0 +1,528 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrLintFindings is returned when lint finds schedules to fix or that are invalid
+var ErrLintFindings = errors.New("lint found problems") //nolint:gochecknoglobals
+
+//nolint:gochecknoglobals
+var (
+	// A YAML key holding a schedule, e.g. "- cron: ..." in GitHub Actions or
+	// "schedule: ..." in a Kubernetes CronJob
+	yamlScheduleLine = regexp.MustCompile(`^(\s*(?:-\s+)?(?:cron|schedule)\s*:\s*)(\S.*)$`)
+
+	// The schedule of a workspace entry, which saveWorkspace writes one per line
+	jsonScheduleLine = regexp.MustCompile(`^(\s*"schedule"\s*:\s*")([^"\\]*)(".*)$`)
+)
+
+// lintChange is one line lint rewrites
+type lintChange struct {
+	line  int      // Line number, counting from 1
+	old   string   // Line as found
+	fixed string   // Line after the fixes
+	notes []string // What was fixed
+}
+
+// lintProblem is a schedule lint cannot fix
+type lintProblem struct {
+	line int   // Line number, counting from 1
+	err  error // Why the schedule is invalid
+}
+
+// lintFinding is a diagnostic about a schedule in a file, with its span
+// counted in bytes from the start of the line
+type lintFinding struct {
+	Path string `json:"path"` // File the schedule is in
+	Line int    `json:"line"` // Line number, counting from 1
+	diagnostic
+}
+
+// fixExpression applies the fixes that never change when an expression runs:
+// single spaces between fields, upper-case names, Sunday as 0 rather than 7,
+// "*" for steps of one and full ranges, and overlapping list items collapsed
+func fixExpression(expr string) (string, []string, error) {
+	var notes []string
+
+	trimmed := strings.TrimSpace(expr)
+	if _, ok := cronMacros[strings.ToLower(trimmed)]; ok {
+		if lower := strings.ToLower(trimmed); lower != trimmed {
+			notes = append(notes, "macro written in lower case")
+			trimmed = lower
+		}
+
+		return trimmed, notes, nil
+	}
+
+	fields, err := splitRawExpression(trimmed)
+	if err != nil {
+		return expr, nil, err
+	}
+
+	if strings.Join(fields, " ") != trimmed {
+		notes = append(notes, "single spaces between fields")
+	}
+
+	for index, field := range fields {
+		fixed, fieldNotes := fixField(field, index, starAllowed(fields, index))
+		fields[index] = fixed
+		notes = append(notes, fieldNotes...)
+	}
+
+	if err := validateStandardFields(fields); err != nil {
+		return expr, nil, err
+	}
+
+	return strings.Join(fields, " "), notes, nil
+}
+
+// fixField applies the per-field fixes of fixExpression to one field.
+// allowStar reports whether a field selecting every value may become "*".
+func fixField(field string, fieldIndex int, allowStar bool) (string, []string) {
+	var notes []string
+
+	if fieldIndex == fieldIndexMonth || fieldIndex == fieldIndexWeekday {
+		if upper := strings.ToUpper(field); upper != field {
+			notes = append(notes, fieldNames[fieldIndex]+" names in upper case")
+			field = upper
+		}
+	}
+
+	set, err := expandField(field, fieldIndex)
+	if err != nil || field == "*" {
+		return field, notes
+	}
+
+	switch {
+	case set == fullFieldSet(fieldIndex) && allowStar:
+		notes = append(notes, fmt.Sprintf("%s %q selects every value, written as *", fieldNames[fieldIndex], field))
+		field = "*"
+	case fieldIndex == fieldIndexWeekday && strings.ContainsRune(field, '7'):
+		notes = append(notes, "Sunday written as 0 rather than 7")
+		field = set.compactString()
+	default:
+		if overlap, ok := findOverlap(field, fieldIndex); ok {
+			notes = append(notes, "overlapping "+fieldNames[fieldIndex]+" items collapsed")
+			field = overlap.collapsed
+
+			if field == "*" && !allowStar {
+				field = overlap.effective.compactString()
+			}
+		}
+	}
+
+	return field, notes
+}
+
+// lintCrontabLine fixes the schedule of a crontab line, keeping the command
+// as written. Comments, blank lines, variable assignments, and @reboot are
+// left alone.
+func lintCrontabLine(line string) (string, []string, error) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "@reboot") {
+		return line, nil, nil
+	}
+
+	tokens := strings.Fields(trimmed)
+	if isEnvAssignment(tokens[0]) {
+		return line, nil, nil
+	}
+
+	count := numCronFields
+	if strings.HasPrefix(tokens[0], "@") {
+		count = 1
+	}
+
+	if len(tokens) <= count {
+		return line, nil, fmt.Errorf("%w: expected a schedule followed by a command", ErrFieldCount)
+	}
+
+	// Find where the command starts so its own spacing is kept
+	rest := trimmed
+	for range count {
+		rest = strings.TrimLeft(rest, " \t")
+		rest = rest[strings.IndexAny(rest+" ", " \t"):]
+	}
+
+	schedule := strings.Join(tokens[:count], " ")
+	command := strings.TrimSpace(rest)
+
+	var notes []string
+	if schedule+" "+command != line {
+		notes = append(notes, "whitespace cleaned up")
+	}
+
+	fixed, fixNotes, err := fixExpression(schedule)
+	if err != nil {
+		return line, nil, err
+	}
+
+	return fixed + " " + command, append(notes, fixNotes...), nil
+}
+
+// lintYAMLLine fixes a schedule under a cron or schedule key, quoting it so
+// a leading "*" is not read as a YAML alias
+func lintYAMLLine(line string) (string, []string, error) {
+	match := yamlScheduleLine.FindStringSubmatch(line)
+	if match == nil {
+		return line, nil, nil
+	}
+
+	prefix, value := match[1], strings.TrimRight(match[2], " \t")
+	quote, comment := `"`, ""
+
+	var notes []string
+
+	if value[0] == '"' || value[0] == '\'' {
+		closing := strings.IndexByte(value[1:], value[0])
+		if closing < 0 {
+			return line, nil, nil
+		}
+
+		quote, comment = value[:1], value[closing+2:]
+		value = value[1 : closing+1]
+	} else {
+		if before, after, ok := strings.Cut(value, " #"); ok {
+			value, comment = strings.TrimRight(before, " \t"), " #"+after
+		}
+
+		notes = append(notes, "schedule quoted")
+	}
+
+	// Other schedule values, such as "rate(5 minutes)", are not cron expressions
+	if len(strings.Fields(value)) != numCronFields && !strings.HasPrefix(value, "@") {
+		return line, nil, nil
+	}
+
+	fixed, fixNotes, err := fixExpression(value)
+	if err != nil {
+		return line, nil, err
+	}
+
+	return prefix + quote + fixed + quote + comment, append(notes, fixNotes...), nil
+}
+
+// lintJSONLine fixes the schedule of a workspace entry
+func lintJSONLine(line string) (string, []string, error) {
+	match := jsonScheduleLine.FindStringSubmatch(line)
+	if match == nil {
+		return line, nil, nil
+	}
+
+	fixed, notes, err := fixExpression(match[2])
+	if err != nil {
+		return line, nil, err
+	}
+
+	return match[1] + fixed + match[3], notes, nil
+}
+
+// lintLineFunc picks how to read a file's lines from its extension: YAML,
+// a JSON workspace, or a crontab
+func lintLineFunc(path string) func(string) (string, []string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return lintYAMLLine
+	case ".json":
+		return lintJSONLine
+	default:
+		return lintCrontabLine
+	}
+}
+
+// crontabScheduleSpan finds the schedule fields of a crontab line, with
+// whether a command follows them. Lines lintCrontabLine leaves alone have
+// none.
+func crontabScheduleSpan(line string) (diagnosticSpan, bool, bool) {
+	syntax := parseCrontabSyntaxLine(line)
+
+	positions := syntax.indexes(crontabField)
+	if len(positions) == 0 || syntax.tokens[positions[0]].text == "@reboot" {
+		return diagnosticSpan{}, false, false
+	}
+
+	var span diagnosticSpan
+
+	offset := 0
+
+	for index, token := range syntax.tokens {
+		if index == positions[0] {
+			span.Start = offset
+		}
+
+		offset += len(token.text)
+
+		if index == positions[len(positions)-1] {
+			span.End = offset
+		}
+	}
+
+	return span, len(syntax.indexes(crontabCommand)) > 0, true
+}
+
+// yamlScheduleSpan finds the schedule lintYAMLLine reads on a line, inside
+// its quotes and before any comment
+func yamlScheduleSpan(line string) (diagnosticSpan, bool) {
+	match := yamlScheduleLine.FindStringSubmatchIndex(line)
+	if match == nil {
+		return diagnosticSpan{}, false
+	}
+
+	start := match[4]
+	value := strings.TrimRight(line[start:], " \t")
+
+	if value[0] == '"' || value[0] == '\'' {
+		closing := strings.IndexByte(value[1:], value[0])
+		if closing < 0 {
+			return diagnosticSpan{}, false
+		}
+
+		start, value = start+1, value[1:closing+1]
+	} else if before, _, ok := strings.Cut(value, " #"); ok {
+		value = strings.TrimRight(before, " \t")
+	}
+
+	if len(strings.Fields(value)) != numCronFields && !strings.HasPrefix(value, "@") {
+		return diagnosticSpan{}, false
+	}
+
+	return diagnosticSpan{Start: start, End: start + len(value)}, true
+}
+
+// lintLineDiagnostics diagnoses the schedule on a line of a file, with spans
+// counted from the start of the line
+func lintLineDiagnostics(path, line string) []diagnostic {
+	var (
+		span    diagnosticSpan
+		found   bool
+		command = true
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		span, found = yamlScheduleSpan(line)
+	case ".json":
+		if match := jsonScheduleLine.FindStringSubmatchIndex(line); match != nil {
+			span, found = diagnosticSpan{Start: match[4], End: match[5]}, true
+		}
+	default:
+		span, command, found = crontabScheduleSpan(line)
+	}
+
+	if !found {
+		return nil
+	}
+
+	diagnostics := diagnoseExpression(line[span.Start:span.End])
+	for index := range diagnostics {
+		diagnostics[index].Span.Start += span.Start
+		diagnostics[index].Span.End += span.Start
+	}
+
+	if !command && !slices.ContainsFunc(diagnostics, func(d diagnostic) bool { return d.Severity == severityError }) {
+		diagnostics = append(diagnostics, diagnostic{
+			Code:     codeFieldCount,
+			Severity: severityError,
+			Span:     span,
+			Message:  ErrFieldCount.Error() + ": expected a schedule followed by a command",
+		})
+	}
+
+	return diagnostics
+}
+
+// lintFindings diagnoses every schedule in a file's contents
+func lintFindings(path, text string) []lintFinding {
+	var findings []lintFinding
+
+	for index, line := range strings.Split(text, "\n") {
+		for _, found := range lintLineDiagnostics(path, strings.TrimSuffix(line, "\r")) {
+			findings = append(findings, lintFinding{Path: path, Line: index + 1, diagnostic: found})
+		}
+	}
+
+	return findings
+}
+
+// writeLintFindings prints the findings of every file as a JSON list or a
+// SARIF log
+func writeLintFindings(out io.Writer, output string, findings []lintFinding) error {
+	var body any = findings
+	if findings == nil {
+		body = []lintFinding{}
+	}
+
+	if output == "sarif" {
+		body = newSarifLog(findings)
+	}
+
+	data, err := json.MarshalIndent(body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to write the findings: %w", err)
+	}
+
+	fmt.Fprintln(out, string(data))
+
+	return nil
+}
+
+// lintText lints every line of a file's contents
+func lintText(path string, text string) ([]lintChange, []lintProblem) {
+	var (
+		changes  []lintChange
+		problems []lintProblem
+	)
+
+	lintLine := lintLineFunc(path)
+
+	for index, line := range strings.Split(text, "\n") {
+		body, carriageReturn := strings.CutSuffix(line, "\r")
+
+		fixed, notes, err := lintLine(body)
+		if err != nil {
+			problems = append(problems, lintProblem{line: index + 1, err: err})
+
+			continue
+		}
+
+		if fixed == body {
+			continue
+		}
+
+		if carriageReturn {
+			fixed += "\r"
+		}
+
+		changes = append(changes, lintChange{line: index + 1, old: line, fixed: fixed, notes: notes})
+	}
+
+	return changes, problems
+}
+
+// applyLintChanges returns the text with the changed lines replaced
+func applyLintChanges(text string, changes []lintChange) string {
+	lines := strings.Split(text, "\n")
+	for _, change := range changes {
+		lines[change.line-1] = change.fixed
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// writeLintDiff prints the changes to a file as a unified diff with one
+// hunk per line, naming the fixes after the hunk header
+func writeLintDiff(out io.Writer, path string, changes []lintChange) {
+	fmt.Fprintf(out, "--- %s\n+++ %s\n", path, path)
+
+	for _, change := range changes {
+		fmt.Fprintf(out, "@@ -%d +%d @@ %s\n", change.line, change.line, strings.Join(change.notes, ", "))
+		fmt.Fprintf(out, "-%s\n+%s\n", strings.TrimSuffix(change.old, "\r"), strings.TrimSuffix(change.fixed, "\r"))
+	}
+}
+
+// runLint checks the schedules in crontab, YAML, and workspace files, printing
+// a diff of the safe fixes, or with --output json or sarif the diagnostics of
+// every schedule. With --fix the files are rewritten in place.
+func runLint(args []string, stdout, stderr io.Writer) error {
+	var (
+		fix    bool
+		output string
+	)
+
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&fix, "fix", false, "rewrite files with the fixes applied")
+	flags.StringVar(&output, "output", "text", "output format: text, json, or sarif")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) == 0 || !slices.Contains([]string{"text", "json", "sarif"}, output) {
+		return fmt.Errorf("%w: crontab-guru lint [--fix] [--output text|json|sarif] FILE...", ErrUsage)
+	}
+
+	pending, invalid, duplicated := 0, 0, 0
+
+	var findings []lintFinding
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		data, err := os.ReadFile(path) //nolint:gosec // Linting files the user names is the point
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		changes, problems := lintText(path, string(data))
+
+		if output == "text" {
+			for _, problem := range problems {
+				fmt.Fprintf(stderr, "%s:%d: %v\n", path, problem.line, problem.err)
+			}
+		} else {
+			findings = append(findings, lintFindings(path, string(data))...)
+		}
+
+		invalid += len(problems)
+
+		messages, repeated := lintDuplicates(path, string(data))
+		for _, message := range messages {
+			fmt.Fprintf(stderr, "%s: %s\n", path, message)
+		}
+
+		duplicated += repeated
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		if output == "text" {
+			writeLintDiff(stdout, path, changes)
+		}
+
+		if !fix {
+			pending += len(changes)
+
+			continue
+		}
+
+		if err := os.WriteFile(path, []byte(applyLintChanges(string(data), changes)), info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	if output != "text" {
+		if err := writeLintFindings(stdout, output, findings); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case duplicated > 0:
+		return fmt.Errorf("%w: %d fixable, %d invalid, %d duplicated", ErrLintFindings, pending, invalid, duplicated)
+	case pending > 0 || invalid > 0:
+		return fmt.Errorf("%w: %d fixable, %d invalid", ErrLintFindings, pending, invalid)
+	}
+
+	return nil
+}
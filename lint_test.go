@@ -0,0 +1,249 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestFixExpression verifies each safe fix and that day fields keep full
+// ranges when turning them into "*" would change the schedule.
+func TestFixExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"0  9 *\t* *", "0 9 * * *"},
+		{"0 9 * jan mon-fri", "0 9 * JAN MON-FRI"},
+		{"0 9 * * 7", "0 9 * * 0"},
+		{"0 9 * * 5-7", "0 9 * * 0,5,6"},
+		{"*/1 0-23 * * *", "* * * * *"},
+		{"0 9 * * 1-7", "0 9 * * *"},
+		{"0 9 1-31 * MON", "0 9 1-31 * MON"}, // "*" would drop every day but Monday
+		{"0 9 1 * 0-6", "0 9 1 * 0-6"},
+		{"1-10,5 * * * *", "1-10 * * * *"},
+		{"@DAILY", "@daily"},
+		{"0 9 * * *", "0 9 * * *"},
+	}
+
+	for _, tt := range tests {
+		got, _, err := fixExpression(tt.expr)
+		if err != nil {
+			t.Errorf("fixExpression(%q) unexpected error: %v", tt.expr, err)
+
+			continue
+		}
+
+		if got != tt.expected {
+			t.Errorf("fixExpression(%q) = %q, expected %q", tt.expr, got, tt.expected)
+		}
+	}
+
+	if _, _, err := fixExpression("61 * * * *"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Expected ErrInvalidValue for an invalid minute, got %v", err)
+	}
+}
+
+// TestLintCrontabLine verifies that the command keeps its spacing and that
+// comments, variables, and @reboot are skipped.
+func TestLintCrontabLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{"*/5  * * * 7   run.sh  --flag   x  ", "*/5 * * * 0 run.sh  --flag   x"},
+		{"@DAILY\tbackup.sh", "@daily backup.sh"},
+		{"# 0  9 * * *", "# 0  9 * * *"},
+		{"MAILTO=ops@example.com", "MAILTO=ops@example.com"},
+		{"@reboot  start.sh", "@reboot  start.sh"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		got, _, err := lintCrontabLine(tt.line)
+		if err != nil {
+			t.Errorf("lintCrontabLine(%q) unexpected error: %v", tt.line, err)
+
+			continue
+		}
+
+		if got != tt.expected {
+			t.Errorf("lintCrontabLine(%q) = %q, expected %q", tt.line, got, tt.expected)
+		}
+	}
+
+	if _, _, err := lintCrontabLine("0 9 * * *"); !errors.Is(err, ErrFieldCount) {
+		t.Errorf("Expected ErrFieldCount for a line without a command, got %v", err)
+	}
+}
+
+// TestLintYAMLLine verifies quoting of bare schedules, kept quote styles and
+// comments, and that non-cron schedule values are left alone.
+func TestLintYAMLLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{"    - cron: */15 * * * * # quarter", `    - cron: "*/15 * * * *" # quarter`},
+		{"    - cron: '0 0 * * 7'", "    - cron: '0 0 * * 0'"},
+		{`  schedule: "0  3 * * *"`, `  schedule: "0 3 * * *"`},
+		{"  schedule: rate(5 minutes)", "  schedule: rate(5 minutes)"},
+		{"  name: 0 3 * * 7", "  name: 0 3 * * 7"},
+	}
+
+	for _, tt := range tests {
+		got, _, err := lintYAMLLine(tt.line)
+		if err != nil {
+			t.Errorf("lintYAMLLine(%q) unexpected error: %v", tt.line, err)
+
+			continue
+		}
+
+		if got != tt.expected {
+			t.Errorf("lintYAMLLine(%q) = %q, expected %q", tt.line, got, tt.expected)
+		}
+	}
+}
+
+// TestRunLint verifies that lint prints a diff without touching the file,
+// --fix rewrites it in place keeping CRLF line endings, and a clean file passes.
+func TestRunLint(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	original := "MAILTO=ops\r\n0  9 * * 7 report.sh\r\n"
+
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	if err := runCommand([]string{"lint", path}, &stdout, &stderr); !errors.Is(err, ErrLintFindings) {
+		t.Errorf("Expected ErrLintFindings, got %v", err)
+	}
+
+	for _, want := range []string{"@@ -2 +2 @@", "-0  9 * * 7 report.sh\n", "+0 9 * * 0 report.sh\n"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Expected %q in the diff:\n%s", want, stdout.String())
+		}
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != original {
+		t.Errorf("Expected lint without --fix to leave the file alone, got %q", data)
+	}
+
+	if err := runCommand([]string{"lint", "--fix", path}, &stdout, &stderr); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "MAILTO=ops\r\n0 9 * * 0 report.sh\r\n" {
+		t.Errorf("Expected the fixed file, got %q", data)
+	}
+
+	stdout.Reset()
+
+	if err := runCommand([]string{"lint", path}, &stdout, &stderr); err != nil || stdout.Len() != 0 {
+		t.Errorf("Expected a clean file to pass silently, got %v and %q", err, stdout.String())
+	}
+
+	if err := runCommand([]string{"lint"}, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage without files, got %v", err)
+	}
+}
+
+// TestRunLintOutput verifies the diagnostics lint prints as JSON and SARIF,
+// with spans and columns counted from the start of each line.
+func TestRunLintOutput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	crontab, workflow := filepath.Join(dir, "crontab"), filepath.Join(dir, "ci.yml")
+
+	if err := os.WriteFile(crontab, []byte("# jobs\n0 0 1 * MON report.sh\n0 9 * * *\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(workflow, []byte("on:\n  schedule:\n    - cron: '*/1 9 * * *'\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	if err := runCommand([]string{"lint", "--output", "json", crontab, workflow}, &stdout, &stderr); !errors.Is(err, ErrLintFindings) {
+		t.Errorf("Expected ErrLintFindings, got %v", err)
+	}
+
+	var findings []lintFinding
+	if err := json.Unmarshal(stdout.Bytes(), &findings); err != nil {
+		t.Fatalf("Expected JSON, got %v:\n%s", err, stdout.String())
+	}
+
+	expected := []struct {
+		path string
+		line int
+		code string
+		span diagnosticSpan
+	}{
+		{crontab, 2, codeDayOrWeekday, diagnosticSpan{Start: 4, End: 11}},
+		{crontab, 3, codeFieldCount, diagnosticSpan{Start: 0, End: 9}},
+		{workflow, 3, codeSimplify, diagnosticSpan{Start: 13, End: 16}},
+	}
+
+	if len(findings) != len(expected) {
+		t.Fatalf("Expected %d findings, got %+v", len(expected), findings)
+	}
+
+	for index, want := range expected {
+		got := findings[index]
+		if got.Path != want.path || got.Line != want.line || got.Code != want.code || got.Span != want.span {
+			t.Errorf("Finding %d = %+v, expected %+v", index, got, want)
+		}
+	}
+
+	stdout.Reset()
+
+	if err := runCommand([]string{"lint", "--output", "sarif", workflow}, &stdout, &stderr); !errors.Is(err, ErrLintFindings) {
+		t.Errorf("Expected ErrLintFindings, got %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(stdout.Bytes(), &log); err != nil || len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 {
+		t.Fatalf("Expected one SARIF result, got %v:\n%s", err, stdout.String())
+	}
+
+	result := log.Runs[0].Results[0]
+	if result.RuleID != codeSimplify || result.Level != "note" ||
+		result.Locations[0].PhysicalLocation.Region != (sarifRegion{StartLine: 3, StartColumn: 14, EndColumn: 17}) {
+		t.Errorf("Unexpected SARIF result %+v", result)
+	}
+
+	if err := runCommand([]string{"lint", "--output", "xml", crontab}, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage for an unknown output, got %v", err)
+	}
+}
+
+// TestLintWorkspace verifies that workspace schedules are fixed in place.
+func TestLintWorkspace(t *testing.T) {
+	t.Parallel()
+
+	changes, problems := lintText("workspace.json", "{\n  \"schedule\": \"0 9 * * mon\",\n  \"name\": \"a\"\n}")
+	if len(problems) != 0 || len(changes) != 1 || changes[0].fixed != `  "schedule": "0 9 * * MON",` {
+		t.Errorf("Unexpected lint result: %+v %+v", changes, problems)
+	}
+}
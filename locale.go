@@ -0,0 +1,232 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// nameLocale holds the month and weekday abbreviations of a language, in the
+// order of monthNames and weekdayNames
+type nameLocale struct {
+	Months   []string `json:"months"`   // Month abbreviations, January first
+	Weekdays []string `json:"weekdays"` // Weekday abbreviations, Sunday first
+	code     string   // Language code, "" for English
+}
+
+// localeSet maps language codes to their month and weekday names
+type localeSet map[string]nameLocale
+
+// nameLocales are the built-in languages whose month and weekday names the
+// fields accept and turn into the English ones cron reads. The dictionaries
+// setting of the config file adds others.
+//
+//nolint:gochecknoglobals
+var nameLocales = localeSet{
+	"de": {
+		Months:   []string{"JAN", "FEB", "MRZ", "APR", "MAI", "JUN", "JUL", "AUG", "SEP", "OKT", "NOV", "DEZ"},
+		Weekdays: []string{"SO", "MO", "DI", "MI", "DO", "FR", "SA"},
+	},
+	"es": {
+		Months:   []string{"ENE", "FEB", "MAR", "ABR", "MAY", "JUN", "JUL", "AGO", "SEP", "OCT", "NOV", "DIC"},
+		Weekdays: []string{"DOM", "LUN", "MAR", "MIE", "JUE", "VIE", "SAB"},
+	},
+	"fr": {
+		Months:   []string{"JAN", "FEV", "MAR", "AVR", "MAI", "JUIN", "JUIL", "AOU", "SEP", "OCT", "NOV", "DEC"},
+		Weekdays: []string{"DIM", "LUN", "MAR", "MER", "JEU", "VEN", "SAM"},
+	},
+	"it": {
+		Months:   []string{"GEN", "FEB", "MAR", "APR", "MAG", "GIU", "LUG", "AGO", "SET", "OTT", "NOV", "DIC"},
+		Weekdays: []string{"DOM", "LUN", "MAR", "MER", "GIO", "VEN", "SAB"},
+	},
+	"pt": {
+		Months:   []string{"JAN", "FEV", "MAR", "ABR", "MAI", "JUN", "JUL", "AGO", "SET", "OUT", "NOV", "DEZ"},
+		Weekdays: []string{"DOM", "SEG", "TER", "QUA", "QUI", "SEX", "SAB"},
+	},
+}
+
+// parseLocale looks up the names of a language by code among the built-in
+// languages and the extra ones; empty and "en" select English alone
+func parseLocale(name string, extra localeSet) (nameLocale, error) {
+	code := strings.ToLower(name)
+	if code == "" || code == "en" {
+		return nameLocale{}, nil
+	}
+
+	names, ok := extra[code]
+	if !ok {
+		names, ok = nameLocales[code]
+	}
+
+	if ok {
+		names.code = code
+
+		return names, nil
+	}
+
+	codes := slices.Collect(maps.Keys(nameLocales))
+	for extraCode := range extra {
+		if !slices.Contains(codes, extraCode) {
+			codes = append(codes, extraCode)
+		}
+	}
+
+	slices.Sort(codes)
+
+	return nameLocale{}, fmt.Errorf("%w: locale %q (expected one of en, %s)", ErrInvalidConfig, name, strings.Join(codes, ", "))
+}
+
+// validateDictionaries checks the languages added in the config: each needs
+// every month and weekday, in letters, without using an English name for a
+// different month or weekday. Names are stored in upper case.
+func validateDictionaries(dictionaries localeSet) (localeSet, error) {
+	checked := make(localeSet, len(dictionaries))
+
+	for code, names := range dictionaries {
+		var upper nameLocale
+
+		for _, field := range []int{fieldIndexMonth, fieldIndexWeekday} {
+			english := fieldNameList(field)
+
+			aliases := names.list(field)
+			if len(aliases) != len(english) {
+				return nil, fmt.Errorf("%w: dictionary %q: expected %d %s names, got %d",
+					ErrInvalidConfig, code, len(english), fieldNames[field], len(aliases))
+			}
+
+			converted := make([]string, 0, len(aliases))
+
+			for position, alias := range aliases {
+				alias = strings.ToUpper(alias)
+				if alias == "" || !isLetters(alias) {
+					return nil, fmt.Errorf("%w: dictionary %q: %s name %q is not only letters", ErrInvalidConfig, code, fieldNames[field], alias)
+				}
+
+				if index := slices.Index(english, alias); index >= 0 && index != position {
+					return nil, fmt.Errorf("%w: dictionary %q: %s is the English name of another %s", ErrInvalidConfig, code, alias, fieldNames[field])
+				}
+
+				if slices.Contains(converted, alias) {
+					return nil, fmt.Errorf("%w: dictionary %q: %s names two of the %s values", ErrInvalidConfig, code, alias, fieldNames[field])
+				}
+
+				converted = append(converted, alias)
+			}
+
+			if field == fieldIndexMonth {
+				upper.Months = converted
+			} else {
+				upper.Weekdays = converted
+			}
+		}
+
+		checked[strings.ToLower(code)] = upper
+	}
+
+	return checked, nil
+}
+
+// list returns a language's names for a field, in value order, or nil for
+// fields without names
+func (names nameLocale) list(fieldIndex int) []string {
+	switch fieldIndex {
+	case fieldIndexMonth:
+		return names.Months
+	case fieldIndexWeekday:
+		return names.Weekdays
+	default:
+		return nil
+	}
+}
+
+// localizeField replaces the names of a language in a field value with the
+// English ones, e.g. "SEG-SEX" with "MON-FRI". While the field is being
+// typed in, a name at the end that could still grow into another, like "FR"
+// into "FRI", is left until the field is done.
+func localizeField(value string, fieldIndex int, locale nameLocale, done bool) string {
+	aliases := locale.list(fieldIndex)
+	if aliases == nil {
+		return value
+	}
+
+	english := fieldNameList(fieldIndex)
+
+	var builder strings.Builder
+
+	for value != "" {
+		end := strings.IndexAny(value, ",-/")
+		if end < 0 {
+			end = len(value)
+		}
+
+		token := value[:end]
+		last := end == len(value)
+
+		if position := slices.Index(aliases, strings.ToUpper(token)); position >= 0 && (done || !last || !growsInto(token, english, aliases)) {
+			token = english[position]
+		}
+
+		builder.WriteString(token)
+
+		if !last {
+			builder.WriteByte(value[end])
+			end++
+		}
+
+		value = value[end:]
+	}
+
+	return builder.String()
+}
+
+// growsInto reports whether a longer name in either list starts with token
+func growsInto(token string, lists ...[]string) bool {
+	upper := strings.ToUpper(token)
+
+	for _, list := range lists {
+		for _, name := range list {
+			if len(name) > len(upper) && strings.HasPrefix(name, upper) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// localizeFields turns the names of the selected language typed into the
+// fields into English, the focused field only as far as it is unambiguous
+func (m *model) localizeFields() tea.Cmd {
+	if m.locale.Months == nil || m.rawMode {
+		return nil
+	}
+
+	changed := false
+
+	for index := range m.inputs {
+		value := m.inputs[index].Value()
+
+		localized := localizeField(value, index, m.locale, index != m.focusIndex)
+		if localized != value {
+			m.inputs[index].SetValue(localized)
+			m.inputs[index].CursorEnd()
+
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
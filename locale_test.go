@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestLocalizeField verifies that names of the selected language become the
+// English ones, and that a name still being typed is left while it could grow
+// into another.
+func TestLocalizeField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		field    int
+		locale   string
+		done     bool
+		expected string
+	}{
+		{"seg-sex", fieldIndexWeekday, "pt", false, "MON-FRI"},
+		{"SEG,", fieldIndexWeekday, "pt", false, "MON,"},
+		{"1,SET", fieldIndexMonth, "pt", true, "1,SEP"},
+		{"LUN-VIE/2", fieldIndexWeekday, "es", false, "MON-FRI/2"},
+		{"JUIN-AOU", fieldIndexMonth, "fr", false, "JUN-AUG"},
+		{"MO-FR", fieldIndexWeekday, "de", false, "MON-FR"},
+		{"MO-FR", fieldIndexWeekday, "de", true, "MON-FRI"},
+		{"SEG", fieldIndexWeekday, "", true, "SEG"},
+		{"SEG", fieldIndexHour, "pt", true, "SEG"},
+	}
+
+	for _, test := range tests {
+		if localized := localizeField(test.value, test.field, nameLocales[test.locale], test.done); localized != test.expected {
+			t.Errorf("localizeField(%q, %d, %q, %t) = %q, expected %q", test.value, test.field, test.locale, test.done, localized, test.expected)
+		}
+	}
+}
+
+// TestNameLocales verifies that every built-in language names each month and
+// weekday and never uses an English name for a different one.
+func TestNameLocales(t *testing.T) {
+	t.Parallel()
+
+	if _, err := validateDictionaries(nameLocales); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+// TestDictionaries verifies that languages added in the config can be
+// selected and that incomplete or conflicting ones are rejected.
+func TestDictionaries(t *testing.T) {
+	t.Parallel()
+
+	dutch := `{"months": ["jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"],
+		"weekdays": ["zo", "ma", "di", "wo", "do", "vr", "za"]}`
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"locale": "nl", "dictionaries": {"nl": `+dutch+`}}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if localized := localizeField("ma-vr", fieldIndexWeekday, opts.locale, true); localized != "MON-FRI" {
+		t.Errorf("Expected the Dutch names turned into MON-FRI, got %q", localized)
+	}
+
+	tests := []localeSet{
+		{"xx": {Months: monthNames, Weekdays: weekdayNames[1:]}},
+		{"xx": {Months: monthNames, Weekdays: []string{"MON", "SUN", "TUE", "WED", "THU", "FRI", "SAT"}}},
+		{"xx": {Months: monthNames, Weekdays: []string{"A", "A", "B", "C", "D", "E", "F"}}},
+		{"xx": {Months: monthNames, Weekdays: []string{"S1", "M", "T", "W", "TH", "F", "SA"}}},
+	}
+
+	for _, dictionaries := range tests {
+		if _, err := validateDictionaries(dictionaries); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("validateDictionaries(%v) expected ErrInvalidConfig, got %v", dictionaries, err)
+		}
+	}
+}
+
+// TestLocaleTyping verifies that Portuguese weekday names typed into the
+// editor are stored as the English ones.
+func TestLocaleTyping(t *testing.T) {
+	t.Parallel()
+
+	opts, err := parseOptions([]string{"--config", filepath.Join(t.TempDir(), "missing.json"), "--locale", "PT"})
+	if err != nil || !slices.Equal(opts.locale.Weekdays, nameLocales["pt"].Weekdays) {
+		t.Fatalf("Expected the pt locale, got %v, %v", opts.locale, err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+	m.setFocus(fieldIndexWeekday)
+	m.inputs[fieldIndexWeekday].SetValue("")
+
+	for _, char := range "seg-sex" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{char}})
+	}
+
+	if expr := m.buildCronExpression(); expr != "20 4 * * MON-FRI" || m.validateCronParts() != nil {
+		t.Errorf("Expected MON-FRI stored, got %q", expr)
+	}
+
+	if _, err := parseOptions([]string{"--config", writeConfig(t, `{"locale": "tlh"}`)}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected an unknown locale rejected, got %v", err)
+	}
+}
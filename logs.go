@@ -0,0 +1,390 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultLogSince   = 24 * time.Hour             // How far back logged runs are compared by default
+	logRunTolerance   = time.Minute                // How long after its scheduled minute a run still counts
+	maxLogRunsListed  = 10                         // Most missed or unexpected runs listed per job
+	maxLogLineLength  = 1024 * 1024                // Longest log line read
+	journalTimeLayout = "2006-01-02 15:04:05"      // Layout journalctl --since accepts
+	syslogTimeLayout  = "Jan _2 15:04:05"          // Layout of traditional syslog timestamps, without a year
+	shortISOLayout    = "2006-01-02T15:04:05-0700" // Layout of journalctl --output short-iso timestamps
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrRunMismatch is returned when logged runs differ from the schedule
+	ErrRunMismatch = errors.New("logged runs differ from the schedule")
+
+	// ErrNoCronLog is returned when a log has no cron runs to compare
+	ErrNoCronLog = errors.New("no cron runs logged")
+
+	// Log files read when neither --log nor --journal is given, in order
+	defaultCronLogs = []string{"/var/log/syslog", "/var/log/cron"}
+
+	// A run logged by Vixie cron or cronie, e.g.
+	// "Oct 15 02:00:01 host CRON[1234]: (root) CMD (backup.sh)"
+	cronLogPattern = regexp.MustCompile(
+		`^([A-Z][a-z]{2} +\d{1,2} \d\d:\d\d:\d\d|\d{4}-\d\d-\d\dT\S+) \S+ (?:CRON|CROND|crond|cron)\[\d+\]: \(([^)]*)\) CMD \((.*)\)$`)
+)
+
+// cronLogRecord is a run found in the cron log
+type cronLogRecord struct {
+	at      time.Time // When cron started the command
+	user    string    // Account the command ran as
+	command string    // Command as cron logged it
+}
+
+// runCorrelation compares the runs of one command with its schedule
+type runCorrelation struct {
+	command    string      // Command as written in the crontab
+	exprs      []string    // Schedules that run the command
+	ran        int         // Scheduled runs that were logged
+	missed     []time.Time // Scheduled runs with no run logged
+	unexpected []time.Time // Logged runs with no run scheduled
+}
+
+// parseLogTime reads a log timestamp. Syslog timestamps have no year or zone,
+// so they are read in location and in the year that puts them before now.
+func parseLogTime(stamp string, location *time.Location, now time.Time) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, shortISOLayout} {
+		if at, err := time.Parse(layout, stamp); err == nil {
+			return at, true
+		}
+	}
+
+	at, err := time.ParseInLocation(syslogTimeLayout, stamp, location)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	at = at.AddDate(now.In(location).Year(), 0, 0)
+	if at.After(now.Add(24 * time.Hour)) {
+		at = at.AddDate(-1, 0, 0)
+	}
+
+	return at, true
+}
+
+// parseCronLog reads the runs of a syslog file, /var/log/cron, or journalctl
+// output, skipping every other line. Runs logged for other users are kept so
+// that the caller can filter them.
+func parseCronLog(reader io.Reader, location *time.Location, now time.Time) ([]cronLogRecord, error) {
+	var records []cronLogRecord
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, maxLogLineLength)
+
+	for scanner.Scan() {
+		match := cronLogPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil {
+			continue
+		}
+
+		at, ok := parseLogTime(match[1], location, now)
+		if !ok {
+			continue
+		}
+
+		records = append(records, cronLogRecord{at: at, user: match[2], command: strings.TrimSpace(match[3])})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the log: %w", err)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool { return records[i].at.Before(records[j].at) })
+
+	return records, nil
+}
+
+// crontabCommands pairs each job of a crontab with its full command, which
+// the job's name shortens
+func crontabCommands(text string, jobs []clashJob) []string {
+	lines := strings.Split(text, "\n")
+	commands := make([]string, 0, len(jobs))
+
+	for _, job := range jobs {
+		line, err := parseCrontabLine(lines[job.source])
+		if err != nil || line.command == "" {
+			commands = append(commands, job.name)
+		} else {
+			commands = append(commands, strings.TrimSpace(line.command))
+		}
+	}
+
+	return commands
+}
+
+// correlateRuns compares the runs logged between start and end with the
+// ones the jobs schedule. A logged run matches a scheduled one when it starts
+// within logRunTolerance of it. Runs of commands that are not in the crontab
+// are counted by command.
+func correlateRuns(jobs []clashJob, commands []string, records []cronLogRecord,
+	start, end time.Time,
+) ([]runCorrelation, map[string]int, error) {
+	var correlations []runCorrelation
+
+	scheduled := make(map[string][]time.Time)
+	position := make(map[string]int)
+
+	for index, job := range jobs {
+		schedule, err := standardParser.Parse(job.expr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s: %w", ErrCronParse, job.name, err)
+		}
+
+		command := commands[index]
+		if _, ok := position[command]; !ok {
+			position[command] = len(correlations)
+			correlations = append(correlations, runCorrelation{command: command})
+		}
+
+		correlation := &correlations[position[command]]
+		correlation.exprs = append(correlation.exprs, job.expr)
+
+		for next := range occurrences(context.Background(), schedule, start.Add(-time.Second)) {
+			if !next.Before(end) {
+				break
+			}
+
+			scheduled[command] = append(scheduled[command], next)
+		}
+	}
+
+	logged := make(map[string][]time.Time)
+	others := make(map[string]int)
+
+	for _, record := range records {
+		if record.at.Before(start) || !record.at.Before(end) {
+			continue
+		}
+
+		if _, ok := position[record.command]; ok {
+			logged[record.command] = append(logged[record.command], record.at)
+		} else {
+			others[record.command]++
+		}
+	}
+
+	for index := range correlations {
+		correlation := &correlations[index]
+
+		runs := scheduled[correlation.command]
+		slices.SortFunc(runs, func(a, b time.Time) int { return a.Compare(b) })
+		runs = slices.CompactFunc(runs, time.Time.Equal)
+
+		actual := logged[correlation.command]
+
+		for len(runs) > 0 || len(actual) > 0 {
+			switch {
+			case len(runs) == 0 || (len(actual) > 0 && actual[0].Before(runs[0])):
+				correlation.unexpected = append(correlation.unexpected, actual[0])
+				actual = actual[1:]
+			case len(actual) > 0 && actual[0].Before(runs[0].Add(logRunTolerance)):
+				runs, actual = runs[1:], actual[1:]
+				correlation.ran++
+			default:
+				// A run this close to the end may not be logged yet
+				if !runs[0].Add(logRunTolerance).After(end) {
+					correlation.missed = append(correlation.missed, runs[0])
+				}
+
+				runs = runs[1:]
+			}
+		}
+	}
+
+	return correlations, others, nil
+}
+
+// renderCorrelation lists a command's schedules, how many of its runs were
+// logged, and the runs that were missed or not scheduled
+func renderCorrelation(correlation runCorrelation, location *time.Location) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s  %s\n", strings.Join(correlation.exprs, ", "), correlation.command)
+	fmt.Fprintf(&builder, "  %d of %d scheduled runs logged\n", correlation.ran, correlation.ran+len(correlation.missed))
+
+	for _, list := range []struct {
+		label string
+		runs  []time.Time
+	}{
+		{"missed    ", correlation.missed},
+		{"unexpected", correlation.unexpected},
+	} {
+		for index, at := range list.runs {
+			if index == maxLogRunsListed {
+				fmt.Fprintf(&builder, "  %s  ... and %d more\n", list.label, len(list.runs)-index)
+
+				break
+			}
+
+			fmt.Fprintf(&builder, "  %s  %s\n", list.label, at.In(location).Format(clashTimeLayout))
+		}
+	}
+
+	return builder.String()
+}
+
+// readCronLog reads the runs from the named log file, from journald, or from
+// the first of the usual log files that can be read, falling back to journald
+func readCronLog(path string, journal bool, since time.Time, location *time.Location, now time.Time,
+	run commandRunner,
+) ([]cronLogRecord, string, error) {
+	paths := defaultCronLogs
+	if path != "" {
+		paths = []string{path}
+	}
+
+	if !journal {
+		for _, candidate := range paths {
+			file, err := os.Open(candidate) //nolint:gosec // Reading the log the user names is the point
+			if err != nil {
+				if path != "" {
+					return nil, "", fmt.Errorf("failed to read %s: %w", path, err)
+				}
+
+				continue
+			}
+
+			records, err := parseCronLog(file, location, now)
+			file.Close() //nolint:errcheck,gosec // Read-only file
+
+			return records, candidate, err
+		}
+	}
+
+	out, err := run([]string{
+		"journalctl", "--no-pager", "--output", "short-iso", "-t", "CRON", "-t", "CROND",
+		"-t", "crond", "--since", since.In(time.Local).Format(journalTimeLayout),
+	}, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	records, err := parseCronLog(strings.NewReader(out), location, now)
+
+	return records, "the journal", err
+}
+
+// runLogs compares the runs cron logged with the ones a crontab schedules,
+// listing the runs that were missed or that no schedule explains
+func runLogs(args []string, stdout, stderr io.Writer) error {
+	var (
+		logPath, user, timezone string
+		journal                 bool
+		since                   time.Duration
+	)
+
+	flags := flag.NewFlagSet("logs", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&logPath, "log", "", "log file to read instead of /var/log/syslog or /var/log/cron")
+	flags.BoolVar(&journal, "journal", false, "read the runs from journald")
+	flags.DurationVar(&since, "since", defaultLogSince, "how far back to compare runs")
+	flags.StringVar(&user, "user", "", "only compare runs logged for this user")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the crontab and log are read in")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) != 1 || (logPath != "" && journal) || since <= 0 {
+		return fmt.Errorf("%w: crontab-guru logs [--log FILE | --journal] [--since 24h] [--user USER] CRONTAB", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	text, err := readCrontab(paths[0])
+	if err != nil {
+		return err
+	}
+
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", paths[0], err)
+	}
+
+	now := time.Now().In(location)
+	start := now.Add(-since)
+
+	records, source, err := readCronLog(logPath, journal, start, location, now, execRunner)
+	if err != nil {
+		return err
+	}
+
+	if user != "" {
+		records = slices.DeleteFunc(records, func(record cronLogRecord) bool { return record.user != user })
+	}
+
+	if len(records) == 0 {
+		return fmt.Errorf("%w in %s", ErrNoCronLog, source)
+	}
+
+	// Runs before the log begins cannot be told apart from missed ones
+	if first := records[0].at.Truncate(time.Minute); first.After(start) {
+		start = first
+	}
+
+	correlations, others, err := correlateRuns(jobs, crontabCommands(text, jobs), records, start, now)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "runs from %s to %s in %s\n", start.In(location).Format(clashTimeLayout),
+		now.Format(clashTimeLayout), source)
+
+	missed, unexpected := 0, 0
+
+	for _, correlation := range correlations {
+		fmt.Fprintln(stdout)
+		fmt.Fprint(stdout, renderCorrelation(correlation, location))
+
+		missed += len(correlation.missed)
+		unexpected += len(correlation.unexpected)
+	}
+
+	if len(others) > 0 {
+		commands := make([]string, 0, len(others))
+		for command := range others {
+			commands = append(commands, command)
+		}
+
+		slices.Sort(commands)
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "not in the crontab")
+
+		for _, command := range commands {
+			fmt.Fprintf(stdout, "  %d runs  %s\n", others[command], command)
+		}
+	}
+
+	if missed > 0 || unexpected > 0 {
+		return fmt.Errorf("%w: %d missed, %d unexpected", ErrRunMismatch, missed, unexpected)
+	}
+
+	return nil
+}
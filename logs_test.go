@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestParseCronLog verifies that runs are read from syslog, RFC 3339, and
+// journalctl timestamps, that other lines are skipped, and that syslog
+// timestamps from late last year are not placed in the future.
+func TestParseCronLog(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 2, 12, 0, 0, 0, time.UTC)
+	log := `Dec 31 23:59:01 web CRON[101]: (root) CMD (/usr/local/bin/backup.sh --full)
+Jan  2 02:00:01 web CRON[102]: (www-data) CMD (php artisan schedule:run)
+Jan  2 02:00:01 web CRON[102]: (CRON) info (No MTA installed, discarding output)
+Jan  2 02:00:05 web sshd[300]: Accepted publickey for deploy
+2026-01-02T03:00:01.123456+00:00 web CROND[103]: (root) CMD (run-parts /etc/cron.hourly)
+2026-01-02T04:00:01+0100 web crond[104]: (root) CMD (logrotate.sh)
+`
+
+	records, err := parseCronLog(strings.NewReader(log), time.UTC, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []cronLogRecord{
+		{at: time.Date(2025, time.December, 31, 23, 59, 1, 0, time.UTC), user: "root", command: "/usr/local/bin/backup.sh --full"},
+		{at: time.Date(2026, time.January, 2, 2, 0, 1, 0, time.UTC), user: "www-data", command: "php artisan schedule:run"},
+		{at: time.Date(2026, time.January, 2, 3, 0, 1, 0, time.UTC), user: "root", command: "logrotate.sh"},
+		{at: time.Date(2026, time.January, 2, 3, 0, 1, 123456000, time.UTC), user: "root", command: "run-parts /etc/cron.hourly"},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("Expected %d runs, got %+v", len(expected), records)
+	}
+
+	for index, record := range records {
+		if !record.at.Equal(expected[index].at) || record.user != expected[index].user || record.command != expected[index].command {
+			t.Errorf("Run %d = %+v, expected %+v", index, record, expected[index])
+		}
+	}
+}
+
+// TestCorrelateRuns verifies that logged runs are matched to scheduled ones
+// within a minute, that the rest are reported as missed or unexpected, that
+// runs too recent to be logged are not missed, and that commands not in the
+// crontab are counted.
+func TestCorrelateRuns(t *testing.T) {
+	t.Parallel()
+
+	text := "0 * * * * /usr/local/bin/backup.sh  --full\n30 2 * * * /usr/local/bin/backup.sh  --full\n*/15 * * * * ping.sh\n"
+
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	commands := crontabCommands(text, jobs)
+	if commands[0] != "/usr/local/bin/backup.sh  --full" || commands[2] != "ping.sh" {
+		t.Fatalf("Unexpected commands %q", commands)
+	}
+
+	at := func(hour, minute, second int) time.Time {
+		return time.Date(2026, time.March, 4, hour, minute, second, 0, time.UTC)
+	}
+
+	var records []cronLogRecord
+
+	for _, run := range []time.Time{at(1, 0, 1), at(2, 0, 2), at(2, 30, 1), at(2, 47, 0)} {
+		records = append(records, cronLogRecord{at: run, command: "/usr/local/bin/backup.sh  --full"})
+	}
+
+	for minute := 0; minute < 120; minute += 15 {
+		records = append(records, cronLogRecord{at: at(1, minute, 0), command: "ping.sh"})
+	}
+
+	records = append(records, cronLogRecord{at: at(1, 5, 0), command: "old.sh"}, cronLogRecord{at: at(0, 5, 0), command: "early.sh"})
+
+	correlations, others, err := correlateRuns(jobs, commands, records, at(1, 0, 0), at(3, 0, 30))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(correlations) != 2 {
+		t.Fatalf("Expected a correlation per command, got %+v", correlations)
+	}
+
+	backup, ping := correlations[0], correlations[1]
+
+	if len(backup.exprs) != 2 || backup.ran != 3 || len(backup.missed) != 0 ||
+		len(backup.unexpected) != 1 || !backup.unexpected[0].Equal(at(2, 47, 0)) {
+		t.Errorf("Unexpected backup correlation %+v", backup)
+	}
+
+	if ping.ran != 8 || len(ping.missed) != 0 || len(ping.unexpected) != 0 {
+		t.Errorf("Unexpected ping correlation %+v", ping)
+	}
+
+	if len(others) != 1 || others["old.sh"] != 1 {
+		t.Errorf("Expected one run of old.sh outside the crontab, got %v", others)
+	}
+
+	// Without the 3:00 run, 3:00 is too recent to be missed until a minute later
+	if correlations, _, _ = correlateRuns(jobs, commands, records, at(1, 0, 0), at(3, 1, 0)); len(correlations[0].missed) != 1 {
+		t.Errorf("Expected the 03:00 backup to be missed, got %+v", correlations[0])
+	}
+
+	output := renderCorrelation(correlations[0], time.UTC)
+	for _, want := range []string{
+		"0 * * * *, 30 2 * * *  /usr/local/bin/backup.sh  --full\n",
+		"  3 of 4 scheduled runs logged\n",
+		"  missed      Wed 2026-03-04 03:00\n",
+		"  unexpected  Wed 2026-03-04 02:47\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+// TestRunLogs verifies that runs the crontab does not schedule are reported
+// with ErrRunMismatch, that --user filters the log, and that usage errors
+// and logs without runs are reported.
+func TestRunLogs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	crontab := filepath.Join(dir, "crontab")
+	log := filepath.Join(dir, "syslog")
+	stamp := func(ago time.Duration) string { return time.Now().Add(-ago).Format(time.RFC3339) }
+
+	if err := os.WriteFile(crontab, []byte("0 0 29 2 * leap.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	text := fmt.Sprintf("%s web CRON[1]: (root) CMD (leap.sh)\n%s web CRON[2]: (www-data) CMD (other.sh)\n",
+		stamp(2*time.Hour), stamp(time.Hour))
+	if err := os.WriteFile(log, []byte(text), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout bytes.Buffer
+
+	err := runLogs([]string{"--log", log, crontab}, &stdout, io.Discard)
+	if !errors.Is(err, ErrRunMismatch) || !strings.Contains(err.Error(), "0 missed, 1 unexpected") {
+		t.Errorf("Expected ErrRunMismatch, got %v", err)
+	}
+
+	for _, want := range []string{"0 0 29 2 *  leap.sh\n", "  unexpected  ", "not in the crontab\n  1 runs  other.sh\n"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Output missing %q:\n%s", want, stdout.String())
+		}
+	}
+
+	if err := runLogs([]string{"--log", log, "--user", "nobody", crontab}, io.Discard, io.Discard); !errors.Is(err, ErrNoCronLog) {
+		t.Errorf("Expected ErrNoCronLog, got %v", err)
+	}
+
+	for _, args := range [][]string{{}, {"--log", log, "--journal", crontab}, {"--since", "0s", crontab}} {
+		if err := runLogs(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runLogs(%q): expected ErrUsage, got %v", args, err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// logNamePlaceholder is replaced in log templates by the name of the
+// command's program
+const logNamePlaceholder = "{name}"
+
+//nolint:gochecknoglobals
+var defaultLogTemplates = []string{
+	">> /var/log/" + logNamePlaceholder + ".log 2>&1",
+	"| logger -t " + logNamePlaceholder,
+}
+
+// validateLogTemplates checks that every configured log template has text
+func validateLogTemplates(templates []string) error {
+	for index, template := range templates {
+		if strings.TrimSpace(template) == "" {
+			return fmt.Errorf("%w: log_templates[%d] is empty", ErrInvalidConfig, index)
+		}
+	}
+
+	return nil
+}
+
+// jobName names a job after the program its command starts, without the
+// extension, or "job" when no program can be told
+func jobName(command string) string {
+	program := commandProgram(command)
+	if program == "" {
+		return "job"
+	}
+
+	return strings.TrimSuffix(filepath.Base(program), filepath.Ext(program))
+}
+
+// logSuffixes returns the configured log templates, or the defaults, filled
+// in for a command
+func (m *model) logSuffixes(command string) []string {
+	templates := m.logTemplates
+	if len(templates) == 0 {
+		templates = defaultLogTemplates
+	}
+
+	suffixes := make([]string, 0, len(templates))
+	for _, template := range templates {
+		suffixes = append(suffixes, strings.ReplaceAll(strings.TrimSpace(template), logNamePlaceholder, jobName(command)))
+	}
+
+	return suffixes
+}
+
+// cycleLogSuffix appends the first log template to the pasted command, then
+// swaps it for each following one in turn, and finally takes it off again.
+// A command already redirecting its output some other way is left alone.
+func (m *model) cycleLogSuffix() tea.Cmd {
+	tick := tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return clearCopyMessage{}
+	})
+
+	if m.lineCommand == "" {
+		m.copyMessage = "No command to log; paste a whole crontab line"
+
+		return tick
+	}
+
+	command, next := m.lineCommand, 0
+
+	suffixes := m.logSuffixes(command)
+	for index, suffix := range suffixes {
+		if trimmed, ok := strings.CutSuffix(command, " "+suffix); ok {
+			command, next = trimmed, index+1
+
+			break
+		}
+	}
+
+	if next == 0 && strings.ContainsAny(command, ">|") {
+		m.copyMessage = "The command already redirects its output"
+
+		return tick
+	}
+
+	if next == len(suffixes) {
+		m.copyMessage = "Logging off"
+	} else {
+		command += " " + suffixes[next]
+		m.copyMessage = "Logging: " + suffixes[next]
+	}
+
+	m.lineCommand = command
+	m.syncRawFromFields()
+
+	return tick
+}
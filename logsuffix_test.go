@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestCycleLogSuffix verifies that alt+l appends each log template in turn,
+// named after the program, then takes it off, and leaves commands that
+// redirect their output some other way alone.
+func TestCycleLogSuffix(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 2 * * *")
+	m.lineCommand = "/usr/local/bin/backup.sh --full"
+
+	alt := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l"), Alt: true}
+
+	for _, expected := range []string{
+		"/usr/local/bin/backup.sh --full >> /var/log/backup.log 2>&1",
+		"/usr/local/bin/backup.sh --full | logger -t backup",
+		"/usr/local/bin/backup.sh --full",
+	} {
+		m.Update(alt)
+
+		if m.lineCommand != expected {
+			t.Errorf("Expected %q, got %q", expected, m.lineCommand)
+		}
+	}
+
+	m.Update(alt)
+
+	if view := m.View(); strings.Contains(view, "output is not redirected") || !strings.Contains(m.rawInput.Value(), "0 2 * * * /usr/local/bin/backup.sh --full >> ") {
+		t.Errorf("Expected the logged command in the line, got %q and:\n%s", m.rawInput.Value(), view)
+	}
+
+	m.lineCommand = "report.sh > /tmp/report.txt"
+	m.Update(alt)
+
+	if m.lineCommand != "report.sh > /tmp/report.txt" || m.copyMessage != "The command already redirects its output" {
+		t.Errorf("Expected a redirected command left alone, got %q (%q)", m.lineCommand, m.copyMessage)
+	}
+}
+
+// TestLogTemplatesConfig verifies that log templates are read from the
+// config and that empty ones are rejected.
+func TestLogTemplatesConfig(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"log_templates": ["2>&1 | ts >> /srv/logs/{name}.log"]}`)
+
+	opts, err := parseOptions([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+	m.lineCommand = "sync"
+	m.cycleLogSuffix()
+
+	if m.lineCommand != "sync 2>&1 | ts >> /srv/logs/sync.log" {
+		t.Errorf("Expected the configured template, got %q", m.lineCommand)
+	}
+
+	if _, err := parseOptions([]string{"--config", writeConfig(t, `{"log_templates": [" "]}`)}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig for an empty template, got %v", err)
+	}
+}
@@ -9,28 +9,38 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/cockroachdb/errors"
-	crondesc "github.com/lnquy/cron"
 	"github.com/mattn/go-isatty"
 	cronparser "github.com/robfig/cron/v3"
 )
 
 const (
-	inputCharLimit     = 10               // Maximum characters per input field
-	inputWidth         = 5                // Visual width of each input field
+	inputCharLimit     = 32               // Longest field value accepted by default, see fieldLimit
+	inputWidth         = 5                // Minimum visual width of each input field
+	maxInputWidth      = 12               // Widest an input grows before switching to vertical layout
+	inputBoxChrome     = 7                // Prompt, cursor, padding, and border around an input's text
 	initialCron        = "20 4 * * *"     // Default cron expression (4:20 AM daily)
 	numCronFields      = 5                // Number of cron fields: minute, hour, day, month, weekday
 	minAbbrevLength    = 3                // Minimum length for month/day abbreviations (e.g., "JAN", "MON")
+	fieldIndexMinute   = 0                // Index of the minute field in the cron expression
+	fieldIndexHour     = 1                // Index of the hour field in the cron expression
+	fieldIndexDay      = 2                // Index of the day-of-month field in the cron expression
 	fieldIndexMonth    = 3                // Index of the month field in the cron expression
 	fieldIndexWeekday  = 4                // Index of the weekday field in the cron expression
 	stepValueMinLength = 2                // Minimum length for step values (e.g., "*/5" has "/" at index 1)
@@ -50,6 +60,9 @@ var (
 	ErrCronParse = errors.New("failed to parse cron expression")
 )
 
+// version is the release version, set at build time by goreleaser
+var version = "dev" //nolint:gochecknoglobals
+
 // clipboardAvailable checks if clipboard operations are available in the current environment
 func clipboardAvailable() bool {
 	// On Linux, clipboard requires DISPLAY environment variable and clipboard utilities (xclip/xsel)
@@ -72,6 +85,25 @@ var (
 	// Cron field names used for error messages and UI labels
 	fieldNames = []string{"minute", "hour", "day", "month", "weekday"}
 
+	// Valid value ranges shown for the focused field
+	allowedValues = []string{
+		"Allowed values: 0-59",
+		"Allowed values: 0-23",
+		"Allowed values: 1-31",
+		"Allowed values: 1-12 or JAN-DEC",
+		"Allowed values: 0-6 or SUN-SAT (7 is also Sunday)",
+	}
+
+	// Cron syntax shown in the help panel above the key bindings
+	helpText = []string{
+		"*    any value",
+		",    value list separator",
+		"-    range of values",
+		"/    step values",
+		"---------------------------",
+		"tab, up/down: complete a month or weekday name",
+	}
+
 	// UI color palette
 	colorYellow    = lipgloss.Color("#FFFF00") // Highlighted/focused elements
 	colorWhite     = lipgloss.Color("#FFFFFF") // Primary text
@@ -118,108 +150,404 @@ var (
 
 	infoStyle = lipgloss.NewStyle().
 			Foreground(colorCyan)
+
+	previewStyle = lipgloss.NewStyle().
+			Foreground(colorWhite)
+
+	scrollIndicatorStyle = lipgloss.NewStyle().
+				Foreground(colorYellow).
+				Bold(true)
+
+	conflictStyle = lipgloss.NewStyle().
+			Foreground(colorYellow)
+
+	peekStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(colorGray).
+			Foreground(colorWhite).
+			Padding(0, 1)
+
+	subtitleStyle = lipgloss.NewStyle().
+			Foreground(colorLightGray)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(colorRed).
+			Bold(true)
+
+	dimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#666666"))
+
+	copyMessageStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#00FF00"))
+
+	// Label cells of the horizontal row, given the width of their box
+	labelCellStyle = lipgloss.NewStyle().
+			Align(lipgloss.Center)
+
+	// Label column of the vertical layout
+	labelColumnStyle = lipgloss.NewStyle().
+				Width(labelWidth).
+				Align(lipgloss.Right).
+				PaddingRight(1)
 )
 
 // clearCopyMessage is sent after a delay to hide the clipboard copy message
 type clearCopyMessage struct{}
 
+// scheduleResult is delivered by a background schedule computation and carries
+// the description and next run time for the expression it was computed for
+type scheduleResult struct {
+	cronExpr    string    // Expression the result was computed for
+	description string    // Human-readable description
+	nextRun     string    // Next scheduled execution time
+	nextTime    time.Time // Next scheduled execution, for showing it in other time zones
+	err         error     // Description or parsing error
+}
+
 // model represents the application state for the Bubble Tea TUI
 type model struct {
-	inputs       []textinput.Model             // Input fields for the 5 cron parts
-	description  string                        // Human-readable description of the cron expression
-	nextRun      string                        // Next scheduled execution time
-	err          error                         // Current validation or parsing error
-	width        int                           // Terminal width
-	height       int                           // Terminal height
-	cronDesc     crondesc.ExpressionDescriptor // Cron expression descriptor
-	focusIndex   int                           // Index of currently focused input field
-	copyMessage  string                        // Message shown after copying to clipboard
-	showHelp     bool                          // Whether help text is visible
-	lastCronExpr string                        // Last processed cron expression (for caching)
+	inputs         []textinput.Model // Input fields for the 5 cron parts
+	description    string            // Human-readable description of the cron expression
+	nextRun        string            // Next scheduled execution time
+	err            error             // Current validation or parsing error
+	width          int               // Terminal width
+	height         int               // Terminal height
+	focusIndex     int               // Index of currently focused input field
+	copyMessage    string            // Message shown after copying to clipboard
+	showHelp       bool              // Whether help text is visible
+	lastCronExpr   string            // Last processed cron expression (for caching)
+	computing      bool              // Whether a schedule computation is in flight
+	showPeek       bool              // Whether the full value of the focused field is shown
+	showDial       bool              // Whether the hour and minute dials are shown
+	previewRow     int               // Screen row of the expression preview line
+	previewCol     int               // Screen column where the expression preview starts
+	plain          bool              // Render plain labeled lines without styling
+	rawInput       textinput.Model   // Free-text input for the whole expression
+	rawMode        bool              // Whether the raw input is shown and focused
+	rawConflict    string            // Why the raw text cannot be applied to the fields
+	hashSeed       string            // Jenkins job name used to resolve H tokens
+	hashResolved   string            // Expression with H tokens resolved, "" when there are none
+	scratchpad     textarea.Model    // Session notes and parked expressions
+	showScratchpad bool              // Whether the scratchpad is shown and focused
+	sessionFile    string            // File the session is saved to on exit, "" to not save
+	sessionLoaded  session           // Session as restored, which closeSession only saves over once changed
+	riskRules      []riskRule        // Rules assigning risk badges, nil for the defaults
+	copyFormat     int               // What y copies: 0 for the expression, else an exporters() index plus one
+	showChips      bool              // Whether toggle chips are shown under the weekday and month fields
+	chipsRow       int               // Screen row of the chip line
+	chipsCol       int               // Screen column where the chip line starts
+	showDST        bool              // Whether the daylight saving week preview is shown
+	showCompat     bool              // Whether the scheduler compatibility matrix is shown
+	tabs           []string          // Expressions of the open tabs, nil while only one is open
+	activeTab      int               // Index of the tab shown in the fields
+	clashWindow    time.Duration     // Tabs running this close together are reported as clashing
+	lineEnv        []string          // Variable assignments pasted before the schedule
+	lineCommand    string            // Command pasted after the schedule, shown read-only
+	linePath       string            // PATH of the crontab the command was opened from, "" for the pasted or default one
+	lineRemote     bool              // Whether the command runs on another host, so its program is not looked up here
+	runtime        time.Duration     // How long commands typically run, for overlap warnings, 0 when unknown
+	logTemplates   []string          // Logging suffixes alt+l appends to the command, nil for the defaults
+	dialect        dialect           // Dialect the raw input is read and written in
+	dialectGuard   *dialectGuard     // Prompt blocking the editor while raw text is in another dialect
+	dialectReport  *dialectReport    // Conversion report of a dialect switch awaiting accept or revert
+	pasteOffer     *pasteOffer       // Offer to spread a paste of several values over the fields
+	wizard         *wizard           // Guided questions building the expression, shown instead of the fields
+	examplePicker  *examplePicker    // Popular examples to load, shown instead of the fields
+	dialectHistory []dialectReport   // Accepted dialect switches, most recent last, for undo
+	pinned         string            // Expression whose next runs are pinned beside the current ones, "" when none
+	renderCache    renderCache       // Blocks of the view reused across frames
+	openURL        urlOpener         // Opens a link in the browser, replaced in tests
+	calendars      calendarSet       // Holidays, business hours, and freezes runs are checked against
+	nextTime       time.Time         // Next scheduled execution, shown in each of timezones
+	timezones      zoneList          // Time zones the next run is also shown in
+	now            time.Time         // Time of the last relative tick, which the time left until the next run is measured from
+	defaultExpr    string            // Expression alt+r restores, "" for initialCron
+	keys           keyMap            // Key bindings of the editor
+	viewport       viewport.Model    // Rows of the view shown when it is taller than the terminal
+	fieldLimit     int               // Longest field value accepted; longer ones are reported rather than cut off
+	locale         nameLocale        // Month and weekday names the fields accept besides English, empty for English only
+	openedExpr     string            // Expression of the crontab job the editor was opened on, "" outside crontab-file mode
+	openedCommand  string            // Command of the crontab job the editor was opened on
+	pendingWrite   bool              // Whether the crontab has changes not yet written back
+	strictNames    bool              // Whether did-you-mean shortens only full month and weekday names, see nameAbbreviation
+	bell           bool              // Whether the bell rings when the expression turns valid or invalid
+	soundCommand   string            // Command run instead of the bell, "" for the bell
+	alert          alertPlayer       // Rings the bell or runs the sound command, nil outside the program
+	wasInvalid     bool              // Whether the expression was invalid when validityAlert last looked
+	autoAdvance    bool              // Whether a complete number moves the focus to the next field
+	advanced       bool              // Whether the last key moved the focus on, see handleAdvancedKey
+
+	exampleIndex      int // Index of the field example currently shown in the help panel
+	exampleGeneration int // Help session the example animation belongs to
+	completionIndex   int // Month or weekday completion tab accepts
 }
 
-// initialModel creates and initializes a new model with default values
-func initialModel() *model {
-	m := model{
-		inputs:     make([]textinput.Model, numCronFields),
-		focusIndex: 0,
-		showHelp:   false,
+// options holds the settings for the editor, merged from the config file and the command line
+type options struct {
+	plain        bool          // Render without borders, colors, or centering
+	mode         startupMode   // Editor shown at startup
+	field        int           // Index of the field focused at startup
+	seed         string        // Jenkins job name used to resolve H tokens
+	session      string        // Name of the session restored at startup and saved on exit
+	riskRules    []riskRule    // Rules assigning risk badges, nil for the defaults
+	clashWindow  time.Duration // Tabs running this close together are reported as clashing
+	dialect      dialect       // Dialect the raw input is read and written in
+	runtime      time.Duration // How long commands typically run, for overlap warnings, 0 when unknown
+	logTemplates []string      // Logging suffixes appended to pasted commands, nil for the defaults
+	calendars    calendarSet   // Holidays, business hours, and freezes runs are checked against
+	timezones    zoneList      // Time zones the next run is also shown in
+	expression   string        // Expression the editor starts with and alt+r restores, "" for initialCron
+	keys         *keyMap       // Key bindings from the config, nil for the defaults
+	locale       nameLocale    // Month and weekday names the fields accept besides English, empty for English only
+	strictNames  bool          // Offer abbreviations only for month and weekday names written out in full
+	fieldLimit   int           // Longest field value accepted
+	notation     string        // Months and weekdays at startup as numbers or names, "" to keep them as written
+	bell         bool          // Ring the bell when the expression turns valid or invalid
+	soundCommand string        // Command run instead of the bell, "" for the bell
+	autoAdvance  bool          // Move to the next field once a number in one is complete
+}
+
+// parseOptions parses the command-line arguments into options, filling in
+// anything not given on the command line from the config files
+func parseOptions(args []string) (options, error) {
+	var (
+		opts        options
+		configPath  string
+		mode        string
+		field       string
+		dialectName string
+		runtime     string
+		tzList      string
+		locale      string
+	)
+
+	flags := flag.NewFlagSet("crontab-guru", flag.ContinueOnError)
+	flags.BoolVar(&opts.plain, "plain", false, "render plain labeled lines for screen readers and dumb terminals")
+	flags.StringVar(&configPath, "config", defaultConfigPath(), "path to the JSON config file")
+	flags.StringVar(&mode, "mode", "", "editor to start in: fields, raw, or wizard")
+	flags.StringVar(&field, "field", "", "field to focus at startup, e.g. hour")
+	flags.StringVar(&opts.seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&opts.session, "session", "", "name of the session to restore and save")
+	flags.StringVar(&dialectName, "dialect", "", "dialect of the raw input, e.g. quartz")
+	flags.StringVar(&runtime, "runtime", "", "how long pasted commands typically run, e.g. 10m, to warn when runs overlap")
+	flags.StringVar(&locale, "locale", "", "language of month and weekday names typed into the fields, e.g. pt")
+	flags.BoolVar(&opts.strictNames, "strict-names", false, "suggest abbreviations only for full month and weekday names, not other words starting with one")
+	flags.BoolVar(&opts.bell, "bell", false, "ring the terminal bell when the expression turns valid or invalid")
+	flags.BoolVar(&opts.autoAdvance, "auto-advance", false, "move to the next field once a number typed in one cannot take another digit")
+	flags.StringVar(&tzList, "tz-list", "", "comma-separated time zones to also show the next run in, e.g. UTC,Asia/Tokyo")
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return opts, ErrHelp
 	}
 
-	placeholders := []string{"*", "*", "*", "*", "*"}
-	initialValues := strings.Fields(initialCron)
+	if err != nil {
+		return opts, fmt.Errorf("invalid arguments: %w", err)
+	}
 
-	for i := range numCronFields {
-		t := textinput.New()
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return opts, err
+	}
 
-		t.Placeholder = placeholders[i]
-		if i < len(initialValues) {
-			t.SetValue(initialValues[i])
-		}
+	set := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
 
-		t.CharLimit = inputCharLimit
-		t.Width = inputWidth
-		m.inputs[i] = t
+	if err := checkLockedFlags(set, cfg); err != nil {
+		return opts, err
 	}
 
-	m.inputs[0].Focus()
+	if !set["plain"] {
+		opts.plain = cfg.Plain
+	}
 
-	cronDescriptor, err := crondesc.NewDescriptor()
-	if err != nil {
-		m.err = fmt.Errorf("%w: %w", ErrCronDescriptor, err)
+	if !set["mode"] {
+		mode = cfg.Mode
+	}
 
-		return &m
+	if !set["field"] {
+		field = cfg.Field
+	}
+
+	if !set["seed"] {
+		opts.seed = cfg.Seed
 	}
 
-	m.cronDesc = *cronDescriptor
+	if !set["session"] {
+		opts.session = cfg.Session
+	}
 
-	m.updateDescription()
+	if !set["dialect"] {
+		dialectName = cfg.Dialect
+	}
 
-	return &m
-}
+	if !set["runtime"] {
+		runtime = cfg.Runtime
+	}
 
-// validateMonthAbbreviation checks if a letter part contains valid month abbreviations
-func validateMonthAbbreviation(letterPart string) bool {
-	validMonths := []string{
-		"JAN", "FEB", "MAR", "APR", "MAY", "JUN",
-		"JUL", "AUG", "SEP", "OCT", "NOV", "DEC",
+	if !set["locale"] {
+		locale = cfg.Locale
 	}
-	for _, month := range validMonths {
-		if strings.Contains(letterPart, month) || strings.HasPrefix(letterPart, month) {
-			return true
+
+	if !set["strict-names"] {
+		opts.strictNames = cfg.StrictNames
+	}
+
+	if !set["bell"] {
+		opts.bell = cfg.Bell
+	}
+
+	if !set["auto-advance"] {
+		opts.autoAdvance = cfg.AutoAdvance
+	}
+
+	opts.soundCommand = cfg.SoundCommand
+
+	if opts.session == "" {
+		opts.session = defaultSessionName
+	}
+
+	zoneNames := cfg.Timezones
+	if set["tz-list"] {
+		zoneNames = strings.Split(tzList, ",")
+	}
+
+	if opts.timezones, err = parseZoneList(zoneNames); err != nil {
+		return opts, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	if err := validateRiskRules(cfg.RiskRules); err != nil {
+		return opts, err
+	}
+
+	opts.riskRules = cfg.RiskRules
+
+	if err := validateLogTemplates(cfg.LogTemplates); err != nil {
+		return opts, err
+	}
+
+	opts.logTemplates = cfg.LogTemplates
+
+	if cfg.Expression != "" {
+		if err := validateDefaultExpression(cfg.Expression); err != nil {
+			return opts, err
 		}
 	}
 
-	return false
-}
+	opts.expression = cfg.Expression
 
-// validateWeekdayAbbreviation checks if a letter part contains valid weekday abbreviations
-func validateWeekdayAbbreviation(letterPart string) bool {
-	validDays := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
-	for _, day := range validDays {
-		if strings.Contains(letterPart, day) || strings.HasPrefix(letterPart, day) {
-			return true
+	if cfg.Keys != nil {
+		keys, err := newKeyMap(cfg.Keys)
+		if err != nil {
+			return opts, err
 		}
+
+		opts.keys = &keys
 	}
 
-	return false
+	if opts.calendars, err = loadCalendars(cfg.Calendars, filepath.Dir(configPath)); err != nil {
+		return opts, err
+	}
+
+	if opts.notation, err = parseNotation(cfg.Notation); err != nil {
+		return opts, err
+	}
+
+	opts.fieldLimit = inputCharLimit
+	if cfg.FieldLimit != 0 {
+		if cfg.FieldLimit < 0 {
+			return opts, fmt.Errorf("%w: field_limit %d", ErrInvalidConfig, cfg.FieldLimit)
+		}
+
+		opts.fieldLimit = cfg.FieldLimit
+	}
+
+	opts.clashWindow = defaultClashWindow
+	if cfg.ClashWindow != "" {
+		if opts.clashWindow, err = time.ParseDuration(cfg.ClashWindow); err != nil || opts.clashWindow < 0 {
+			return opts, fmt.Errorf("%w: clash_window %q", ErrInvalidConfig, cfg.ClashWindow)
+		}
+	}
+
+	if runtime != "" {
+		if opts.runtime, err = time.ParseDuration(runtime); err != nil || opts.runtime < 0 {
+			return opts, fmt.Errorf("%w: runtime %q", ErrInvalidConfig, runtime)
+		}
+	}
+
+	if opts.mode, err = parseStartupMode(mode); err != nil {
+		return opts, err
+	}
+
+	if opts.field, err = parseFieldName(field); err != nil {
+		return opts, err
+	}
+
+	dictionaries, err := validateDictionaries(cfg.Dictionaries)
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.locale, err = parseLocale(locale, dictionaries); err != nil {
+		return opts, err
+	}
+
+	opts.dialect = dialectStandard
+	if dialectName != "" {
+		if opts.dialect, err = parseDialect(dialectName); err != nil {
+			return opts, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+	}
+
+	return opts, nil
 }
 
-// extractLetterPart extracts the leading letter sequence from a value
-func extractLetterPart(value string) string {
-	upper := strings.ToUpper(value)
+// initialModel creates and initializes a new model with default values
+func initialModel() *model {
+	m := model{
+		inputs:      make([]textinput.Model, numCronFields),
+		focusIndex:  0,
+		showHelp:    false,
+		clashWindow: defaultClashWindow,
+		dialect:     dialectStandard,
+		openURL:     openInBrowser,
+		now:         time.Now(),
+		keys:        defaultKeyMap(),
+	}
+
+	placeholders := []string{"*", "*", "*", "*", "*"}
+	initialValues := strings.Fields(initialCron)
 
-	var letterPart strings.Builder
+	for i := range numCronFields {
+		t := textinput.New()
 
-	for _, char := range upper {
-		if char >= 'A' && char <= 'Z' {
-			letterPart.WriteRune(char)
-		} else {
-			break
+		t.Placeholder = placeholders[i]
+		if i < len(initialValues) {
+			t.SetValue(initialValues[i])
 		}
+
+		t.CharLimit = 0 // Too long values are reported by validateCronParts instead of cut off
+		t.Width = inputWidth
+		m.inputs[i] = t
+	}
+
+	m.inputs[0].Focus()
+
+	m.fieldLimit = inputCharLimit
+	m.rawInput = newRawInput()
+	m.viewport = viewport.New(0, 0)
+	m.scratchpad = newScratchpad()
+	m.syncRawFromFields()
+
+	if _, err := sharedDescriptor(); err != nil {
+		m.err = err
+
+		return &m
 	}
 
-	return letterPart.String()
+	m.updateDescription()
+
+	return &m
 }
 
 // hasLetters checks if a string contains any letter characters
@@ -275,22 +603,22 @@ func isValidCharForField(value string, fieldIndex int) bool {
 	return true
 }
 
-// validateLetterValue validates letter-containing values for month/weekday fields
+// validateLetterValue validates letter-containing values for month/weekday
+// fields: every item of a list, range, or step made of letters must be one of
+// the field's names, so a name still being typed is invalid until completed
 func validateLetterValue(value string, fieldIndex int) bool {
-	if fieldIndex != fieldIndexMonth && fieldIndex != fieldIndexWeekday {
-		return false
-	}
-
-	letterPart := extractLetterPart(value)
-	if len(letterPart) < minAbbrevLength {
+	names := fieldNameList(fieldIndex)
+	if names == nil || !hasLetters(value) {
 		return false
 	}
 
-	if fieldIndex == fieldIndexMonth {
-		return validateMonthAbbreviation(letterPart)
+	for _, item := range strings.FieldsFunc(value, func(char rune) bool { return strings.ContainsRune(",-/", char) }) {
+		if hasLetters(item) && !slices.Contains(names, item) {
+			return false
+		}
 	}
 
-	return validateWeekdayAbbreviation(letterPart)
+	return true
 }
 
 // isValidCronPart validates a cron field value based on its field index.
@@ -305,6 +633,10 @@ func isValidCronPart(value string, fieldIndex int) bool {
 		return true
 	}
 
+	if hasHashItem(value) {
+		return validateHashValue(value, fieldIndex)
+	}
+
 	if !isValidCharForField(value, fieldIndex) {
 		return false
 	}
@@ -318,23 +650,69 @@ func isValidCronPart(value string, fieldIndex int) bool {
 
 // Init initializes the model and returns the initial command (text cursor blink)
 func (m *model) Init() tea.Cmd {
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, relativeTickCmd())
 }
 
 // View renders the complete UI by assembling all visual components
 func (m *model) View() string {
+	if m.plain {
+		return m.renderPlain()
+	}
+
 	var builder strings.Builder
 
+	// Growing the buffer to the last frame's size spares copying it as it fills
+	builder.Grow(m.renderCache.size)
 	builder.WriteString(m.renderHeader())
+
+	if m.wizard != nil {
+		builder.WriteString(m.renderWizard())
+
+		return builder.String()
+	}
+
+	if m.examplePicker != nil {
+		builder.WriteString(m.renderExamplePicker())
+
+		return builder.String()
+	}
+
+	builder.WriteString(m.renderTabs())
 	builder.WriteString(m.renderDescription())
 	builder.WriteString(m.renderNextRun())
 	builder.WriteString(m.renderInputs())
 	builder.WriteString(m.renderLabels())
+	builder.WriteString(m.renderCompletions())
+
+	// Remember where the chips land so clicks can flip them
+	m.chipsRow = strings.Count(builder.String(), "\n")
+	builder.WriteString(m.renderChips())
+
+	// Remember where the preview line lands so mouse clicks can be mapped to fields
+	m.previewRow = strings.Count(builder.String(), "\n")
+	builder.WriteString(m.renderPreview())
+	builder.WriteString(m.renderCrontabLine())
+	builder.WriteString(m.renderOverlaps())
+	builder.WriteString(m.renderSimplifications())
+	builder.WriteString(m.renderMergedTimeline())
+	builder.WriteString(m.renderPinned())
+	builder.WriteString(m.renderClashes())
+	builder.WriteString(m.renderRaw())
+	builder.WriteString(m.renderDialectGuard())
+	builder.WriteString(m.renderDialectReport())
+	builder.WriteString(m.renderPasteOffer())
+	builder.WriteString(m.renderScratchpad())
+	builder.WriteString(m.renderPeek())
+	builder.WriteString(m.renderDials())
+	builder.WriteString(m.renderDSTPreview())
+	builder.WriteString(m.renderCompat())
 	builder.WriteString(m.renderAllowedValues())
+	builder.WriteString(m.renderExample())
 	builder.WriteString(m.renderHelp())
-	builder.WriteString(m.renderFooter())
 
-	return builder.String()
+	m.renderCache.size = builder.Len()
+
+	return m.fitHeight(builder.String(), m.renderFooter())
 }
 
 // Update handles all messages (keyboard input, window resize, timer events)
@@ -353,9 +731,23 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		if model, cmd := m.handleKeyMessage(msg); model != nil {
-			return model, cmd
+			return model, tea.Batch(cmd, m.localizeFields(), m.validityAlert())
 		}
 
+	case scheduleResult:
+		m.applyScheduleResult(msg)
+
+		return m, m.validityAlert()
+
+	case exampleTick:
+		return m, m.handleExampleTick(msg)
+
+	case relativeTick:
+		return m, m.handleRelativeTick(msg)
+
+	case tea.MouseMsg:
+		return m, tea.Batch(m.handleMouse(msg), m.validityAlert())
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -364,51 +756,200 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	cmd := m.updateInputs(msg)
-	m.updateDescription()
+	if !m.rawMode {
+		m.syncRawFromFields()
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		cmd = tea.Batch(cmd, m.advanceFocus(msg))
+	}
 
-	return m, cmd
+	return m, tea.Batch(cmd, m.localizeFields(), m.scheduleCmd(), m.validityAlert())
 }
 
 // handleKeyMessage processes keyboard input
 func (m *model) handleKeyMessage(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "ctrl+c", "esc":
+	advanced := m.advanced
+	m.advanced = false
+
+	if m.wizard != nil {
+		return m.handleWizardKey(msg)
+	}
+
+	if m.examplePicker != nil {
+		return m.handleExamplePickerKey(msg)
+	}
+
+	if m.showScratchpad {
+		return m.handleScratchpadKey(msg)
+	}
+
+	if m.dialectGuard != nil {
+		return m.handleDialectGuardKey(msg)
+	}
+
+	if m.dialectReport != nil {
+		return m.handleDialectReportKey(msg)
+	}
+
+	if m.pasteOffer != nil {
+		return m.handlePasteOfferKey(msg)
+	}
+
+	if key.Matches(msg, m.keys.Scratchpad) {
+		return m, m.toggleScratchpad()
+	}
+
+	if cmd, ok := m.handleTabKey(msg); ok {
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.ScrollUp):
+		m.viewport.PageUp()
+
+		return m, nil
+	case key.Matches(msg, m.keys.ScrollDown):
+		m.viewport.PageDown()
+
+		return m, nil
+	case key.Matches(msg, m.keys.NextDialect):
+		return m, m.nextDialect()
+	case key.Matches(msg, m.keys.UndoDialect):
+		return m, m.undoDialectSwitch()
+	case key.Matches(msg, m.keys.LogSuffix):
+		return m, m.cycleLogSuffix()
+	}
+
+	if m.rawMode {
+		return m.handleRawKey(msg)
+	}
+
+	if msg.Paste {
+		return m, m.pasteIntoField(string(msg.Runes))
+	}
+
+	if cmd, ok := m.handleChipKey(msg); ok {
+		return m, cmd
+	}
+
+	if cmd, ok := m.handleCompletionKey(msg); ok {
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
 		return m, tea.Quit
-	case "y":
+	case key.Matches(msg, m.keys.Copy):
 		return m, m.handleCopyToClipboard()
-	case "?":
-		m.showHelp = !m.showHelp
+	case key.Matches(msg, m.keys.Help):
+		return m, m.toggleHelp()
+	case key.Matches(msg, m.keys.Peek):
+		m.showPeek = !m.showPeek
+
+		return m, nil
+	case key.Matches(msg, m.keys.Raw):
+		return m, m.toggleRawMode()
+	case key.Matches(msg, m.keys.ClearField):
+		return m, m.clearField()
+	case key.Matches(msg, m.keys.ClearFields):
+		return m, m.clearFields()
+	case key.Matches(msg, m.keys.RestoreDefault):
+		return m, m.restoreDefault()
+	case key.Matches(msg, m.keys.Notation):
+		return m, m.toggleNotation()
+	case key.Matches(msg, m.keys.Increment):
+		return m, m.stepField(1)
+	case key.Matches(msg, m.keys.Decrement):
+		return m, m.stepField(-1)
+	case key.Matches(msg, m.keys.Dials):
+		m.showDial = !m.showDial
+
+		return m, nil
+	case key.Matches(msg, m.keys.ResolveHash):
+		return m, m.applyHashResolution()
+	case key.Matches(msg, m.keys.CopyFormat):
+		m.cycleCopyFormat()
 
 		return m, nil
-	case "tab", " ", "enter":
+	case key.Matches(msg, m.keys.Chips):
+		m.showChips = !m.showChips
+
+		return m, nil
+	case key.Matches(msg, m.keys.Collapse):
+		return m, m.collapseOverlaps()
+	case key.Matches(msg, m.keys.Simplify):
+		return m, m.simplifyFields()
+	case key.Matches(msg, m.keys.DST):
+		m.showDST = !m.showDST
+
+		return m, nil
+	case key.Matches(msg, m.keys.Compat):
+		m.showCompat = !m.showCompat
+
+		return m, nil
+	case key.Matches(msg, m.keys.Pin):
+		m.togglePin()
+
+		return m, nil
+	case key.Matches(msg, m.keys.Wizard):
+		m.openWizard()
+
+		return m, nil
+	case key.Matches(msg, m.keys.Examples):
+		return m, m.openExamplePicker()
+	case key.Matches(msg, m.keys.Guru):
+		return m, m.openGuru()
+	case key.Matches(msg, m.keys.NextField):
 		return m, m.handleTabNavigation()
-	case "shift+tab":
+	case key.Matches(msg, m.keys.PrevField):
 		return m, m.handleShiftTabNavigation()
-	case "backspace":
+	case msg.String() == "backspace":
 		if cmd := m.handleBackspaceNavigation(); cmd != nil {
 			return m, cmd
 		}
 	}
 
+	if advanced {
+		if cmd, ok := m.handleAdvancedKey(msg); ok {
+			return m, cmd
+		}
+	}
+
 	return nil, nil
 }
 
 // updateDescription validates the cron expression and updates the human-readable
-// description and next run time. Uses caching to avoid redundant processing.
+// description and next run time synchronously. Uses caching to avoid redundant processing.
 func (m *model) updateDescription() {
+	cmd := m.scheduleCmd()
+	if cmd == nil {
+		return
+	}
+
+	if result, ok := cmd().(scheduleResult); ok {
+		m.applyScheduleResult(result)
+	}
+}
+
+// scheduleCmd validates the cron expression and returns a command that computes
+// its description and next run time in the background. Cheap validation happens
+// immediately so errors show without delay; nil is returned when there is nothing to compute.
+func (m *model) scheduleCmd() tea.Cmd {
 	cronExpr := m.buildCronExpression()
 
 	// Optimization: Only update if cron expression has changed
 	if cronExpr == m.lastCronExpr {
-		return
+		return nil
 	}
 
 	m.lastCronExpr = cronExpr
+	m.hashResolved = ""
 
 	if strings.TrimSpace(cronExpr) == "" {
 		m.clearDescription()
 
-		return
+		return nil
 	}
 
 	// Validate all parts before attempting to parse
@@ -416,12 +957,51 @@ func (m *model) updateDescription() {
 		m.err = err
 		m.description = ""
 		m.nextRun = ""
+		m.computing = false
+
+		return nil
+	}
+
+	// Jenkins H tokens are resolved first so the description and next run are concrete
+	scheduleExpr := cronExpr
+
+	if hasJenkinsHash(cronExpr) {
+		resolved, err := resolveJenkinsHash(cronExpr, m.hashSeed)
+		if err != nil {
+			m.err = err
+			m.description = ""
+			m.nextRun = ""
+			m.computing = false
+
+			return nil
+		}
+
+		m.hashResolved = resolved
+		scheduleExpr = resolved
+	}
+
+	m.computing = true
+
+	return func() tea.Msg {
+		result := computeSchedule(scheduleExpr, time.Now())
+		result.cronExpr = cronExpr
 
+		return result
+	}
+}
+
+// applyScheduleResult stores a computed schedule result, discarding results
+// for expressions that have been edited since the computation started
+func (m *model) applyScheduleResult(result scheduleResult) {
+	if result.cronExpr != m.lastCronExpr {
 		return
 	}
 
-	m.updateCronDescription(cronExpr)
-	m.updateNextRunTime(cronExpr)
+	m.computing = false
+	m.description = result.description
+	m.nextRun = result.nextRun
+	m.nextTime = result.nextTime
+	m.err = result.err
 }
 
 // buildCronExpression constructs the cron expression string from input fields
@@ -444,12 +1024,18 @@ func (m *model) buildCronExpression() string {
 func (m *model) clearDescription() {
 	m.description = ""
 	m.nextRun = ""
+	m.nextTime = time.Time{}
 	m.err = nil
 }
 
 // validateCronParts validates all cron field values
 func (m *model) validateCronParts() error {
 	for index, input := range m.inputs {
+		if length := len(input.Value()); length > m.fieldLimit {
+			return fmt.Errorf("%w: %s is %d characters long, more than the %d allowed (field_limit in the config)",
+				ErrInvalidValue, fieldNames[index], length, m.fieldLimit)
+		}
+
 		if !isValidCronPart(input.Value(), index) {
 			return fmt.Errorf("%w: %s", ErrInvalidValue, fieldNames[index])
 		}
@@ -458,35 +1044,31 @@ func (m *model) validateCronParts() error {
 	return nil
 }
 
-// updateCronDescription generates the human-readable description
-func (m *model) updateCronDescription(cronExpr string) {
-	desc, err := m.cronDesc.ToDescription(cronExpr, crondesc.Locale_en)
+// computeSchedule generates the human-readable description and next run time
+// for a validated cron expression. It has no side effects and uses only the
+// shared descriptor and parser, so several can run at once in tea.Cmds.
+func computeSchedule(cronExpr string, now time.Time) scheduleResult {
+	result := scheduleResult{cronExpr: cronExpr}
+
+	desc, err := describeExpression(cronExpr)
 	if err != nil {
-		m.err = err
-		m.description = ""
-		m.nextRun = ""
+		result.err = err
 
-		return
+		return result
 	}
 
-	m.description = desc
-	m.err = nil
-}
-
-// updateNextRunTime calculates the next scheduled execution time
-func (m *model) updateNextRunTime(cronExpr string) {
-	parser := cronparser.NewParser(cronParserOptions)
-
-	schedule, err := parser.Parse(cronExpr)
+	schedule, err := standardParser.Parse(cronExpr)
 	if err != nil {
-		m.nextRun = ""
-		m.err = fmt.Errorf("%w: %w", ErrCronParse, err)
+		result.err = fmt.Errorf("%w: %w", ErrCronParse, err)
 
-		return
+		return result
 	}
 
-	next := schedule.Next(time.Now())
-	m.nextRun = next.Format("2006-01-02 15:04:05")
+	result.description = desc
+	result.nextTime = schedule.Next(now)
+	result.nextRun = result.nextTime.Format("2006-01-02 15:04:05")
+
+	return result
 }
 
 // updateInputs updates the focused input field
@@ -499,6 +1081,14 @@ func (m *model) updateInputs(msg tea.Msg) tea.Cmd {
 		}
 	}
 
+	if m.rawInput.Focused() {
+		m.rawInput, cmd = m.rawInput.Update(msg)
+	}
+
+	if m.scratchpad.Focused() {
+		m.scratchpad, cmd = m.scratchpad.Update(msg)
+	}
+
 	return cmd
 }
 
@@ -511,6 +1101,21 @@ func (m *model) handleCopyToClipboard() tea.Cmd {
 
 	cronExpr := strings.Join(cronParts, " ")
 
+	tick := tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return clearCopyMessage{}
+	})
+
+	if m.copyFormat != 0 {
+		rendered, err := m.exportText()
+		if err != nil {
+			m.copyMessage = "Cannot copy as " + m.copyFormatName() + ": " + err.Error()
+
+			return tick
+		}
+
+		cronExpr = rendered
+	}
+
 	// Check if clipboard is available in the current environment
 	if !clipboardAvailable() {
 		m.copyMessage = "Clipboard not available"
@@ -520,9 +1125,7 @@ func (m *model) handleCopyToClipboard() tea.Cmd {
 		m.copyMessage = copyMessageText
 	}
 
-	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
-		return clearCopyMessage{}
-	})
+	return tick
 }
 
 // handleTabNavigation handles tab key navigation between fields
@@ -575,19 +1178,19 @@ func (m *model) handleBackspaceNavigation() tea.Cmd {
 
 // renderHeader renders the title and subtitle
 func (m *model) renderHeader() string {
-	var builder strings.Builder
+	return m.cached("header", func() string {
+		var builder strings.Builder
 
-	title := titleStyle.Render("crontab guru")
-	builder.WriteString(lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, title))
-	builder.WriteString("\n")
+		title := titleStyle.Render("crontab guru")
+		builder.WriteString(m.place(title))
+		builder.WriteString("\n")
 
-	subtitle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#AAAAAA")).
-		Render("The quick and simple editor for cron schedule expressions")
-	builder.WriteString(lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, subtitle))
-	builder.WriteString("\n\n")
+		subtitle := subtitleStyle.Render("The quick and simple editor for cron schedule expressions")
+		builder.WriteString(m.place(subtitle))
+		builder.WriteString("\n\n")
 
-	return builder.String()
+		return builder.String()
+	})
 }
 
 // renderDescription renders the description or error message
@@ -595,57 +1198,335 @@ func (m *model) renderDescription() string {
 	switch {
 	case m.description != "":
 		desc := descriptionStyle.Render(fmt.Sprintf("\"%s\"", m.description))
+		warnings := m.cached("warnings", func() string { return m.renderWarnings(m.fieldWarnings()) })
 
-		return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, desc) + "\n"
+		// Calendar warnings name upcoming runs, which pass as time goes by, so
+		// they are not cached with the expression
+		return m.place(desc) + "\n" + warnings + m.renderWarnings(m.calendarWarnings(time.Now()))
 	case m.err != nil:
-		errmsg := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Bold(true).Render("Error: " + m.err.Error())
+		errmsg := errorStyle.Render("Error: " + m.err.Error())
+		if suggestion := m.didYouMean(); suggestion != "" {
+			errmsg += "\n" + infoStyle.Render(suggestion)
+		}
 
-		return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, errmsg) + "\n"
+		return m.place(errmsg) + "\n"
 	default:
 		return "\n"
 	}
 }
 
+// renderWarnings renders warnings under the description, one per line
+func (m *model) renderWarnings(warnings []string) string {
+	var builder strings.Builder
+
+	for _, warning := range warnings {
+		builder.WriteString(m.place(conflictStyle.Render("! "+warning)) + "\n")
+	}
+
+	return builder.String()
+}
+
 // renderNextRun displays the next scheduled execution time if available
 func (m *model) renderNextRun() string {
 	if m.nextRun != "" {
-		nextInfo := infoStyle.Render("next at " + m.nextRun)
+		badge := m.cached("risk", func() string { return m.risk().renderBadge() })
+
+		nextInfo := infoStyle.Render("next at "+m.nextRun+" ("+relativeRun(m.nextTime, m.now)+")") + "  " + badge
+		if note := m.hashNote(); note != "" {
+			nextInfo += "\n" + infoStyle.Render(note)
+		}
+
+		if lines := m.zoneLines(); len(lines) > 0 {
+			nextInfo += "\n" + dimStyle.Render(strings.Join(lines, "\n"))
+		}
 
-		return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, nextInfo) + "\n\n"
+		return m.place(nextInfo) + "\n\n"
 	}
 
 	return "\n\n"
 }
 
+// fitInputWidths grows each input to fit its value so long lists stay visible.
+// Inputs are capped at maxInputWidth in the horizontal layout and at the
+// character limit in the vertical layout.
+func (m *model) fitInputWidths() {
+	maxWidth := maxInputWidth
+	if m.verticalLayout() {
+		maxWidth = m.fieldLimit
+		// Keep stacked boxes within the terminal; longer values scroll inside the box
+		if m.width > 0 {
+			maxWidth = max(inputWidth, min(maxWidth, m.width-labelWidth-inputBoxChrome-1))
+		}
+	}
+
+	for index := range m.inputs {
+		width := max(inputWidth, min(len(m.inputs[index].Value()), maxWidth))
+		if m.inputs[index].Width != width {
+			pos := m.inputs[index].Position()
+			m.inputs[index].Width = width
+			// Rewind then re-apply the cursor so the input recomputes its visible window
+			m.inputs[index].CursorStart()
+			m.inputs[index].SetCursor(pos)
+		}
+	}
+}
+
+// verticalLayout reports whether the fields should be stacked vertically,
+// either because a value is too long for the horizontal row or because
+// the terminal is too narrow to fit it
+func (m *model) verticalLayout() bool {
+	if m.compactLayout() {
+		return true
+	}
+
+	for _, input := range m.inputs {
+		if len(input.Value()) > maxInputWidth {
+			return true
+		}
+	}
+
+	return false
+}
+
+// horizontalRowWidth returns the width the five boxes need side by side.
+// It is derived from the values rather than the current input widths,
+// which themselves depend on the chosen layout.
+func (m *model) horizontalRowWidth() int {
+	total := 0
+	for _, input := range m.inputs {
+		total += max(inputWidth, min(len(input.Value()), maxInputWidth)) + inputBoxChrome
+	}
+
+	return total
+}
+
+// compactLayout reports whether the terminal is narrower than the horizontal row
+func (m *model) compactLayout() bool {
+	return m.width > 0 && m.width < m.horizontalRowWidth()
+}
+
+// place centers a block horizontally in the terminal. In the compact layout
+// the decorative centering is dropped and text is wrapped to the terminal width.
+func (m *model) place(content string) string {
+	if m.compactLayout() {
+		return lipgloss.NewStyle().Width(m.width).Render(content)
+	}
+
+	return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, content)
+}
+
+// inputStyle returns the box style for an input based on focus state and validation errors
+func (m *model) inputStyle(index int) lipgloss.Style {
+	switch {
+	case m.err != nil:
+		return errorInputBoxStyle
+	case m.inputs[index].Focused():
+		return focusedInputBoxStyle
+	default:
+		return inputBoxStyle
+	}
+}
+
+// overflowIndicators reports whether part of a field's value is scrolled out of
+// view on the left or right. The input's scroll offset is private, so the
+// hidden sides are inferred from the cursor position, which is always visible.
+func (m *model) overflowIndicators(index int) (bool, bool) {
+	input := m.inputs[index]
+	length := len(input.Value())
+
+	if length <= input.Width {
+		return false, false
+	}
+
+	pos := input.Position()
+	hiddenLeft := pos >= input.Width
+	hiddenRight := length > max(pos+1, input.Width)
+
+	return hiddenLeft, hiddenRight
+}
+
+// renderInputBox renders a single input inside its box, marking hidden content
+// with scroll indicators on the side where the value continues
+func (m *model) renderInputBox(index int) string {
+	hiddenLeft, hiddenRight := m.overflowIndicators(index)
+
+	left, right := " ", " "
+	if hiddenLeft {
+		left = scrollIndicatorStyle.Render("‹")
+	}
+
+	if hiddenRight {
+		right = scrollIndicatorStyle.Render("›")
+	}
+
+	style := m.inputStyle(index).Padding(0)
+
+	return style.Render(left + m.inputs[index].View() + right)
+}
+
+// setFocus moves focus to the field at index
+func (m *model) setFocus(index int) tea.Cmd {
+	if index < 0 || index >= len(m.inputs) {
+		return nil
+	}
+
+	m.inputs[m.focusIndex].Blur()
+	m.focusIndex = index
+	m.inputs[m.focusIndex].Focus()
+
+	return textinput.Blink
+}
+
+// handleMouse flips a clicked chip or focuses the field whose segment was
+// clicked in the expression preview
+func (m *model) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.viewport.ScrollUp(m.viewport.MouseWheelDelta)
+
+		return nil
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.viewport.ScrollDown(m.viewport.MouseWheelDelta)
+
+		return nil
+	case msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft:
+		return nil
+	}
+
+	// Rows are counted from the top of the view, which may be scrolled
+	row := msg.Y + m.viewport.YOffset
+
+	if m.chipsActive() && row == m.chipsRow {
+		return m.toggleChip(m.chipAt(msg.X - m.chipsCol))
+	}
+
+	if row != m.previewRow {
+		return nil
+	}
+
+	index := m.segmentAt(msg.X - m.previewCol)
+	if index < 0 {
+		return nil
+	}
+
+	if m.rawMode {
+		m.toggleRawMode()
+	}
+
+	return m.setFocus(index)
+}
+
+// segmentAt returns the index of the field whose segment covers the given column
+// of the expression preview, counting a separating space as part of the segment
+// before it. It returns -1 when the column is outside the expression.
+func (m *model) segmentAt(column int) int {
+	if column < 0 {
+		return -1
+	}
+
+	start := 0
+
+	for index, part := range strings.Fields(m.buildCronExpression()) {
+		end := start + len(part)
+		if column <= end {
+			return index
+		}
+
+		start = end + 1
+	}
+
+	return -1
+}
+
+// renderPreview renders the composed expression on one line with the focused
+// field's segment highlighted
+func (m *model) renderPreview() string {
+	parts := strings.Fields(m.buildCronExpression())
+	segments := make([]string, 0, len(parts))
+
+	for index, part := range parts {
+		style := previewStyle
+		if index == m.focusIndex {
+			style = focusedLabelStyle
+		}
+
+		segments = append(segments, style.Render(part))
+	}
+
+	preview := strings.Join(segments, " ")
+
+	m.previewCol = 0
+	if !m.compactLayout() {
+		m.previewCol = max(0, (m.width-lipgloss.Width(preview))/2)
+	}
+
+	return m.place(preview) + "\n"
+}
+
+// renderPeek shows the full value of the focused field in a tooltip so content
+// hidden by scrolling can be read without moving the cursor
+func (m *model) renderPeek() string {
+	if !m.showPeek || m.focusIndex < 0 || m.focusIndex >= len(m.inputs) {
+		return ""
+	}
+
+	value := m.inputs[m.focusIndex].Value()
+	if value == "" {
+		value = "*"
+	}
+
+	tooltip := peekStyle.Render(fieldNames[m.focusIndex] + ": " + value)
+
+	return m.place(tooltip) + "\n"
+}
+
 // renderInputs renders the five input fields with appropriate styling
 // based on focus state and validation errors
 func (m *model) renderInputs() string {
+	m.fitInputWidths()
+
+	if m.verticalLayout() {
+		return m.renderVerticalInputs()
+	}
+
 	inputViews := make([]string, 0, len(m.inputs))
 
 	for index := range m.inputs {
-		var style lipgloss.Style
+		inputViews = append(inputViews, m.renderInputBox(index))
+	}
 
-		switch {
-		case m.err != nil:
-			style = errorInputBoxStyle
-		case m.inputs[index].Focused():
-			style = focusedInputBoxStyle
-		default:
-			style = inputBoxStyle
+	inputs := lipgloss.JoinHorizontal(lipgloss.Top, inputViews...)
+
+	return m.place(inputs) + "\n"
+}
+
+// renderVerticalInputs stacks the fields one per row with their labels on the left
+func (m *model) renderVerticalInputs() string {
+	rows := make([]string, 0, len(m.inputs))
+	for index := range m.inputs {
+		style := labelStyle
+		if index == m.focusIndex {
+			style = focusedLabelStyle
 		}
 
-		inputViews = append(inputViews, style.Render(m.inputs[index].View()))
+		label := labelColumnStyle.Render(style.Render(fieldNames[index]))
+		box := m.renderInputBox(index)
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Center, label, box))
 	}
 
-	inputs := lipgloss.JoinHorizontal(lipgloss.Top, inputViews...)
+	inputs := lipgloss.JoinVertical(lipgloss.Left, rows...)
 
-	return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, inputs) + "\n"
+	return m.place(inputs) + "\n"
 }
 
-// renderLabels renders the field labels
+// renderLabels renders the field labels, each as wide as the box above it.
+// Labels are drawn inline with the boxes in the vertical layout.
 func (m *model) renderLabels() string {
+	if m.verticalLayout() {
+		return ""
+	}
+
 	styledLabels := make([]string, 0, len(fieldNames))
-	baseLabelStyle := lipgloss.NewStyle().Width(labelWidth).Align(lipgloss.Center)
 
 	safeFocusIndex := m.focusIndex
 	if safeFocusIndex < 0 || safeFocusIndex >= len(m.inputs) {
@@ -660,28 +1541,25 @@ func (m *model) renderLabels() string {
 			style = labelStyle
 		}
 
-		styledLabels = append(styledLabels, baseLabelStyle.Render(style.Render(label)))
+		width := labelWidth
+		if index < len(m.inputs) {
+			width = m.inputs[index].Width + inputBoxChrome
+		}
+
+		styledLabels = append(styledLabels, labelCellStyle.Width(width).Render(style.Render(label)))
 	}
 
 	labelRow := lipgloss.JoinHorizontal(lipgloss.Top, styledLabels...)
 
-	return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, labelRow) + "\n"
+	return m.place(labelRow) + "\n"
 }
 
 // renderAllowedValues shows the valid value range for the currently focused field
 func (m *model) renderAllowedValues() string {
-	availableValues := []string{
-		"Allowed values: 0-59",
-		"Allowed values: 0-23",
-		"Allowed values: 1-31",
-		"Allowed values: 1-12 or JAN-DEC",
-		"Allowed values: 0-6 or SUN-SAT (7 is also Sunday)",
-	}
+	if m.focusIndex >= 0 && m.focusIndex < len(allowedValues) && m.focusIndex < len(m.inputs) {
+		availVals := dimStyle.Render(allowedValues[m.focusIndex])
 
-	if m.focusIndex >= 0 && m.focusIndex < len(availableValues) && m.focusIndex < len(m.inputs) {
-		availVals := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render(availableValues[m.focusIndex])
-
-		return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, availVals) + "\n\n"
+		return m.place(availVals) + "\n\n"
 	}
 
 	return "\n\n"
@@ -693,40 +1571,188 @@ func (m *model) renderHelp() string {
 		return ""
 	}
 
-	helpText := []string{
-		"*    any value",
-		",    value list separator",
-		"-    range of values",
-		"/    step values",
-		"---------------------------",
-		"tab/space/enter: next field",
-		"shift+tab: previous field",
-		"y: copy expression",
-		"esc/ctrl+c: quit",
+	return m.cached("help", func() string {
+		return m.place(helpStyle.Render(strings.Join(m.helpLines(), "\n"))) + "\n\n"
+	})
+}
+
+// renderFooter renders the copy message and the status bar
+func (m *model) renderFooter() string {
+	var builder strings.Builder
+
+	if m.copyMessage != "" {
+		copyMsg := copyMessageStyle.Render(m.copyMessage)
+		builder.WriteString(m.place(copyMsg))
+	} else {
+		builder.WriteString(m.place(""))
 	}
 
-	help := helpStyle.Render(strings.Join(helpText, "\n"))
+	builder.WriteString("\n")
+	builder.WriteString(m.renderStatusBar())
 
-	return lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, help) + "\n\n"
+	return builder.String()
 }
 
-// renderFooter renders the instructions and copy message
-func (m *model) renderFooter() string {
+// renderPlain renders the editor as plain labeled lines without borders, colors,
+// or centering so screen readers and dumb terminals can follow it
+func (m *model) renderPlain() string {
 	var builder strings.Builder
 
-	instructions := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666666")).
-		Render("Press ? for help, y to copy, Esc to quit")
-	builder.WriteString(lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, instructions))
+	builder.WriteString("crontab guru\n\n")
+
+	if m.wizard != nil {
+		builder.WriteString(strings.Join(m.wizardLines(), "\n") + "\n")
+
+		return builder.String()
+	}
+
+	if m.examplePicker != nil {
+		builder.WriteString(strings.Join(m.examplePickerLines(), "\n") + "\n")
+
+		return builder.String()
+	}
+
+	switch {
+	case m.description != "":
+		builder.WriteString("description: " + m.description + "\n")
+
+		for _, warning := range m.warnings() {
+			builder.WriteString("warning: " + warning + "\n")
+		}
+	case m.err != nil:
+		builder.WriteString("error: " + m.err.Error() + "\n")
+
+		if suggestion := m.didYouMean(); suggestion != "" {
+			builder.WriteString(suggestion + "\n")
+		}
+	}
+
+	if m.nextRun != "" {
+		builder.WriteString("next run: " + m.nextRun + " (" + relativeRun(m.nextTime, m.now) + ")\n")
+		builder.WriteString("risk: " + m.risk().label() + "\n")
+
+		for _, line := range m.zoneLines() {
+			builder.WriteString("next run in " + line + "\n")
+		}
+	}
+
+	if note := m.hashNote(); note != "" {
+		builder.WriteString("jenkins: " + note + "\n")
+	}
+
 	builder.WriteString("\n")
 
+	for index, input := range m.inputs {
+		marker := "  "
+		if index == m.focusIndex {
+			marker = "> "
+		}
+
+		value := input.Value()
+		if value == "" {
+			value = "*"
+		}
+
+		builder.WriteString(marker + fieldNames[index] + ": " + value + "\n")
+	}
+
+	builder.WriteString("\nexpression: " + m.buildCronExpression() + "\n")
+
+	if len(m.lineEnv) > 0 {
+		builder.WriteString("env: " + strings.Join(m.lineEnv, " ") + "\n")
+	}
+
+	if m.lineCommand != "" {
+		builder.WriteString("command: " + m.lineCommand + "\n")
+	}
+
+	for _, warning := range m.commandWarnings() {
+		builder.WriteString("command warning: " + warning + "\n")
+	}
+
+	builder.WriteString(m.renderPlainTabs())
+
+	if m.pinned != "" {
+		lines, _ := m.pinnedLines(time.Now())
+		builder.WriteString("pinned runs:\n  " + strings.Join(lines, "\n  ") + "\n")
+	}
+
+	for _, overlap := range m.overlaps() {
+		builder.WriteString("overlap: " + overlap.String() + "\n")
+	}
+
+	if hint := m.simplificationHint(); hint != "" {
+		builder.WriteString("simpler: " + hint + "\n")
+	}
+
+	if m.rawMode {
+		builder.WriteString("raw: " + m.rawInput.Value() + "\n")
+
+		if m.dialect != dialectStandard {
+			builder.WriteString("dialect: " + string(m.dialect) + "\n")
+		}
+
+		if m.rawConflict != "" {
+			builder.WriteString("warning: " + m.rawConflict + "\n")
+		}
+	}
+
+	if m.dialectGuard != nil {
+		builder.WriteString("blocked: " + strings.Join(m.dialectGuardLines(), "\n  ") + "\n")
+	}
+
+	if m.dialectReport != nil {
+		builder.WriteString("conversion: " + strings.Join(m.dialectReportLines(), "\n  ") + "\n")
+	}
+
+	if m.pasteOffer != nil {
+		builder.WriteString("paste: " + strings.Join(m.pasteOfferLines(), "\n  ") + "\n")
+	}
+
+	if m.showScratchpad {
+		builder.WriteString("scratchpad:\n" + m.scratchpad.Value() + "\n")
+	}
+
+	if _, matches := m.completions(); len(matches) > 0 {
+		marked := slices.Clone(matches)
+		marked[m.completionIndex%len(marked)] = "[" + marked[m.completionIndex%len(marked)] + "]"
+		builder.WriteString("complete with tab: " + strings.Join(marked, ", ") + "\n")
+	}
+
+	if m.chipsActive() {
+		builder.WriteString(m.renderPlainChips())
+	}
+
+	if m.showDST {
+		if preview, err := dstPreview(m.buildCronExpression(), time.Local, time.Now()); err == nil {
+			builder.WriteString("dst:\n" + preview)
+		}
+	}
+
+	if m.showCompat {
+		if matrix, err := compatMatrix(m.buildCronExpression()); err == nil {
+			builder.WriteString("compatibility:\n  " + strings.Join(compatLines(matrix), "\n  ") + "\n")
+		}
+	}
+
+	if m.focusIndex >= 0 && m.focusIndex < len(allowedValues) {
+		builder.WriteString(allowedValues[m.focusIndex] + "\n")
+	}
+
+	if m.showHelp {
+		if example, set := m.currentExample(); example != "" {
+			builder.WriteString("example: " + example + " selects " + set.String() + "\n")
+		}
+
+		builder.WriteString("\n" + strings.Join(m.helpLines(), "\n") + "\n")
+	}
+
 	if m.copyMessage != "" {
-		copyMsg := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Render(m.copyMessage)
-		builder.WriteString(lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, copyMsg))
-	} else {
-		builder.WriteString(lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, ""))
+		builder.WriteString("\n" + m.copyMessage + "\n")
 	}
 
+	builder.WriteString("\n" + m.plainStatus())
+
 	return builder.String()
 }
 
@@ -735,26 +1761,57 @@ func (m *model) renderFooter() string {
 //nolint:gochecknoglobals
 var app *tea.Program
 
-// run initializes and runs the Bubble Tea app
-func run() error {
+// run dispatches to a subcommand when one is named, otherwise parses the
+// command-line options, then initializes and runs the Bubble Tea app
+func run(args []string) error {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		return runCommand(args, os.Stdout, os.Stderr)
+	}
+
+	opts, err := parseOptions(args)
+	if err != nil {
+		return err
+	}
+
 	if !isatty.IsTerminal(os.Stdout.Fd()) {
 		return nil // Exit gracefully when no TTY is available
 	}
 
+	output := &terminalOutput{File: os.Stdout}
+
 	m := initialModel()
+	m.applyStartup(opts)
+	m.alert = newAlertPlayer(output)
+
+	if err := m.openSession(opts.session); err != nil {
+		return err
+	}
+
+	programOptions := []tea.ProgramOption{tea.WithOutput(output), tea.WithMouseCellMotion()}
+	if opts.plain {
+		programOptions = programOptions[:1]
+	}
 
-	app = tea.NewProgram(m)
-	if _, err := app.Run(); err != nil {
+	app = tea.NewProgram(m, programOptions...)
+	final, err := app.Run()
+	if err != nil {
 		return fmt.Errorf("app execution failed: %w", err)
 	}
 
+	if finalModel, ok := final.(*model); ok {
+		return finalModel.closeSession()
+	}
+
 	return nil
 }
 
 // main is the entry point of the application
 func main() {
-	if err := run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if err := run(os.Args[1:]); err != nil && !errors.Is(err, ErrHelp) {
+		if !errors.Is(err, ErrNoMatch) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+
 		os.Exit(1)
 	}
 }
@@ -22,11 +22,13 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/cockroachdb/errors"
 )
 
@@ -48,6 +50,14 @@ func assertModelType(t *testing.T, teaModel tea.Model) *model {
 	return m
 }
 
+// TestMain points the system config at a file that does not exist, so no
+// test depends on the settings of the machine it runs on.
+func TestMain(m *testing.M) {
+	systemConfigPath = filepath.Join(os.TempDir(), "crontab-guru-test-no-system-config", configFileName)
+
+	os.Exit(m.Run())
+}
+
 // TestInitialModel verifies that the model is initialized correctly with:
 // - 5 input fields (minute, hour, day, month, weekday)
 // - First input focused
@@ -317,7 +327,7 @@ func TestView(t *testing.T) {
 		t.Error("View should contain the title")
 	}
 
-	if !strings.Contains(view, "Press ? for help") {
+	if !strings.Contains(view, "? for help") {
 		t.Error("View should contain the default help text")
 	}
 
@@ -391,7 +401,7 @@ func TestRun(t *testing.T) {
 	t.Parallel()
 
 	// Note: This test will exit early in CI environments without TTY
-	err := run()
+	err := run(nil)
 	if err != nil {
 		t.Errorf("run() returned an error: %v", err)
 	}
@@ -799,7 +809,7 @@ func TestIsValidCronPartEdgeCases(t *testing.T) {
 		{"1-5/2", 0, true},     // Range with step
 		{"MON-FRI", 4, true},   // Day range (weekday field)
 		{"JAN", 3, true},       // Month abbreviation (month field)
-		{"JANUARY", 3, true},   // Full month name (contains JAN, month field)
+		{"JANUARY", 3, false},  // Full month name, which cron does not accept
 		{"XYZ", 3, false},      // Invalid abbreviation
 		{"1,2,3,4,5", 0, true}, // Long list
 		{"0", 0, true},         // Zero
@@ -825,14 +835,14 @@ func TestIsValidCronPartMoreEdgeCases(t *testing.T) {
 		fieldIndex int
 		expected   bool
 	}{
-		{"#", 0, false},      // Invalid special character
-		{"@", 0, false},      // Invalid special character
-		{"5-10", 0, true},    // Valid range
-		{"TUE", 4, true},     // Day abbreviation (weekday field)
-		{"TUESDAY", 4, true}, // Contains valid abbreviation (weekday field)
-		{"AB", 3, false},     // Invalid two-letter combo
-		{"1a", 0, false},     // Number with invalid letter
-		{"10x", 0, false},    // Number with trailing invalid letter
+		{"#", 0, false},       // Invalid special character
+		{"@", 0, false},       // Invalid special character
+		{"5-10", 0, true},     // Valid range
+		{"TUE", 4, true},      // Day abbreviation (weekday field)
+		{"TUESDAY", 4, false}, // Full day name, which cron does not accept
+		{"AB", 3, false},      // Invalid two-letter combo
+		{"1a", 0, false},      // Number with invalid letter
+		{"10x", 0, false},     // Number with trailing invalid letter
 	}
 
 	for _, tt := range tests {
@@ -1820,10 +1830,12 @@ func TestValidateLetterValueEdgeCases(t *testing.T) {
 	}{
 		{"J", fieldIndexMonth, false, "single letter J in month field"},
 		{"JA", fieldIndexMonth, false, "partial month abbreviation JA"},
-		{"JANUARY", fieldIndexMonth, true, "full month name"},
+		{"JANUARY", fieldIndexMonth, false, "full month name"},
+		{"JANUARYX", fieldIndexMonth, false, "month name with trailing letters"},
+		{"XJAN", fieldIndexMonth, false, "month name with leading letters"},
 		{"S", fieldIndexWeekday, false, "single letter S in weekday field"},
 		{"SU", fieldIndexWeekday, false, "partial weekday abbreviation SU"},
-		{"SUNDAY", fieldIndexWeekday, true, "full weekday name"},
+		{"SUNDAY", fieldIndexWeekday, false, "full weekday name"},
 		{"XYZ", fieldIndexMonth, false, "invalid letters in month field"},
 		{"ABC", fieldIndexWeekday, false, "invalid letters in weekday field"},
 	}
@@ -2000,8 +2012,8 @@ func TestInitialModelCompleteCoverage(t *testing.T) {
 
 	// Verify all inputs are properly initialized
 	for i, input := range m.inputs {
-		if input.CharLimit != inputCharLimit {
-			t.Errorf("Input %d char limit should be %d, got %d", i, inputCharLimit, input.CharLimit)
+		if input.CharLimit != 0 {
+			t.Errorf("Input %d should not cut values off, got char limit %d", i, input.CharLimit)
 		}
 
 		if input.Width != inputWidth {
@@ -2131,3 +2143,333 @@ func TestNavigationFunctionsEdgeCases(t *testing.T) {
 		}
 	}
 }
+
+// TestScheduleCmdComputesInBackground verifies that editing a field returns a
+// command that delivers the description and next run time as a message.
+func TestScheduleCmdComputesInBackground(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.inputs[0].SetValue("30")
+
+	cmd := m.scheduleCmd()
+	if cmd == nil {
+		t.Fatal("Expected a schedule command for a changed expression")
+	}
+
+	if !m.computing {
+		t.Error("Expected computing to be true while the command is in flight")
+	}
+
+	result, ok := cmd().(scheduleResult)
+	if !ok {
+		t.Fatal("Expected the command to return a scheduleResult")
+	}
+
+	newModel, _ := m.Update(result)
+	m = assertModelType(t, newModel)
+
+	if m.computing {
+		t.Error("Expected computing to be false after the result arrives")
+	}
+
+	if !strings.Contains(m.description, "30") {
+		t.Errorf("Expected description for minute 30, got %q", m.description)
+	}
+
+	if m.nextRun == "" {
+		t.Error("Expected nextRun to be set from the result")
+	}
+
+	// Unchanged expression should not schedule another computation
+	if m.scheduleCmd() != nil {
+		t.Error("Expected no command when the expression is unchanged")
+	}
+}
+
+// TestScheduleCmdInvalidInputIsSynchronous verifies that validation errors are
+// reported immediately without starting a background computation.
+func TestScheduleCmdInvalidInputIsSynchronous(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.inputs[1].SetValue("x")
+
+	if cmd := m.scheduleCmd(); cmd != nil {
+		t.Error("Expected no command for an invalid expression")
+	}
+
+	if m.err == nil || !strings.Contains(m.err.Error(), "hour") {
+		t.Errorf("Expected an hour validation error, got %v", m.err)
+	}
+}
+
+// TestStaleScheduleResultIsDiscarded verifies that a result computed for an
+// expression that has since been edited does not overwrite the current state.
+func TestStaleScheduleResultIsDiscarded(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.inputs[0].SetValue("10")
+	staleCmd := m.scheduleCmd()
+
+	m.inputs[0].SetValue("15")
+	freshCmd := m.scheduleCmd()
+
+	newModel, _ := m.Update(freshCmd())
+	m = assertModelType(t, newModel)
+	freshDescription := m.description
+
+	newModel, _ = m.Update(staleCmd())
+	m = assertModelType(t, newModel)
+
+	if m.description != freshDescription {
+		t.Errorf("Expected stale result to be discarded, description changed to %q", m.description)
+	}
+}
+
+// TestFitInputWidthsGrowsWithValue verifies that input boxes grow to fit their
+// values and fall back to a vertical layout when a value is too long for the row.
+func TestFitInputWidthsGrowsWithValue(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 80
+
+	m.inputs[0].SetValue("0,5,10,15")
+	m.fitInputWidths()
+
+	if m.inputs[0].Width != len("0,5,10,15") {
+		t.Errorf("Expected minute width %d, got %d", len("0,5,10,15"), m.inputs[0].Width)
+	}
+
+	if m.inputs[1].Width != inputWidth {
+		t.Errorf("Expected short values to keep the minimum width %d, got %d", inputWidth, m.inputs[1].Width)
+	}
+
+	if m.verticalLayout() {
+		t.Error("Expected horizontal layout while values fit within maxInputWidth")
+	}
+
+	longValue := "0,5,10,15,20,25,30"
+	m.inputs[0].SetValue(longValue)
+
+	if !m.verticalLayout() {
+		t.Error("Expected vertical layout for a value longer than maxInputWidth")
+	}
+
+	view := m.View()
+	if !strings.Contains(view, longValue) {
+		t.Errorf("Expected the full value %q to be visible in the vertical layout", longValue)
+	}
+
+	if m.renderLabels() != "" {
+		t.Error("Expected the separate label row to be omitted in the vertical layout")
+	}
+}
+
+// TestOverflowIndicatorsAndPeek verifies that values wider than their box are
+// marked with scroll indicators and that ctrl+p reveals the full value.
+func TestOverflowIndicatorsAndPeek(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 36
+
+	longValue := "0,5,10,15,20,25,30,35,40"
+	m.inputs[0].SetValue(longValue)
+	m.inputs[0].CursorStart()
+	m.fitInputWidths()
+
+	hiddenLeft, hiddenRight := m.overflowIndicators(0)
+	if hiddenLeft || !hiddenRight {
+		t.Errorf("Expected only right overflow with cursor at start, got left=%v right=%v", hiddenLeft, hiddenRight)
+	}
+
+	m.inputs[0].CursorEnd()
+
+	hiddenLeft, hiddenRight = m.overflowIndicators(0)
+	if !hiddenLeft || hiddenRight {
+		t.Errorf("Expected only left overflow with cursor at end, got left=%v right=%v", hiddenLeft, hiddenRight)
+	}
+
+	if left, right := m.overflowIndicators(1); left || right {
+		t.Error("Expected no overflow indicators for a short value")
+	}
+
+	if strings.Contains(m.View(), "minute: "+longValue) {
+		t.Error("Expected the peek tooltip to be hidden by default")
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = assertModelType(t, newModel)
+
+	if !m.showPeek {
+		t.Fatal("Expected ctrl+p to enable the peek tooltip")
+	}
+
+	if !strings.Contains(m.View(), "minute: "+longValue) {
+		t.Error("Expected the peek tooltip to show the full field value")
+	}
+}
+
+// TestCompactLayoutForNarrowTerminals verifies that terminals narrower than the
+// horizontal row stack the fields and keep every rendered line within the width.
+func TestCompactLayoutForNarrowTerminals(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+
+	newModel, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m = assertModelType(t, newModel)
+
+	if m.compactLayout() || m.verticalLayout() {
+		t.Error("Expected the horizontal layout on an 80-column terminal")
+	}
+
+	newModel, _ = m.Update(tea.WindowSizeMsg{Width: 40, Height: 24})
+	m = assertModelType(t, newModel)
+
+	if !m.compactLayout() || !m.verticalLayout() {
+		t.Fatal("Expected the compact vertical layout on a 40-column terminal")
+	}
+
+	for _, line := range strings.Split(m.View(), "\n") {
+		if width := lipgloss.Width(line); width > 40 {
+			t.Errorf("Expected lines to fit in 40 columns, got %d: %q", width, line)
+		}
+	}
+}
+
+// TestPreviewLineClickFocusesField verifies that the composed expression is shown
+// under the boxes and that clicking one of its segments focuses that field.
+func TestPreviewLineClickFocusesField(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 80
+
+	view := m.View()
+	if !strings.Contains(view, "20 4 * * *") {
+		t.Fatal("Expected the view to contain the composed expression preview")
+	}
+
+	lines := strings.Split(view, "\n")
+	if !strings.Contains(lines[m.previewRow], "20 4 * * *") {
+		t.Fatalf("Expected preview on row %d, got %q", m.previewRow, lines[m.previewRow])
+	}
+
+	tests := []struct {
+		column   int
+		expected int
+	}{
+		{0, 0}, // "20"
+		{2, 0}, // separator belongs to the segment before it
+		{3, 1}, // "4"
+		{5, 2}, // first "*"
+		{9, 4}, // last "*"
+	}
+
+	for _, tt := range tests {
+		if got := m.segmentAt(tt.column); got != tt.expected {
+			t.Errorf("segmentAt(%d) = %d, expected %d", tt.column, got, tt.expected)
+		}
+	}
+
+	if got := m.segmentAt(20); got != -1 {
+		t.Errorf("Expected -1 for a column past the expression, got %d", got)
+	}
+
+	click := tea.MouseMsg{
+		X:      m.previewCol + 3,
+		Y:      m.previewRow,
+		Action: tea.MouseActionPress,
+		Button: tea.MouseButtonLeft,
+	}
+
+	newModel, _ := m.Update(click)
+	m = assertModelType(t, newModel)
+
+	if m.focusIndex != 1 || !m.inputs[1].Focused() || m.inputs[0].Focused() {
+		t.Errorf("Expected a click on the hour segment to focus field 1, got %d", m.focusIndex)
+	}
+
+	click.Y = m.previewRow + 1
+
+	newModel, _ = m.Update(click)
+	m = assertModelType(t, newModel)
+
+	if m.focusIndex != 1 {
+		t.Errorf("Expected clicks outside the preview line to be ignored, focus moved to %d", m.focusIndex)
+	}
+}
+
+// TestParseOptions verifies command-line flag parsing, including the --plain flag
+// and rejection of unknown flags.
+func TestParseOptions(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), configFileName)
+
+	opts, err := parseOptions([]string{"--config", configPath})
+	if err != nil || opts.plain {
+		t.Errorf("Expected default options without error, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseOptions([]string{"--config", configPath, "--plain"})
+	if err != nil || !opts.plain {
+		t.Errorf("Expected --plain to enable plain mode, got %+v, %v", opts, err)
+	}
+
+	if _, err := parseOptions([]string{"--bogus"}); err == nil {
+		t.Error("Expected an error for an unknown flag")
+	}
+
+	if err := run([]string{"--bogus"}); err == nil {
+		t.Error("Expected run to report invalid arguments")
+	}
+}
+
+// TestRenderPlain verifies that plain mode renders labeled lines without
+// borders, colors, or centering.
+func TestRenderPlain(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.plain = true
+	m.width = 80
+
+	view := m.View()
+
+	for _, expected := range []string{
+		"description: At 04:20 AM",
+		"> minute: 20",
+		"  hour: 4",
+		"  weekday: *",
+		"expression: 20 4 * * *",
+		"Allowed values: 0-59",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected plain view to contain %q, got:\n%s", expected, view)
+		}
+	}
+
+	for _, decoration := range []string{"╭", "│", "\x1b["} {
+		if strings.Contains(view, decoration) {
+			t.Errorf("Expected plain view to contain no decoration %q", decoration)
+		}
+	}
+
+	m.inputs[0].SetValue("x")
+	m.updateDescription()
+	m.showHelp = true
+
+	view = m.View()
+	if !strings.Contains(view, "error: invalid value in field: minute") {
+		t.Errorf("Expected plain view to show the error, got:\n%s", view)
+	}
+
+	if !strings.Contains(view, "shift+tab: previous field") {
+		t.Error("Expected plain view to include help text when toggled")
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMarkdownRuns = 5                          // Next runs listed in the table
+	defaultSummaryRuns  = 10                         // Next runs listed in a summary
+	markdownTimeLayout  = "Mon 2006-01-02 15:04"     // Layout of the run times in the table
+	summaryTimeLayout   = "Mon 2006-01-02 15:04 MST" // Layout of the run times in a summary, with the zone
+)
+
+// renderMarkdown renders a snippet for READMEs and runbooks: the expression
+// in a code block, its description as a blockquote, and a table of the next
+// count runs after now. Jobs given a name get it as a bold title.
+func renderMarkdown(job exportJob, now time.Time, count int) (string, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return "", fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	now = now.In(location)
+
+	result, err := explainSpec(cronSpec{fields: job.fields}, now)
+	if err != nil {
+		return "", err
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(job.fields, " "))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	var builder strings.Builder
+
+	if job.name != defaultExportName {
+		fmt.Fprintf(&builder, "**%s**\n\n", job.name)
+	}
+
+	fmt.Fprintf(&builder, "```cron\n%s\n```\n\n", strings.Join(job.fields, " "))
+	fmt.Fprintf(&builder, "> %s\n\n", result.description)
+	fmt.Fprintf(&builder, "| # | Next run (%s) |\n", job.timezone)
+	builder.WriteString("| --- | --- |\n")
+
+	next := now
+	for index := range count {
+		if next = schedule.Next(next); next.IsZero() {
+			break
+		}
+
+		fmt.Fprintf(&builder, "| %d | %s |\n", index+1, next.Format(markdownTimeLayout))
+	}
+
+	return builder.String(), nil
+}
+
+// renderSummary renders a block to paste into a pull request or incident
+// document: the expression with its description, a table of the next count
+// runs after now in the job's time zone, with UTC beside them for readers
+// elsewhere, and when the runs were listed, since they go stale
+func renderSummary(job exportJob, now time.Time, count int) (string, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return "", fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	expr := strings.Join(job.fields, " ")
+
+	result, err := explainSpec(cronSpec{fields: job.fields}, now.In(location))
+	if err != nil {
+		return "", err
+	}
+
+	schedule, err := standardParser.Parse(expr)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	title := "Schedule"
+	if job.name != defaultExportName {
+		title = job.name
+	}
+
+	utc := location != time.UTC
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "**%s:** `%s`, %s\n\n", title, expr, lowerFirst(result.description))
+
+	if utc {
+		fmt.Fprintf(&builder, "| # | Run (%s) | UTC |\n| --- | --- | --- |\n", job.timezone)
+	} else {
+		builder.WriteString("| # | Run (UTC) |\n| --- | --- |\n")
+	}
+
+	for index, run := range nextTimes(schedule, now.In(location), time.Time{}, count) {
+		fmt.Fprintf(&builder, "| %d | %s |", index+1, run.Format(summaryTimeLayout))
+
+		if utc {
+			fmt.Fprintf(&builder, " %s |", run.UTC().Format(markdownTimeLayout))
+		}
+
+		builder.WriteString("\n")
+	}
+
+	fmt.Fprintf(&builder, "\n_Next runs as of %s, see [crontab.guru](%s)._\n",
+		now.UTC().Format(summaryTimeLayout), guruLink(expr))
+
+	return builder.String(), nil
+}
+
+// lowerFirst lowercases the first letter of a description so it reads on
+// after a comma, leaving words like AM alone
+func lowerFirst(text string) string {
+	if len(text) > 1 && text[1] >= 'a' && text[1] <= 'z' {
+		return strings.ToLower(text[:1]) + text[1:]
+	}
+
+	return text
+}
+
+// renderSummaryExport renders a summary listing the default number of runs from now
+func renderSummaryExport(job exportJob) (string, error) {
+	return renderSummary(job, time.Now(), defaultSummaryRuns)
+}
+
+// renderMarkdownExport renders a snippet listing the default number of runs from now
+func renderMarkdownExport(job exportJob) (string, error) {
+	return renderMarkdown(job, time.Now(), defaultMarkdownRuns)
+}
+
+// runMarkdown prints a Markdown snippet describing an expression
+func runMarkdown(args []string, stdout, stderr io.Writer) error {
+	var (
+		name, timezone string
+		count          int
+	)
+
+	flags := flag.NewFlagSet("markdown", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&name, "name", defaultExportName, "title shown above the snippet")
+	flags.StringVar(&timezone, "timezone", defaultExportZone, "IANA time zone the schedule is read in")
+	flags.IntVar(&count, "runs", defaultMarkdownRuns, "next runs listed in the table")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru markdown [--runs N] [--name NAME] [--timezone ZONE] EXPRESSION", ErrUsage)
+	}
+
+	if count < 1 {
+		return fmt.Errorf("%w: --runs must be at least 1", ErrUsage)
+	}
+
+	job, err := newExportJob(strings.Join(positional, " "), name, defaultExportCommand, timezone)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderMarkdown(job, time.Now(), count)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, rendered)
+
+	return nil
+}
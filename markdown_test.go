@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRenderMarkdown verifies the code block, description, and table of
+// next runs, read in the job's time zone.
+func TestRenderMarkdown(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "Nightly backup", "backup.sh", "America/Bogota")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rendered, err := renderMarkdown(job, time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC), 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "**Nightly backup**\n\n" +
+		"```cron\n30 2 * * 1-5\n```\n\n" +
+		"> At 02:30 AM, Monday through Friday\n\n" +
+		"| # | Next run (America/Bogota) |\n" +
+		"| --- | --- |\n" +
+		"| 1 | Mon 2025-03-10 02:30 |\n" +
+		"| 2 | Tue 2025-03-11 02:30 |\n" +
+		"| 3 | Wed 2025-03-12 02:30 |\n"
+
+	if rendered != expected {
+		t.Errorf("Unexpected snippet:\n%s\nexpected:\n%s", rendered, expected)
+	}
+}
+
+// TestRunMarkdown verifies the subcommand output and its errors.
+func TestRunMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	if err := runMarkdown([]string{"--runs", "2", "0 9 * * *"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(stdout.String(), "**") || !strings.Contains(stdout.String(), "\n| 2 | ") ||
+		strings.Contains(stdout.String(), "\n| 3 | ") {
+		t.Errorf("Expected an untitled snippet with two runs, got:\n%s", stdout.String())
+	}
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{nil, ErrUsage},
+		{[]string{"--runs", "0", "0 9 * * *"}, ErrUsage},
+		{[]string{"0 25 * * *"}, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		if err := runMarkdown(tt.args, io.Discard, io.Discard); !errors.Is(err, tt.expected) {
+			t.Errorf("runMarkdown(%q) = %v, expected %v", tt.args, err, tt.expected)
+		}
+	}
+}
+
+// TestRenderSummary verifies the title, description, runs in the job's
+// time zone with UTC beside them across a daylight saving change, and the
+// footer with the time the runs were listed.
+func TestRenderSummary(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "Nightly backup", "backup.sh", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rendered, err := renderSummary(job, time.Date(2025, time.October, 23, 12, 0, 0, 0, time.UTC), 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "**Nightly backup:** `30 2 * * 1-5`, at 02:30 AM, Monday through Friday\n\n" +
+		"| # | Run (Europe/Lisbon) | UTC |\n" +
+		"| --- | --- | --- |\n" +
+		"| 1 | Fri 2025-10-24 02:30 WEST | Fri 2025-10-24 01:30 |\n" +
+		"| 2 | Mon 2025-10-27 02:30 WET | Mon 2025-10-27 02:30 |\n" +
+		"| 3 | Tue 2025-10-28 02:30 WET | Tue 2025-10-28 02:30 |\n\n" +
+		"_Next runs as of Thu 2025-10-23 12:00 UTC, see [crontab.guru](https://crontab.guru/#30_2_*_*_1-5)._\n"
+
+	if rendered != expected {
+		t.Errorf("Unexpected summary:\n%s\nexpected:\n%s", rendered, expected)
+	}
+}
+
+// TestRenderSummaryUTC verifies that a summary in UTC has no second column
+// and an untitled job is called a schedule.
+func TestRenderSummaryUTC(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	if err := runExport([]string{"--format", "summary", "0 9 * * *"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	summary := stdout.String()
+	if !strings.HasPrefix(summary, "**Schedule:** `0 9 * * *`, at 09:00 AM\n") || strings.Contains(summary, "| UTC |") ||
+		!strings.Contains(summary, "\n| 10 | ") || strings.Contains(summary, "\n| 11 | ") {
+		t.Errorf("Expected an untitled summary of ten runs in UTC, got:\n%s", summary)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	minMatchYear = 1970 // Earliest year a year field accepts
+	maxMatchYear = 2199 // Latest year a year field accepts, as AWS allows
+)
+
+// ErrNoMatch is returned when a time does not match a schedule. It exits
+// with status 1 without a message, so match reads like test in scripts.
+var ErrNoMatch = errors.New("no match") //nolint:gochecknoglobals
+
+// matchesSpec reports whether a schedule runs in the minute holding at,
+// counting the year field of dialects that have one
+func matchesSpec(spec cronSpec, at time.Time) (bool, error) {
+	schedule, err := specSchedule(spec)
+	if err != nil {
+		return false, err
+	}
+
+	if spec.year != "" {
+		if ok, err := yearMatches(spec.year, at.Year()); err != nil || !ok {
+			return false, err
+		}
+	}
+
+	minute := at.Truncate(time.Minute)
+
+	return schedule.Next(minute.Add(-time.Nanosecond)).Before(minute.Add(time.Minute)), nil
+}
+
+// yearMatches reports whether a year field such as "2025-2030/2,2040"
+// selects year
+func yearMatches(field string, year int) (bool, error) {
+	invalid := fmt.Errorf("%w: year %q", ErrInvalidValue, field)
+
+	for item := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepPart)
+			if err != nil || parsed < 1 {
+				return false, invalid
+			}
+
+			step = parsed
+		}
+
+		start, end := minMatchYear, maxMatchYear
+
+		if rangePart != "*" {
+			low, high, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if start, err = strconv.Atoi(low); err != nil {
+				return false, invalid
+			}
+
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(high); err != nil {
+					return false, invalid
+				}
+			} else if hasStep {
+				end = maxMatchYear
+			}
+		}
+
+		if start < minMatchYear || end > maxMatchYear || start > end {
+			return false, invalid
+		}
+
+		if year >= start && year <= end && (year-start)%step == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// runMatch exits successfully when a time, now unless --at is given, falls
+// in a minute the schedule runs, and with ErrNoMatch otherwise
+func runMatch(args []string, _, stderr io.Writer) error {
+	var from, seed, timezone, at string
+
+	flags := flag.NewFlagSet("match", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&at, "at", "", "time to check, e.g. 2025-06-01T03:00:00Z, now by default")
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&timezone, "timezone", "", "IANA time zone the schedule is read in, local by default")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru match [--at TIME] [--dialect DIALECT] [--timezone ZONE] EXPRESSION", ErrUsage)
+	}
+
+	query, err := readServeQuery(strings.Join(positional, " "), from, seed, timezone, time.Local)
+	if err != nil {
+		return err
+	}
+
+	instant := time.Now()
+	if at != "" {
+		var ok bool
+		if instant, ok = parseLayoutTime(at, query.location); !ok {
+			return fmt.Errorf("%w: --at %q is not a time like 2025-06-01T03:00:00Z", ErrUsage, at)
+		}
+	}
+
+	matched, err := matchesSpec(query.spec, instant.In(query.location))
+	if err != nil {
+		return err
+	}
+
+	if !matched {
+		return ErrNoMatch
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestYearMatches verifies years, ranges, steps, and lists, and that
+// malformed or out-of-range items are rejected.
+func TestYearMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		field    string
+		year     int
+		expected bool
+	}{
+		{"*", 2025, true},
+		{"2025", 2025, true},
+		{"2025", 2026, false},
+		{"2024-2026", 2026, true},
+		{"2025-2035/5", 2030, true},
+		{"2025-2035/5", 2031, false},
+		{"2025/2", 2027, true},
+		{"2020,2025", 2025, true},
+	}
+
+	for _, test := range tests {
+		if matched, err := yearMatches(test.field, test.year); err != nil || matched != test.expected {
+			t.Errorf("yearMatches(%q, %d) = %v, %v, expected %v", test.field, test.year, matched, err, test.expected)
+		}
+	}
+
+	for _, field := range []string{"1969", "2030-2020", "20x5", "2025/0"} {
+		if _, err := yearMatches(field, 2025); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("yearMatches(%q) = %v, expected ErrInvalidValue", field, err)
+		}
+	}
+}
+
+// TestRunMatch verifies that a time matches anywhere in a minute the
+// schedule runs, in the schedule's zone and dialect, and that a miss is
+// ErrNoMatch.
+func TestRunMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{[]string{"--at", "2025-06-01T03:00:00Z", "--timezone", "UTC", "0 3 * * *"}, nil},
+		{[]string{"--at", "2025-06-01T03:00:59Z", "--timezone", "UTC", "0 3 * * *"}, nil},
+		{[]string{"--at", "2025-06-01T03:01:00Z", "--timezone", "UTC", "0 3 * * *"}, ErrNoMatch},
+		{[]string{"--at", "2025-06-01T03:00:00Z", "--timezone", "Europe/Lisbon", "0 4 * * *"}, nil},
+		{[]string{"--at", "2025-06-01 04:00", "--timezone", "Europe/Lisbon", "0 4 * * *"}, nil},
+		{[]string{"--at", "2025-06-02T12:00:30Z", "--timezone", "UTC", "--dialect", "quartz", "0 0 12 ? * MON 2025"}, nil},
+		{[]string{"--at", "2026-06-01T12:00:00Z", "--timezone", "UTC", "--dialect", "quartz", "0 0 12 ? * MON 2025"}, ErrNoMatch},
+		{[]string{"--at", "2030-01-01T00:00:00Z", "--timezone", "UTC", "--dialect", "aws", "cron(0 0 1 1 ? 2025-2035/5)"}, nil},
+		{[]string{"--at", "soon", "@daily"}, ErrUsage},
+		{[]string{"--at", "2025-06-01T03:00:00Z", "61 * * * *"}, ErrInvalidValue},
+		{nil, ErrUsage},
+	}
+
+	for _, test := range tests {
+		if err := runMatch(test.args, io.Discard, io.Discard); !errors.Is(err, test.expected) {
+			t.Errorf("runMatch(%q) = %v, expected %v", test.args, err, test.expected)
+		}
+	}
+}
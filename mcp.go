@@ -0,0 +1,339 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+)
+
+const (
+	mcpProtocolVersion  = "2025-06-18" // Latest Model Context Protocol version spoken
+	maxMCPMessageLength = 1 << 20      // Longest message read from the client
+	mcpParseError       = -32700       // JSON-RPC code for a message that is not JSON
+	mcpInvalidRequest   = -32600       // JSON-RPC code for a message that is not a request
+	mcpMethodNotFound   = -32601       // JSON-RPC code for an unknown method
+	mcpInvalidParams    = -32602       // JSON-RPC code for parameters that do not fit the method
+)
+
+// mcpProtocolVersions are the protocol versions a client may ask for, newest first
+//
+//nolint:gochecknoglobals
+var mcpProtocolVersions = []string{mcpProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// mcpRequest is a JSON-RPC request or notification from the client
+type mcpRequest struct {
+	JSONRPC string          `json:"jsonrpc"`          // Always "2.0"
+	ID      json.RawMessage `json:"id,omitempty"`     // Request ID echoed in the response, absent for notifications
+	Method  string          `json:"method"`           // Method called, e.g. "tools/call"
+	Params  json.RawMessage `json:"params,omitempty"` // Method parameters
+}
+
+// mcpResponse is a JSON-RPC response holding either a result or an error
+type mcpResponse struct {
+	JSONRPC string          `json:"jsonrpc"`          // Always "2.0"
+	ID      json.RawMessage `json:"id"`               // ID of the request answered, null when it could not be read
+	Result  any             `json:"result,omitempty"` // Result of a successful call
+	Error   *mcpError       `json:"error,omitempty"`  // Why the call failed
+}
+
+// mcpError is the error of a failed JSON-RPC call
+type mcpError struct {
+	Code    int    `json:"code"`    // JSON-RPC error code
+	Message string `json:"message"` // What went wrong
+}
+
+// mcpArguments are the arguments of every tool; each reads the ones it needs
+type mcpArguments struct {
+	Expr     string `json:"expr"`     // Expression to read
+	Dialect  string `json:"dialect"`  // Dialect of the expression, standard when empty
+	Seed     string `json:"seed"`     // Jenkins job name used to resolve H tokens
+	Timezone string `json:"timezone"` // Time zone runs are given in
+	Count    int    `json:"count"`    // Runs listed by next
+	To       string `json:"to"`       // Dialect convert translates to
+}
+
+// mcpConversion is the result of the convert tool
+type mcpConversion struct {
+	Expression string   `json:"expression"`      // Expression in the target dialect
+	Standard   string   `json:"standard"`        // Equivalent five-field standard expression
+	Notes      []string `json:"notes,omitempty"` // Explanations of lossy or noteworthy translations
+}
+
+// mcpTool is a tool offered to the client
+type mcpTool struct {
+	Name        string                                    `json:"name"`        // Name the client calls the tool by
+	Description string                                    `json:"description"` // What the tool does, read by the assistant
+	InputSchema map[string]any                            `json:"inputSchema"` // JSON Schema of the arguments
+	call        func(arguments mcpArguments) (any, error) // Runs the tool
+}
+
+// mcpServer answers Model Context Protocol requests with the same
+// description, validation, and conversion code as the command line
+type mcpServer struct {
+	location *time.Location   // Time zone runs are given in without a timezone argument
+	now      func() time.Time // Current time, replaced in tests
+}
+
+// mcpSchema describes tool arguments as a JSON Schema object
+func mcpSchema(required []string, properties map[string]any) map[string]any {
+	return map[string]any{"type": "object", "properties": properties, "required": required}
+}
+
+// tools lists the tools the server offers
+func (s *mcpServer) tools() []mcpTool {
+	names := make([]string, 0, len(dialects))
+	for _, candidate := range dialects {
+		names = append(names, string(candidate))
+	}
+
+	expr := map[string]any{"type": "string", "description": `Cron expression, e.g. "30 2 * * 1-5" or "@daily"`}
+	dialectName := map[string]any{"type": "string", "enum": names, "description": "Dialect of the expression, standard when left out"}
+	seed := map[string]any{"type": "string", "description": "Jenkins job name used to resolve H tokens"}
+	timezone := map[string]any{"type": "string", "description": "IANA time zone runs are given in, e.g. Europe/Lisbon"}
+
+	return []mcpTool{
+		{
+			Name:        "describe",
+			Description: "Describe a cron expression in plain English and give its next run.",
+			InputSchema: mcpSchema([]string{"expr"}, map[string]any{"expr": expr, "dialect": dialectName, "seed": seed, "timezone": timezone}),
+			call: func(arguments mcpArguments) (any, error) {
+				query, err := readServeQuery(arguments.Expr, arguments.Dialect, arguments.Seed, arguments.Timezone, s.location)
+				if err != nil {
+					return nil, err
+				}
+
+				return query.describe(s.now())
+			},
+		},
+		{
+			Name:        "next",
+			Description: "List the next runs of a cron expression as RFC 3339 times.",
+			InputSchema: mcpSchema([]string{"expr"}, map[string]any{
+				"expr": expr, "dialect": dialectName, "seed": seed, "timezone": timezone,
+				"count": map[string]any{"type": "integer", "minimum": 1, "maximum": maxServeRuns, "description": "Runs to list, 5 when left out"},
+			}),
+			call: func(arguments mcpArguments) (any, error) {
+				query, err := readServeQuery(arguments.Expr, arguments.Dialect, arguments.Seed, arguments.Timezone, s.location)
+				if err != nil {
+					return nil, err
+				}
+
+				if arguments.Count == 0 {
+					arguments.Count = defaultServeRuns
+				}
+
+				return query.nextRuns(context.Background(), s.now(), arguments.Count)
+			},
+		},
+		{
+			Name:        "validate",
+			Description: "Check whether a cron expression is valid in its dialect and say why not.",
+			InputSchema: mcpSchema([]string{"expr"}, map[string]any{"expr": expr, "dialect": dialectName, "seed": seed}),
+			call: func(arguments mcpArguments) (any, error) {
+				return validateServeExpression(arguments.Expr, arguments.Dialect, arguments.Seed, s.location)
+			},
+		},
+		{
+			Name:        "convert",
+			Description: "Convert a cron expression between dialects such as standard, Quartz, AWS, and Jenkins, with notes on anything that changes meaning.",
+			InputSchema: mcpSchema([]string{"expr", "to"}, map[string]any{
+				"expr": expr, "dialect": dialectName, "seed": seed,
+				"to": map[string]any{"type": "string", "enum": names, "description": "Dialect to convert to"},
+			}),
+			call: func(arguments mcpArguments) (any, error) {
+				target, err := parseDialect(arguments.To)
+				if err != nil {
+					return nil, err
+				}
+
+				from := dialectStandard
+				if arguments.Dialect != "" {
+					if from, err = parseDialect(arguments.Dialect); err != nil {
+						return nil, err
+					}
+				}
+
+				result, err := convertExpression(arguments.Expr, from, target, arguments.Seed)
+				if err != nil {
+					return nil, err
+				}
+
+				return mcpConversion{Expression: result.expression, Standard: result.standard, Notes: result.notes}, nil
+			},
+		},
+	}
+}
+
+// handle answers one message, returning nil for notifications
+func (s *mcpServer) handle(line []byte) *mcpResponse {
+	var request mcpRequest
+	if err := json.Unmarshal(line, &request); err != nil {
+		return &mcpResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &mcpError{Code: mcpParseError, Message: err.Error()}}
+	}
+
+	// Notifications, such as notifications/initialized, get no answer
+	if len(request.ID) == 0 {
+		return nil
+	}
+
+	response := &mcpResponse{JSONRPC: "2.0", ID: request.ID}
+
+	result, failure := s.call(request)
+	if failure != nil {
+		response.Error = failure
+	} else {
+		response.Result = result
+	}
+
+	return response
+}
+
+// call runs the method of a request
+func (s *mcpServer) call(request mcpRequest) (any, *mcpError) {
+	if request.JSONRPC != "2.0" || request.Method == "" {
+		return nil, &mcpError{Code: mcpInvalidRequest, Message: "not a JSON-RPC 2.0 request"}
+	}
+
+	switch request.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"` // Version the client asks for
+		}
+
+		_ = json.Unmarshal(request.Params, &params)
+
+		// Answer with the client's version when spoken, or else the latest
+		protocol := mcpProtocolVersion
+		if slices.Contains(mcpProtocolVersions, params.ProtocolVersion) {
+			protocol = params.ProtocolVersion
+		}
+
+		return map[string]any{
+			"protocolVersion": protocol,
+			"capabilities":    map[string]any{"tools": map[string]any{}},
+			"serverInfo":      map[string]any{"name": "crontab-guru", "version": version},
+			"instructions":    "Use these tools to describe, validate, list the runs of, and convert cron expressions instead of working them out by hand.",
+		}, nil
+	case "ping":
+		return map[string]any{}, nil
+	case "tools/list":
+		return map[string]any{"tools": s.tools()}, nil
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`      // Tool called
+			Arguments json.RawMessage `json:"arguments"` // Tool arguments
+		}
+
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &mcpError{Code: mcpInvalidParams, Message: err.Error()}
+		}
+
+		index := slices.IndexFunc(s.tools(), func(tool mcpTool) bool { return tool.Name == params.Name })
+		if index < 0 {
+			return nil, &mcpError{Code: mcpInvalidParams, Message: fmt.Sprintf("unknown tool %q", params.Name)}
+		}
+
+		var arguments mcpArguments
+		if len(params.Arguments) > 0 {
+			if err := json.Unmarshal(params.Arguments, &arguments); err != nil {
+				return nil, &mcpError{Code: mcpInvalidParams, Message: err.Error()}
+			}
+		}
+
+		return mcpToolResult(s.tools()[index].call(arguments)), nil
+	}
+
+	return nil, &mcpError{Code: mcpMethodNotFound, Message: fmt.Sprintf("unknown method %q", request.Method)}
+}
+
+// mcpToolResult wraps a tool's result as JSON text and structured content.
+// A failed tool, such as one given an invalid expression, is reported to the
+// assistant as a result so it can correct itself, not as a protocol error.
+func mcpToolResult(result any, err error) map[string]any {
+	if err != nil {
+		return map[string]any{"content": []map[string]any{{"type": "text", "text": err.Error()}}, "isError": true}
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return map[string]any{"content": []map[string]any{{"type": "text", "text": err.Error()}}, "isError": true}
+	}
+
+	return map[string]any{
+		"content":           []map[string]any{{"type": "text", "text": string(data)}},
+		"structuredContent": result,
+		"isError":           false,
+	}
+}
+
+// serve reads one message per line from reader and writes the answers to
+// writer, one per line, until reader ends
+func (s *mcpServer) serve(reader io.Reader, writer io.Writer) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, maxMCPMessageLength)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		response := s.handle(scanner.Bytes())
+		if response == nil {
+			continue
+		}
+
+		data, err := json.Marshal(response)
+		if err != nil {
+			return fmt.Errorf("failed to write a response: %w", err)
+		}
+
+		if _, err := fmt.Fprintf(writer, "%s\n", data); err != nil {
+			return fmt.Errorf("failed to write a response: %w", err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read a request: %w", err)
+	}
+
+	return nil
+}
+
+// runMCP serves the describe, next, validate, and convert tools over the
+// Model Context Protocol on stdin and stdout, for coding assistants that
+// start it as a local server
+func runMCP(args []string, stdout, stderr io.Writer) error {
+	var timezone string
+
+	flags := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone runs are given in without a timezone argument")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 0 {
+		return fmt.Errorf("%w: crontab-guru mcp [--timezone ZONE]", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	server := &mcpServer{location: location, now: time.Now}
+
+	return server.serve(os.Stdin, stdout)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// mcpSession sends messages to a server, one per line, and decodes the
+// responses
+func mcpSession(t *testing.T, messages ...string) []mcpResponse {
+	t.Helper()
+
+	server := &mcpServer{
+		location: time.UTC,
+		now:      func() time.Time { return time.Date(2026, time.October, 15, 12, 0, 30, 0, time.UTC) },
+	}
+
+	var output bytes.Buffer
+	if err := server.serve(strings.NewReader(strings.Join(messages, "\n")+"\n"), &output); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var responses []mcpResponse
+
+	for line := range strings.Lines(output.String()) {
+		var response mcpResponse
+		if err := json.Unmarshal([]byte(line), &response); err != nil {
+			t.Fatalf("Invalid response %q: %v", line, err)
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// mcpToolText returns the text content of a tools/call result and whether
+// the tool failed
+func mcpToolText(t *testing.T, response mcpResponse) (string, bool) {
+	t.Helper()
+
+	result, ok := response.Result.(map[string]any)
+	if !ok || response.Error != nil {
+		t.Fatalf("Expected a tool result, got %+v", response)
+	}
+
+	content, _ := result["content"].([]any)
+	if len(content) != 1 {
+		t.Fatalf("Expected one content item, got %+v", result)
+	}
+
+	text, _ := content[0].(map[string]any)["text"].(string)
+	failed, _ := result["isError"].(bool)
+
+	return text, failed
+}
+
+// TestMCPHandshake verifies the initialize handshake with version
+// negotiation, that notifications get no answer, and that the tools are
+// listed with their schemas.
+func TestMCPHandshake(t *testing.T) {
+	t.Parallel()
+
+	responses := mcpSession(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":"three","method":"initialize","params":{"protocolVersion":"1999-01-01"}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"ping"}`,
+	)
+
+	if len(responses) != 4 {
+		t.Fatalf("Expected four responses, got %+v", responses)
+	}
+
+	initialize, _ := responses[0].Result.(map[string]any)
+	if string(responses[0].ID) != "1" || initialize["protocolVersion"] != "2025-03-26" {
+		t.Errorf("Expected the client's version, got %+v", responses[0])
+	}
+
+	tools, _ := responses[1].Result.(map[string]any)["tools"].([]any)
+
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.(map[string]any)["name"].(string))
+
+		if _, ok := tool.(map[string]any)["inputSchema"].(map[string]any); !ok {
+			t.Errorf("Tool without a schema: %+v", tool)
+		}
+	}
+
+	if strings.Join(names, ",") != "describe,next,validate,convert" {
+		t.Errorf("Unexpected tools %q", names)
+	}
+
+	if initialize, _ = responses[2].Result.(map[string]any); string(responses[2].ID) != `"three"` ||
+		initialize["protocolVersion"] != mcpProtocolVersion {
+		t.Errorf("Expected the latest version for an unknown one, got %+v", responses[2])
+	}
+
+	if responses[3].Error != nil || responses[3].Result == nil {
+		t.Errorf("Expected ping to answer, got %+v", responses[3])
+	}
+}
+
+// TestMCPTools verifies each tool's result, and that an invalid expression
+// is a failed tool result rather than a protocol error.
+func TestMCPTools(t *testing.T) {
+	t.Parallel()
+
+	responses := mcpSession(t,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"describe","arguments":{"expr":"30 2 * * 1-5"}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"next","arguments":{"expr":"@hourly","count":2,"timezone":"Europe/Lisbon"}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"validate","arguments":{"expr":"0 0 12 * * MON","dialect":"quartz"}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"convert","arguments":{"expr":"0 12 * * 1","to":"quartz"}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"describe","arguments":{"expr":"61 * * * *"}}}`,
+	)
+
+	expected := []string{
+		`{"expression":"30 2 * * 1-5","description":"At 02:30 AM, Monday through Friday","next":"2026-10-16T02:30:00Z"}`,
+		`{"expression":"0 * * * *","timezone":"Europe/Lisbon","runs":["2026-10-15T14:00:00+01:00","2026-10-15T15:00:00+01:00"]}`,
+		`{"valid":false,"error":"unsupported syntax: quartz needs \"?\" in exactly one of the day and weekday fields",` +
+			`"diagnostics":[{"code":"unsupported-syntax","severity":"error","span":{"start":0,"end":14},` +
+			`"message":"unsupported syntax: quartz needs \"?\" in exactly one of the day and weekday fields"}]}`,
+		`{"expression":"0 0 12 ? * 2","standard":"0 12 * * 1","notes":["weekday \"1\" renumbered to \"2\": quartz counts Sunday as 1"]}`,
+	}
+
+	for index, want := range expected {
+		if text, failed := mcpToolText(t, responses[index]); text != want || failed {
+			t.Errorf("Call %d = %s (failed %v), expected %s", index+1, text, failed, want)
+		}
+	}
+
+	if text, failed := mcpToolText(t, responses[4]); !failed || !strings.Contains(text, "61") {
+		t.Errorf("Expected a failed tool result, got %q", text)
+	}
+}
+
+// TestMCPErrors verifies the JSON-RPC errors for malformed messages, unknown
+// methods, and unknown tools, and that the server keeps going after them.
+func TestMCPErrors(t *testing.T) {
+	t.Parallel()
+
+	responses := mcpSession(t,
+		`{not json`,
+		`{"id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"delete"}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"next","arguments":{"expr":"@daily","count":"many"}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"ping"}`,
+	)
+
+	codes := []int{mcpParseError, mcpInvalidRequest, mcpMethodNotFound, mcpInvalidParams, mcpInvalidParams}
+	if len(responses) != len(codes)+1 {
+		t.Fatalf("Expected %d responses, got %+v", len(codes)+1, responses)
+	}
+
+	for index, code := range codes {
+		if responses[index].Error == nil || responses[index].Error.Code != code {
+			t.Errorf("Response %d = %+v, expected error %d", index, responses[index], code)
+		}
+	}
+
+	if string(responses[0].ID) != "null" || responses[5].Error != nil {
+		t.Errorf("Unexpected responses %+v", responses)
+	}
+}
+
+// TestRunMCPUsage verifies that arguments and an unknown time zone are
+// rejected.
+func TestRunMCPUsage(t *testing.T) {
+	t.Parallel()
+
+	if err := runMCP([]string{"extra"}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+
+	if err := runMCP([]string{"--timezone", "Mars/Base"}, io.Discard, io.Discard); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Expected ErrInvalidValue, got %v", err)
+	}
+}
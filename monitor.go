@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	healthchecksURL   = "https://healthchecks.io/api/v3/checks/"        // Healthchecks.io management API
+	sentryCheckInURL  = "https://o0.ingest.sentry.io/api/0/cron/%s/%s/" // Sentry cron check-in endpoint
+	sentryPublicKey   = "REPLACE_WITH_DSN_PUBLIC_KEY"                   // Placeholder for the public key from the project DSN
+	monitorSampleRuns = 100                                             // Upcoming runs sampled to find the shortest gap
+	minMonitorGrace   = time.Minute                                     // Shortest grace period suggested
+	maxMonitorGrace   = time.Hour                                       // Longest grace period suggested
+	maxMonitorRuntime = 24 * time.Hour                                  // Longest maximum runtime suggested
+	monitorGraceShare = 4                                               // Grace is this fraction of the gap between runs
+	gapSampleWindow   = 366 * 24 * time.Hour                            // Span of runs sampled to find the longest gap
+	maxGapSampleRuns  = 100000                                          // Most runs sampled to find the longest gap
+	prometheusGroup   = "crontab-guru"                                  // Rule group the Prometheus alert is placed in
+)
+
+// healthchecksCheck is the body of a Healthchecks.io create-check request
+type healthchecksCheck struct {
+	Name     string   `json:"name"`     // Check name shown in the dashboard
+	Desc     string   `json:"desc"`     // Description, set to the job's command
+	Schedule string   `json:"schedule"` // Cron expression the pings are expected on
+	TZ       string   `json:"tz"`       // Time zone the schedule is read in
+	Grace    int      `json:"grace"`    // Seconds to wait past the expected time before alerting
+	Unique   []string `json:"unique"`   // Fields that make the request update an existing check
+}
+
+// sentryMonitorConfig is the monitor_config sent with a Sentry Crons check-in
+type sentryMonitorConfig struct {
+	Schedule struct {
+		Type  string `json:"type"`  // Always "crontab"
+		Value string `json:"value"` // Cron expression
+	} `json:"schedule"` // When check-ins are expected
+	Timezone      string `json:"timezone"`       // Time zone the schedule is read in
+	CheckinMargin int    `json:"checkin_margin"` // Minutes a check-in may be late
+	MaxRuntime    int    `json:"max_runtime"`    // Minutes a run may take before it is marked timed out
+}
+
+// shortestGap returns the shortest time between two of the job's upcoming runs
+// after now, sampling enough runs to cover gaps across days and weekdays
+func shortestGap(job exportJob, now time.Time) (time.Duration, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return 0, fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(job.fields, " "))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	previous := schedule.Next(now.In(location))
+	gap := time.Duration(0)
+
+	for range monitorSampleRuns {
+		next := schedule.Next(previous)
+		if next.IsZero() {
+			break
+		}
+
+		if gap == 0 || next.Sub(previous) < gap {
+			gap = next.Sub(previous)
+		}
+
+		previous = next
+	}
+
+	return gap, nil
+}
+
+// longestGap returns the longest time between two of the job's runs in the
+// year after now, so gaps such as weekends and short months are seen.
+// Schedules running more often than maxGapSampleRuns a year are sampled for
+// that many runs only.
+func longestGap(job exportJob, now time.Time) (time.Duration, error) {
+	location, err := time.LoadLocation(job.timezone)
+	if err != nil {
+		return 0, fmt.Errorf("%w: timezone %q", ErrInvalidValue, job.timezone)
+	}
+
+	schedule, err := standardParser.Parse(strings.Join(job.fields, " "))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrCronParse, err)
+	}
+
+	previous := schedule.Next(now.In(location))
+	end := previous.Add(gapSampleWindow)
+	gap := time.Duration(0)
+
+	for range maxGapSampleRuns {
+		next := schedule.Next(previous)
+		if next.IsZero() {
+			break
+		}
+
+		gap = max(gap, next.Sub(previous))
+
+		if next.After(end) {
+			break
+		}
+
+		previous = next
+	}
+
+	return gap, nil
+}
+
+// formatGap formats a whole number of minutes compactly, e.g. "24h" or "1h30m"
+func formatGap(gap time.Duration) string {
+	text := strings.TrimSuffix(gap.String(), "0s")
+	if strings.HasSuffix(text, "h0m") {
+		text = strings.TrimSuffix(text, "0m")
+	}
+
+	return text
+}
+
+// suggestGrace returns a grace period of a quarter of the gap between runs,
+// rounded to the minute and kept between one minute and one hour, so a late
+// run is flagged before the next one is due
+func suggestGrace(gap time.Duration) time.Duration {
+	return min(max((gap/monitorGraceShare).Round(time.Minute), minMonitorGrace), maxMonitorGrace)
+}
+
+// renderHealthchecks renders a Healthchecks.io create-or-update API call for
+// the job with a grace period suggested from its run frequency
+func renderHealthchecks(job exportJob) (string, error) {
+	gap, err := shortestGap(job, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	grace := suggestGrace(gap)
+
+	body, err := json.Marshal(healthchecksCheck{
+		Name:     job.name,
+		Desc:     job.command,
+		Schedule: strings.Join(job.fields, " "),
+		TZ:       job.timezone,
+		Grace:    int(grace.Seconds()),
+		Unique:   []string{"name"},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode check: %w", err)
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# Healthchecks.io check: runs can be %s apart, so a grace of %s\n", formatGap(gap), formatGap(grace))
+	builder.WriteString("# Creates the check, or updates the one with the same name\n")
+	builder.WriteString("curl --request POST \\\n")
+	builder.WriteString("  --header \"X-Api-Key: $HEALTHCHECKS_API_KEY\" \\\n")
+	fmt.Fprintf(&builder, "  --data %s \\\n", shellQuote(string(body)))
+	fmt.Fprintf(&builder, "  %s\n", healthchecksURL)
+	builder.WriteString("\n")
+	builder.WriteString("# Then ping the check's URL from the response when the job succeeds:\n")
+	fmt.Fprintf(&builder, "#   %s && curl -fsS -m 10 --retry 5 \"$PING_URL\"\n", job.command)
+
+	return builder.String(), nil
+}
+
+// renderSentry renders a Sentry Crons check-in that creates or updates the
+// monitor, with a check-in margin suggested from the job's run frequency
+func renderSentry(job exportJob) (string, error) {
+	gap, err := shortestGap(job, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	var config sentryMonitorConfig
+
+	config.Schedule.Type = "crontab"
+	config.Schedule.Value = strings.Join(job.fields, " ")
+	config.Timezone = job.timezone
+	config.CheckinMargin = int(suggestGrace(gap).Minutes())
+	config.MaxRuntime = int(max(min(gap/2, maxMonitorRuntime), time.Minute).Minutes())
+
+	body, err := json.Marshal(map[string]any{"monitor_config": config, "status": "ok"})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode monitor: %w", err)
+	}
+
+	slug := strings.ReplaceAll(terraformName(job.name), "_", "-")
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# Sentry Crons monitor %q: runs can be %s apart, so a check-in margin of %s\n",
+		slug, formatGap(gap), formatGap(suggestGrace(gap)))
+	builder.WriteString("# and a maximum runtime of half the gap. The first check-in creates the monitor.\n")
+	builder.WriteString("curl --request POST \\\n")
+	builder.WriteString("  --header \"Content-Type: application/json\" \\\n")
+	fmt.Fprintf(&builder, "  --data %s \\\n", shellQuote(string(body)))
+	fmt.Fprintf(&builder, "  %s\n", fmt.Sprintf(sentryCheckInURL, slug, sentryPublicKey))
+
+	return builder.String(), nil
+}
+
+// renderPrometheus renders a Prometheus alerting rule that fires when the job
+// has not succeeded for its longest gap between runs plus a grace period. The
+// job is expected to record the time of its last success, e.g. through a
+// Pushgateway.
+func renderPrometheus(job exportJob) (string, error) {
+	gap, err := longestGap(job, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	grace := suggestGrace(gap)
+	threshold := gap + grace
+	metric := terraformName(job.name) + "_last_success_timestamp_seconds"
+	alert := camelName(job.name)
+	alert = strings.ToUpper(alert[:1]) + alert[1:] + "MissedRun"
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# Prometheus alert for %q: runs can be up to %s apart, so it fires after %s\n",
+		job.name, formatGap(gap), formatGap(threshold))
+	fmt.Fprintf(&builder, "# without a success (%s plus a grace of %s). Record each success, e.g.:\n", formatGap(gap), formatGap(grace))
+	fmt.Fprintf(&builder, "#   %s && echo \"%s $(date +%%s)\" | curl --data-binary @- \"$PUSHGATEWAY_URL/metrics/job/%s\"\n",
+		job.command, metric, terraformName(job.name))
+	builder.WriteString("groups:\n")
+	fmt.Fprintf(&builder, "  - name: %s\n", prometheusGroup)
+	builder.WriteString("    rules:\n")
+	fmt.Fprintf(&builder, "      - alert: %s\n", alert)
+	fmt.Fprintf(&builder, "        expr: time() - %s > %d\n", metric, int(threshold.Seconds()))
+	builder.WriteString("        labels:\n")
+	builder.WriteString("          severity: warning\n")
+	builder.WriteString("        annotations:\n")
+	fmt.Fprintf(&builder, "          summary: %s\n", strconv.Quote(fmt.Sprintf("%s has not succeeded in over %s", job.name, formatGap(threshold))))
+	fmt.Fprintf(&builder, "          description: %s\n", strconv.Quote(fmt.Sprintf("Scheduled %q in %s; runs can be up to %s apart.",
+		strings.Join(job.fields, " "), job.timezone, formatGap(gap))))
+
+	return builder.String(), nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestShortestGap verifies the gap between runs, including gaps that only
+// show up across days, such as the weekend gap being skipped for weekdays.
+func TestShortestGap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Duration
+	}{
+		{"*/5 * * * *", 5 * time.Minute},
+		{"0 9,17 * * *", 8 * time.Hour},
+		{"30 2 * * 1-5", 24 * time.Hour},
+		{"0 9 * * MON", 7 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		job, err := newExportJob(tt.expr, "job", "run.sh", "UTC")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got, _ := shortestGap(job, now); got != tt.expected {
+			t.Errorf("shortestGap(%q) = %v, expected %v", tt.expr, got, tt.expected)
+		}
+	}
+}
+
+// TestLongestGap verifies that the longest gap covers weekends, months, and
+// schedules that only run every few years.
+func TestLongestGap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Duration
+	}{
+		{"*/5 * * * *", 5 * time.Minute},
+		{"30 2 * * 1-5", 72 * time.Hour},
+		{"0 0 31 * *", 61 * 24 * time.Hour},
+		{"0 0 29 2 *", (4*365 + 1) * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		job, err := newExportJob(tt.expr, "job", "run.sh", "UTC")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got, _ := longestGap(job, now); got != tt.expected {
+			t.Errorf("longestGap(%q) = %v, expected %v", tt.expr, got, tt.expected)
+		}
+	}
+}
+
+// TestSuggestGrace verifies that the grace is a quarter of the gap, kept
+// between one minute and one hour.
+func TestSuggestGrace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		gap      time.Duration
+		expected time.Duration
+	}{
+		{time.Minute, time.Minute},
+		{20 * time.Minute, 5 * time.Minute},
+		{2 * time.Hour, 30 * time.Minute},
+		{24 * time.Hour, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := suggestGrace(tt.gap); got != tt.expected {
+			t.Errorf("suggestGrace(%v) = %v, expected %v", tt.gap, got, tt.expected)
+		}
+	}
+
+	if got := formatGap(90 * time.Minute); got != "1h30m" {
+		t.Errorf("formatGap = %q, expected 1h30m", got)
+	}
+}
+
+// TestMonitorExports verifies the Healthchecks.io and Sentry requests and the
+// Prometheus rule carry the schedule, time zone, and suggested grace. The zone has no daylight
+// saving, so the gap does not depend on when the test runs.
+func TestMonitorExports(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("0 */2 * * *", "Nightly backup", "backup.sh", "America/Bogota")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		render   func(exportJob) (string, error)
+		expected []string
+	}{
+		{renderHealthchecks, []string{
+			`"schedule":"0 */2 * * *","tz":"America/Bogota","grace":1800`,
+			`"unique":["name"]`,
+			"X-Api-Key: $HEALTHCHECKS_API_KEY",
+			healthchecksURL,
+		}},
+		{renderSentry, []string{
+			`{"type":"crontab","value":"0 */2 * * *"}`,
+			`"timezone":"America/Bogota","checkin_margin":30,"max_runtime":60`,
+			"/api/0/cron/nightly-backup/",
+		}},
+		{renderPrometheus, []string{
+			"- alert: NightlyBackupMissedRun",
+			"expr: time() - nightly_backup_last_success_timestamp_seconds > 9000",
+			`description: "Scheduled \"0 */2 * * *\" in America/Bogota; runs can be up to 2h apart."`,
+		}},
+	}
+
+	for _, tt := range tests {
+		rendered, err := tt.render(job)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+
+			continue
+		}
+
+		for _, want := range tt.expected {
+			if !strings.Contains(rendered, want) {
+				t.Errorf("Expected %q in:\n%s", want, rendered)
+			}
+		}
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+const (
+	defaultNextRuns    = 5          // Runs printed without --count or --until
+	maxNextRuns        = 100000     // Most runs printed before --until
+	nextFormatUnix     = "unix"     // Seconds since the Unix epoch
+	nextFormatRFC3339  = "rfc3339"  // RFC 3339 with the zone offset
+	nextFormatRelative = "relative" // Time from now, e.g. "in 2h 30m"
+	nextFormatCSV      = "csv"      // RFC 3339 and Unix seconds columns under a header
+)
+
+// nextUntilLayouts are the layouts --until accepts besides a duration
+var nextUntilLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} //nolint:gochecknoglobals
+
+// upcomingRuns yields the runs of a schedule after from, stopping after
+// count runs or, when until is set, at the last run not after it. Each run is
+// found as it is asked for, so long listings can be printed as they go.
+func upcomingRuns(schedule cronparser.Schedule, from, until time.Time, count int) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if count < 1 {
+			return
+		}
+
+		found := 0
+
+		for next := range occurrences(context.Background(), schedule, from) {
+			if !until.IsZero() && next.After(until) || !yield(next) {
+				return
+			}
+
+			if found++; found == count {
+				return
+			}
+		}
+	}
+}
+
+// nextTimes lists the runs of a schedule after from, stopping after count
+// runs or, when until is set, at the last run not after it
+func nextTimes(schedule cronparser.Schedule, from, until time.Time, count int) []time.Time {
+	return slices.Collect(upcomingRuns(schedule, from, until, count))
+}
+
+// parseUntil reads --until as a time in location, in one of
+// nextUntilLayouts, or as a duration from now such as 36h
+func parseUntil(value string, now time.Time, location *time.Location) (time.Time, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(duration), nil
+	}
+
+	if until, ok := parseLayoutTime(value, location); ok {
+		return until, nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: --until %q is neither a duration nor a time like 2006-01-02 15:04", ErrUsage, value)
+}
+
+// parseLayoutTime reads a time in one of nextUntilLayouts, in location
+// unless it has an offset
+func parseLayoutTime(value string, location *time.Location) (time.Time, bool) {
+	for _, layout := range nextUntilLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, location); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// nextFormatter returns a function formatting runs as unix, rfc3339,
+// relative to now, csv rows, or with a Go time layout, e.g. "Mon 15:04"
+func nextFormatter(format string, now time.Time) (func(time.Time) string, error) {
+	switch format {
+	case nextFormatCSV:
+		return func(run time.Time) string { return run.Format(time.RFC3339) + "," + strconv.FormatInt(run.Unix(), 10) }, nil
+	case nextFormatUnix:
+		return func(run time.Time) string { return strconv.FormatInt(run.Unix(), 10) }, nil
+	case nextFormatRFC3339:
+		return func(run time.Time) string { return run.Format(time.RFC3339) }, nil
+	case nextFormatRelative:
+		return func(run time.Time) string { return formatRelative(run.Sub(now)) }, nil
+	}
+
+	// A layout without any element of the reference time prints itself for
+	// a time that differs from it in every element
+	probe := time.Date(2001, time.March, 4, 7, 8, 9, 0, time.UTC)
+	if probe.Format(format) == format {
+		return nil, fmt.Errorf("%w: --format %q is not unix, rfc3339, relative, csv, or a layout like \"2006-01-02 15:04\"",
+			ErrUsage, format)
+	}
+
+	return func(run time.Time) string { return run.Format(format) }, nil
+}
+
+// formatRelative formats the time until a run in its largest units, e.g.
+// "in 1d 2h 30m" or "in 45s", or since a past run, e.g. "2h ago"
+func formatRelative(left time.Duration) string {
+	seconds := int(left.Abs().Round(time.Second).Seconds())
+	units := []struct {
+		size   int
+		suffix string
+	}{{hoursPerDay * 3600, "d"}, {3600, "h"}, {60, "m"}, {1, "s"}}
+
+	var parts []string
+
+	for _, unit := range units {
+		if seconds >= unit.size {
+			parts = append(parts, fmt.Sprintf("%d%s", seconds/unit.size, unit.suffix))
+			seconds %= unit.size
+		}
+	}
+
+	if len(parts) == 0 {
+		return "now"
+	}
+
+	if left < 0 {
+		return strings.Join(parts, " ") + " ago"
+	}
+
+	return "in " + strings.Join(parts, " ")
+}
+
+// csvHeader returns the header row of a format, "time,unix" for csv and ""
+// for the others
+func csvHeader(format string) string {
+	if format == nextFormatCSV {
+		return "time,unix"
+	}
+
+	return ""
+}
+
+// writeRuns prints runs one per line as they are found, under the header
+// unless it is empty, buffering the output so thousands of runs are written
+// in a few large writes
+func writeRuns(out io.Writer, header string, formatRun func(time.Time) string, runs iter.Seq[time.Time]) (int, error) {
+	writer := bufio.NewWriter(out)
+	if header != "" {
+		fmt.Fprintln(writer, header)
+	}
+
+	written := 0
+
+	for run := range runs {
+		fmt.Fprintln(writer, formatRun(run))
+		written++
+	}
+
+	if err := writer.Flush(); err != nil {
+		return written, fmt.Errorf("failed to write the runs: %w", err)
+	}
+
+	return written, nil
+}
+
+// runNext prints the next runs of an expression, one per line, in the format
+// scripts need
+func runNext(args []string, stdout, stderr io.Writer) error {
+	var (
+		from, seed, timezone, format, until, tzList string
+		count                                       int
+	)
+
+	flags := flag.NewFlagSet("next", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.IntVar(&count, "count", 0, "runs printed, 5 by default or every run before --until")
+	flags.StringVar(&format, "format", nextFormatRFC3339, "unix, rfc3339, relative, csv, or a Go time layout")
+	flags.StringVar(&until, "until", "", "print the runs up to this time, or for this long, e.g. 2026-12-31 or 36h")
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&timezone, "timezone", "", "IANA time zone the schedule is read in, local by default")
+	flags.StringVar(&tzList, "tz-list", "", "comma-separated time zones to print each run in, e.g. UTC,Asia/Tokyo")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru next [--count N] [--format FORMAT] [--until TIME] [--tz-list ZONES] EXPRESSION",
+			ErrUsage)
+	}
+
+	countSet := false
+
+	flags.Visit(func(f *flag.Flag) { countSet = countSet || f.Name == "count" })
+
+	if countSet && count < 1 {
+		return fmt.Errorf("%w: --count must be at least 1", ErrUsage)
+	}
+
+	query, err := readServeQuery(strings.Join(positional, " "), from, seed, timezone, time.Local)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(query.location)
+
+	formatRun, err := nextFormatter(format, now)
+	if err != nil {
+		return err
+	}
+
+	header := csvHeader(format)
+
+	if tzList != "" {
+		zones, err := parseZoneList(strings.Split(tzList, ","))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUsage, err)
+		}
+
+		header, formatRun = zonedFormatter(format, formatRun, zones)
+	}
+
+	var end time.Time
+	if until != "" {
+		if end, err = parseUntil(until, now, query.location); err != nil {
+			return err
+		}
+	}
+
+	limit := count
+
+	switch {
+	case !countSet && until == "":
+		limit = defaultNextRuns
+	case !countSet:
+		limit = maxNextRuns
+	}
+
+	schedule, err := specSchedule(query.spec)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Next(now).IsZero() {
+		return fmt.Errorf("%w: %s never runs", ErrInvalidValue, strings.Join(positional, " "))
+	}
+
+	for _, note := range query.notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+
+	written, err := writeRuns(stdout, header, formatRun, upcomingRuns(schedule, now, end, limit))
+	if err != nil {
+		return err
+	}
+
+	if !countSet && written == maxNextRuns {
+		fmt.Fprintf(stderr, "note: stopped after %d runs; use --count for more\n", maxNextRuns)
+	}
+
+	return nil
+}
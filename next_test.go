@@ -0,0 +1,205 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// TestNextTimes verifies that runs stop at the count or at the last run not
+// after the end.
+func TestNextTimes(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := cronparser.NewParser(cronParserOptions).Parse("0 */6 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2026, time.October, 15, 1, 0, 0, 0, time.UTC)
+
+	if runs := nextTimes(schedule, from, time.Time{}, 3); len(runs) != 3 || runs[2].Hour() != 18 {
+		t.Errorf("Expected three runs, got %v", runs)
+	}
+
+	runs := nextTimes(schedule, from, from.Add(23*time.Hour), 100)
+	if len(runs) != 4 || !runs[3].Equal(time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the runs up to midnight, got %v", runs)
+	}
+}
+
+// TestParseUntil verifies that --until takes a duration from now or a time
+// in the schedule's zone.
+func TestParseUntil(t *testing.T) {
+	t.Parallel()
+
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, lisbon)
+
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"36h", now.Add(36 * time.Hour)},
+		{"2026-12-31", time.Date(2026, time.December, 31, 0, 0, 0, 0, lisbon)},
+		{"2026-10-20 08:30", time.Date(2026, time.October, 20, 8, 30, 0, 0, lisbon)},
+		{"2026-10-20T08:30:00Z", time.Date(2026, time.October, 20, 8, 30, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		if until, err := parseUntil(test.value, now, lisbon); err != nil || !until.Equal(test.expected) {
+			t.Errorf("parseUntil(%q) = %v, %v, expected %v", test.value, until, err, test.expected)
+		}
+	}
+
+	if _, err := parseUntil("next week", now, lisbon); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+}
+
+// TestNextFormatter verifies each format and that a layout without any time
+// element is rejected.
+func TestNextFormatter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	run := time.Date(2026, time.October, 16, 14, 30, 0, 0, time.UTC)
+
+	tests := map[string]string{
+		"unix":       "1792161000",
+		"rfc3339":    "2026-10-16T14:30:00Z",
+		"relative":   "in 1d 2h 30m",
+		"csv":        "2026-10-16T14:30:00Z,1792161000",
+		"Mon 15:04":  "Fri 14:30",
+		"2006-01-02": "2026-10-16",
+	}
+
+	for format, expected := range tests {
+		formatRun, err := nextFormatter(format, now)
+		if err != nil {
+			t.Errorf("nextFormatter(%q) failed: %v", format, err)
+
+			continue
+		}
+
+		if formatted := formatRun(run); formatted != expected {
+			t.Errorf("Format %q = %q, expected %q", format, formatted, expected)
+		}
+	}
+
+	if _, err := nextFormatter("json", now); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+
+	if formatted := formatRelative(45 * time.Second); formatted != "in 45s" {
+		t.Errorf("Unexpected relative time %q", formatted)
+	}
+
+	if formatted := formatRelative(-2 * time.Hour); formatted != "2h ago" {
+		t.Errorf("Unexpected past relative time %q", formatted)
+	}
+}
+
+// TestRunNext verifies the default, counted, CSV, and multi-zone output and
+// that bad flags and expressions are rejected.
+func TestRunNext(t *testing.T) {
+	t.Parallel()
+
+	var stdout strings.Builder
+	if err := runNext([]string{"@hourly"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(stdout.String()), "\n"); len(lines) != defaultNextRuns {
+		t.Errorf("Expected %d runs, got %q", defaultNextRuns, lines)
+	}
+
+	stdout.Reset()
+
+	if err := runNext([]string{"--count", "2", "--format", "unix", "0", "0", "*", "*", "*"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if lines := strings.Fields(stdout.String()); len(lines) != 2 || !strings.HasSuffix(lines[0], "00") {
+		t.Errorf("Expected two Unix times, got %q", lines)
+	}
+
+	stdout.Reset()
+
+	if err := runNext([]string{"--until", "3h", "*/30 * * * *"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if lines := strings.Fields(stdout.String()); len(lines) < 5 || len(lines) > 6 {
+		t.Errorf("Expected the runs of the next three hours, got %q", lines)
+	}
+
+	stdout.Reset()
+
+	if err := runNext([]string{"--count", "1000", "--format", "csv", "--timezone", "Europe/Lisbon", "@hourly"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 1001 || lines[0] != "time,unix" {
+		t.Fatalf("Expected a header and 1000 rows, got %d lines starting %q", len(lines), lines[0])
+	}
+
+	for _, row := range lines[1:] {
+		stamp, epoch, _ := strings.Cut(row, ",")
+
+		run, err := time.Parse(time.RFC3339, stamp)
+		if err != nil || strconv.FormatInt(run.Unix(), 10) != epoch {
+			t.Errorf("Expected matching RFC 3339 and Unix columns, got %q", row)
+
+			break
+		}
+	}
+
+	stdout.Reset()
+
+	args := []string{"--count", "1", "--timezone", "America/New_York", "--tz-list", "UTC,Asia/Tokyo", "0 9 * * *"}
+	if err := runNext(args, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if line := strings.TrimSpace(stdout.String()); !strings.Contains(line, ":00Z (UTC)  ") ||
+		!strings.HasSuffix(line, "+09:00 (Asia/Tokyo)") {
+		t.Errorf("Expected the run in UTC and Tokyo, got %q", line)
+	}
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{nil, ErrUsage},
+		{[]string{"--tz-list", "UTC,Mars/Base", "@daily"}, ErrUsage},
+		{[]string{"--count", "-1", "@daily"}, ErrUsage},
+		{[]string{"--count", "0", "@daily"}, ErrUsage},
+		{[]string{"--format", "json", "@daily"}, ErrUsage},
+		{[]string{"--until", "soon", "@daily"}, ErrUsage},
+		{[]string{"--timezone", "Mars/Base", "@daily"}, ErrUsage},
+		{[]string{"61 * * * *"}, ErrInvalidValue},
+		{[]string{"0 0 31 2 *"}, ErrInvalidValue},
+		{[]string{"--until", "1h", "0 0 31 2 *"}, ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		if err := runNext(test.args, io.Discard, io.Discard); !errors.Is(err, test.expected) {
+			t.Errorf("runNext(%q) = %v, expected %v", test.args, err, test.expected)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	notationNumeric = "numeric" // Months and weekdays written as numbers, e.g. 1-5
+	notationNamed   = "named"   // Months and weekdays written as names, e.g. MON-FRI
+)
+
+// parseNotation checks the notation setting of the config, which may be
+// empty to leave the fields as they are written
+func parseNotation(name string) (string, error) {
+	switch notation := strings.ToLower(name); notation {
+	case "", notationNumeric, notationNamed:
+		return notation, nil
+	default:
+		return "", fmt.Errorf("%w: notation %q (expected %s or %s)", ErrInvalidConfig, name, notationNumeric, notationNamed)
+	}
+}
+
+// rewriteNotation writes the months or weekdays of a field value as names or
+// as numbers, e.g. "1-5" as "MON-FRI" and back. Steps stay numbers, and
+// anything that is not a single value, like * or a Jenkins H, is kept.
+func rewriteNotation(value string, fieldIndex int, named bool) string {
+	names := fieldNameList(fieldIndex)
+	if names == nil {
+		return value
+	}
+
+	var builder strings.Builder
+
+	step := false
+
+	for value != "" {
+		end := strings.IndexAny(value, ",-/")
+		if end < 0 {
+			end = len(value)
+		}
+
+		token := value[:end]
+
+		if number, err := parseFieldValue(token, fieldIndex); err == nil && !step {
+			switch {
+			case !named:
+				token = strconv.Itoa(number)
+			case fieldIndex == fieldIndexMonth && number >= 1 && number <= len(names):
+				token = names[number-1]
+			case fieldIndex == fieldIndexWeekday && number >= 0 && number <= len(names):
+				token = names[number%len(names)] // 7 is Sunday too
+			}
+		}
+
+		builder.WriteString(token)
+
+		if end == len(value) {
+			break
+		}
+
+		step = value[end] == '/'
+		builder.WriteByte(value[end])
+		value = value[end+1:]
+	}
+
+	return builder.String()
+}
+
+// applyNotation rewrites the month and weekday fields as names or numbers
+func (m *model) applyNotation(named bool) tea.Cmd {
+	for _, index := range []int{fieldIndexMonth, fieldIndexWeekday} {
+		m.inputs[index].SetValue(rewriteNotation(m.inputs[index].Value(), index, named))
+		m.inputs[index].CursorEnd()
+	}
+
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
+
+// toggleNotation rewrites the month and weekday fields as numbers when
+// either holds a name, and as names otherwise
+func (m *model) toggleNotation() tea.Cmd {
+	named := !hasLetters(m.inputs[fieldIndexMonth].Value()) && !hasLetters(m.inputs[fieldIndexWeekday].Value())
+
+	return m.applyNotation(named)
+}
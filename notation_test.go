@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestRewriteNotation verifies that months and weekdays are rewritten as
+// names and numbers, leaving steps, stars, and Jenkins H alone.
+func TestRewriteNotation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		field   int
+		named   string
+		numeric string
+	}{
+		{"1-5", fieldIndexWeekday, "MON-FRI", "1-5"},
+		{"0,6,7", fieldIndexWeekday, "SUN,SAT,SUN", "0,6,0"},
+		{"jan-jun/2", fieldIndexMonth, "JAN-JUN/2", "1-6/2"},
+		{"*/3", fieldIndexMonth, "*/3", "*/3"},
+		{"H(1-5)", fieldIndexWeekday, "H(1-5)", "H(1-5)"},
+		{"13", fieldIndexMonth, "13", "13"},
+		{"5", fieldIndexHour, "5", "5"},
+	}
+
+	for _, test := range tests {
+		if named := rewriteNotation(test.value, test.field, true); named != test.named {
+			t.Errorf("rewriteNotation(%q, %d, true) = %q, expected %q", test.value, test.field, named, test.named)
+		}
+
+		if numeric := rewriteNotation(test.named, test.field, false); numeric != test.numeric {
+			t.Errorf("rewriteNotation(%q, %d, false) = %q, expected %q", test.named, test.field, numeric, test.numeric)
+		}
+	}
+}
+
+// TestToggleNotation verifies that the key switches the month and weekday
+// fields between numbers and names, and that the config sets the notation
+// at startup.
+func TestToggleNotation(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 9 * 1,7 1-5")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t"), Alt: true})
+
+	if m.buildCronExpression() != "0 9 * JAN,JUL MON-FRI" {
+		t.Errorf("Expected names, got %q", m.buildCronExpression())
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t"), Alt: true})
+
+	if m.buildCronExpression() != "0 9 * 1,7 1-5" {
+		t.Errorf("Expected numbers, got %q", m.buildCronExpression())
+	}
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"expression": "0 9 * * 1-5", "notation": "named"}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m = initialModel()
+	m.applyStartup(opts)
+
+	if m.buildCronExpression() != "0 9 * * MON-FRI" {
+		t.Errorf("Expected the configured expression with names, got %q", m.buildCronExpression())
+	}
+
+	if _, err := parseOptions([]string{"--config", writeConfig(t, `{"notation": "roman"}`)}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig for an unknown notation, got %v", err)
+	}
+}
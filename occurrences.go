@@ -0,0 +1,28 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// occurrences streams the runs of a schedule after from, in order, for as
+// long as the loop ranging over them goes on, the schedule keeps running,
+// and ctx is not done. Each run is found only when the loop asks for it, so
+// callers stop at a count, a time, or any other condition without building
+// a slice of runs first.
+func occurrences(ctx context.Context, schedule cronparser.Schedule, from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for next := schedule.Next(from); !next.IsZero() && ctx.Err() == nil; next = schedule.Next(next) {
+			if !yield(next) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestOccurrences verifies that runs are streamed in order until the loop
+// stops, the context is canceled, or the schedule stops running.
+func TestOccurrences(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := specSchedule(cronSpec{fields: []string{"0", "*/6", "*", "*", "*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2025, time.June, 1, 1, 0, 0, 0, time.UTC)
+
+	var runs []time.Time
+
+	for run := range occurrences(context.Background(), schedule, from) {
+		if runs = append(runs, run); len(runs) == 3 {
+			break
+		}
+	}
+
+	expected := []time.Time{from.Add(5 * time.Hour), from.Add(11 * time.Hour), from.Add(17 * time.Hour)}
+	if !slices.Equal(runs, expected) {
+		t.Errorf("Expected %v, got %v", expected, runs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streamed := 0
+
+	for range occurrences(ctx, schedule, from) {
+		if streamed++; streamed == 2 {
+			cancel()
+		}
+	}
+
+	if streamed != 2 {
+		t.Errorf("Expected the stream to end when canceled, got %d runs", streamed)
+	}
+
+	never, err := specSchedule(cronSpec{fields: []string{"0", "0", "30", "2", "*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for run := range occurrences(context.Background(), never, from) {
+		t.Errorf("Expected no runs on February 30, got %v", run)
+	}
+}
+
+// TestServeNextRunsCanceled verifies that a canceled request lists no runs.
+func TestServeNextRunsCanceled(t *testing.T) {
+	t.Parallel()
+
+	query, err := readServeQuery("*/5 * * * *", "", "", "UTC", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := query.nextRuns(ctx, time.Now(), 10); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	runs, err := query.nextRuns(context.Background(), time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), 2)
+	if err != nil || !slices.Equal(runs.Runs, []string{"2025-06-01T00:05:00Z", "2025-06-01T00:10:00Z"}) {
+		t.Errorf("Expected two runs, got %v, %v", runs.Runs, err)
+	}
+}
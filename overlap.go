@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fieldOverlap describes list items in a field that select the same values
+type fieldOverlap struct {
+	fieldIndex int      // Field the items belong to
+	notes      []string // One explanation per redundant or overlapping item
+	effective  fieldSet // Values the field selects
+	collapsed  string   // Shortest value selecting the same set
+}
+
+// String explains the overlap and the set the field effectively selects
+func (o fieldOverlap) String() string {
+	return fmt.Sprintf("%s: %s; selects %s", fieldNames[o.fieldIndex], strings.Join(o.notes, "; "), o.collapsed)
+}
+
+// findOverlap reports list items that repeat values selected by other items,
+// such as 5 in "1-10,5,7-12". An item wholly covered by the others is
+// redundant; one sharing only some values overlaps the earlier items it
+// meets. Values that cannot be expanded, such as H tokens, are never flagged.
+func findOverlap(value string, fieldIndex int) (fieldOverlap, bool) {
+	items := strings.Split(value, ",")
+	if len(items) < 2 {
+		return fieldOverlap{}, false
+	}
+
+	sets := make([]fieldSet, len(items))
+
+	for index, item := range items {
+		set, err := expandField(item, fieldIndex)
+		if err != nil || item == "" {
+			return fieldOverlap{}, false
+		}
+
+		sets[index] = set
+	}
+
+	overlap := fieldOverlap{fieldIndex: fieldIndex}
+	redundant := make([]bool, len(items))
+
+	// Check later items first so the second copy of a repeated item is the
+	// one flagged, comparing only against items not already flagged
+	for index := len(sets) - 1; index >= 0; index-- {
+		var others fieldSet
+
+		for other := range sets {
+			if other != index && !redundant[other] {
+				others |= sets[other]
+			}
+		}
+
+		redundant[index] = sets[index]&^others == 0
+	}
+
+	for index, set := range sets {
+		overlap.effective |= set
+
+		if redundant[index] {
+			overlap.notes = append(overlap.notes, items[index]+" is already covered")
+
+			continue
+		}
+
+		for earlier := range index {
+			if shared := set & sets[earlier]; shared != 0 && !redundant[earlier] {
+				overlap.notes = append(overlap.notes,
+					fmt.Sprintf("%s overlaps %s on %s", items[index], items[earlier], shared.compactString()))
+			}
+		}
+	}
+
+	if len(overlap.notes) == 0 {
+		return fieldOverlap{}, false
+	}
+
+	overlap.collapsed = overlap.effective.compactString()
+	if overlap.effective == fullFieldSet(fieldIndex) {
+		overlap.collapsed = "*"
+	}
+
+	return overlap, true
+}
+
+// overlaps returns the overlapping items of every field, in field order
+func (m *model) overlaps() []fieldOverlap {
+	var found []fieldOverlap
+
+	for index, input := range m.inputs {
+		if overlap, ok := findOverlap(input.Value(), index); ok {
+			found = append(found, overlap)
+		}
+	}
+
+	return found
+}
+
+// collapseOverlaps rewrites every field with overlapping items as the
+// shortest value selecting the same set
+func (m *model) collapseOverlaps() tea.Cmd {
+	found := m.overlaps()
+	if len(found) == 0 {
+		return nil
+	}
+
+	for _, overlap := range found {
+		m.inputs[overlap.fieldIndex].SetValue(overlap.collapsed)
+		m.inputs[overlap.fieldIndex].CursorEnd()
+	}
+
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
+
+// renderOverlaps warns about overlapping items and offers to collapse them
+func (m *model) renderOverlaps() string {
+	found := m.overlaps()
+	if len(found) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(found)+1)
+	for _, overlap := range found {
+		lines = append(lines, conflictStyle.Render(overlap.String()))
+	}
+
+	lines = append(lines, labelStyle.Render("ctrl+l to collapse"))
+
+	return m.place(strings.Join(lines, "\n")) + "\n"
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestFindOverlap verifies which items are reported as covered or
+// overlapping and the collapsed value that selects the same set.
+func TestFindOverlap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value      string
+		fieldIndex int
+		expected   string
+	}{
+		{"1-10,5,7-12", 1, "hour: 5 is already covered; 7-12 overlaps 1-10 on 7-10; selects 1-12"},
+		{"5,5", 0, "minute: 5 is already covered; selects 5"},
+		{"*/15,0", 0, "minute: 0 is already covered; selects 0,15,30,45"},
+		{"0,7", fieldIndexWeekday, "weekday: 7 is already covered; selects 0"},
+		{"MON-FRI,1", fieldIndexWeekday, "weekday: 1 is already covered; selects 1-5"},
+		{"1-5,*", 1, "hour: 1-5 is already covered; selects *"},
+	}
+
+	for _, tt := range tests {
+		overlap, ok := findOverlap(tt.value, tt.fieldIndex)
+		if !ok {
+			t.Errorf("findOverlap(%q) found no overlap", tt.value)
+
+			continue
+		}
+
+		if got := overlap.String(); got != tt.expected {
+			t.Errorf("findOverlap(%q) = %q, expected %q", tt.value, got, tt.expected)
+		}
+	}
+
+	for _, value := range []string{"", "*", "1-5", "1-5,6-10", "1,2,3", "H,5", "1-5,"} {
+		if overlap, ok := findOverlap(value, 1); ok {
+			t.Errorf("findOverlap(%q) unexpectedly found %q", value, overlap)
+		}
+	}
+}
+
+// TestCollapseOverlaps verifies that the warning is shown and ctrl+l rewrites
+// the overlapping fields without touching the others.
+func TestCollapseOverlaps(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 100
+	m.inputs[0].SetValue("0,0-30/15")
+	m.inputs[1].SetValue("1-10,5,7-12")
+
+	if view := m.View(); !strings.Contains(view, "7-12 overlaps 1-10") || !strings.Contains(view, "ctrl+l") {
+		t.Errorf("Expected the overlap warning in the view:\n%s", view)
+	}
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "overlap: minute: 0 is already covered") {
+		t.Errorf("Expected the overlap in the plain view:\n%s", plain)
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	m = assertModelType(t, newModel)
+
+	if got := m.buildCronExpression(); got != "0,15,30 1-12 * * *" {
+		t.Errorf("Expected collapsed fields, got %q", got)
+	}
+
+	if got := m.rawInput.Value(); got != "0,15,30 1-12 * * *" {
+		t.Errorf("Expected the raw expression to follow, got %q", got)
+	}
+
+	if m.renderOverlaps() != "" {
+		t.Error("Expected no warning after collapsing")
+	}
+}
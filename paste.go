@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//nolint:gochecknoglobals
+var (
+	// Quotes a value picks up when copied from documents, chat, or JSON
+	pasteQuotes = strings.NewReplacer("‘", "", "’", "", "‚", "", "“", "", "”", "", "„", "", "«", "", "»", "", "'", "", `"`, "", "`", "")
+
+	// Spaces around list, range, and step separators, as in "1, 15" or "9 - 17"
+	pasteSeparatorSpace = regexp.MustCompile(`\s*([,\-/])\s*`)
+)
+
+// pasteOffer asks whether a paste holding several values, such as "0 9 * *",
+// is spread over the fields from the focused one instead of landing in it
+type pasteOffer struct {
+	values []string // Values of the paste, in order
+	field  int      // Index of the field pasted into, which gets the first value
+}
+
+// pasteValues cleans up text pasted into a field: quotes are dropped, spaces
+// around separators closed up, and commas left at either end of a value
+// trimmed. It returns the values the text still holds, split at spaces.
+func pasteValues(text string) []string {
+	text = pasteSeparatorSpace.ReplaceAllString(pasteQuotes.Replace(text), "$1")
+
+	var values []string
+
+	for _, value := range strings.Fields(text) {
+		if value = strings.Trim(value, ","); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// pasteIntoField inserts a single pasted value at the cursor of the focused
+// field. A paste with several values raises the offer to spread them instead.
+func (m *model) pasteIntoField(text string) tea.Cmd {
+	values := pasteValues(text)
+
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		input := &m.inputs[m.focusIndex]
+		*input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(values[0])})
+		m.syncRawFromFields()
+
+		return m.scheduleCmd()
+	}
+
+	m.pasteOffer = &pasteOffer{values: values, field: m.focusIndex}
+
+	return nil
+}
+
+// handlePasteOfferKey accepts only the offer's choices while it is shown
+func (m *model) handlePasteOfferKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	offer := m.pasteOffer
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "s", "enter":
+		m.pasteOffer = nil
+
+		last := offer.field
+		for index, value := range offer.values {
+			if last = offer.field + index; last >= numCronFields {
+				last = numCronFields - 1
+
+				break
+			}
+
+			m.inputs[last].SetValue(value)
+			m.inputs[last].CursorEnd()
+		}
+
+		m.syncRawFromFields()
+
+		return m, tea.Batch(m.setFocus(last), m.scheduleCmd())
+	case "f":
+		m.pasteOffer = nil
+
+		return m, m.pasteIntoField(offer.values[0])
+	case "esc":
+		m.pasteOffer = nil
+	}
+
+	return m, nil
+}
+
+// renderPasteOffer renders the offer and its choices
+func (m *model) renderPasteOffer() string {
+	if m.pasteOffer == nil {
+		return ""
+	}
+
+	return m.place(peekStyle.Render(strings.Join(m.pasteOfferLines(), "\n"))) + "\n"
+}
+
+// pasteOfferLines names the fields the values would go to, and which do
+// not fit, followed by the keys that resolve the offer
+func (m *model) pasteOfferLines() []string {
+	offer := m.pasteOffer
+	fitting := min(len(offer.values), numCronFields-offer.field)
+
+	spread := fmt.Sprintf("s  spread them over the %s fields", strings.Join(fieldNames[offer.field:offer.field+fitting], ", "))
+	if dropped := len(offer.values) - fitting; dropped > 0 {
+		spread += fmt.Sprintf(", dropping the last %d", dropped)
+	}
+
+	return []string{
+		fmt.Sprintf("the paste holds %d values: %s", len(offer.values), strings.Join(offer.values, " ")),
+		spread,
+		fmt.Sprintf("f  put only %s in the %s field", offer.values[0], fieldNames[offer.field]),
+		"esc  cancel the paste",
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestPasteValues verifies that quotes, stray spaces, and commas at the ends
+// of pasted values are cleaned up, and that spaces split values.
+func TestPasteValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"  15 ", []string{"15"}},
+		{"“1-5”", []string{"1-5"}},
+		{"'*/10',", []string{"*/10"}},
+		{"1, 15, 30,", []string{"1,15,30"}},
+		{"9 - 17", []string{"9-17"}},
+		{" MON\t", []string{"MON"}},
+		{"0 9 * * 1-5", []string{"0", "9", "*", "*", "1-5"}},
+		{" , ", nil},
+	}
+
+	for _, test := range tests {
+		if values := pasteValues(test.text); !slices.Equal(values, test.expected) {
+			t.Errorf("pasteValues(%q) = %q, expected %q", test.text, values, test.expected)
+		}
+	}
+}
+
+// TestPasteIntoField verifies that a single pasted value is cleaned up and
+// inserted into the focused field.
+func TestPasteIntoField(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setFocus(fieldIndexHour)
+	m.inputs[fieldIndexHour].SetValue("")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("‘9-17,’ "), Paste: true})
+
+	if value := m.inputs[fieldIndexHour].Value(); value != "9-17" || m.pasteOffer != nil {
+		t.Errorf("Expected 9-17 pasted into the hour, got %q", value)
+	}
+
+	if m.buildCronExpression() != "20 9-17 * * *" {
+		t.Errorf("Expected the expression updated, got %q", m.buildCronExpression())
+	}
+}
+
+// TestPasteOffer verifies that a paste of several values is spread over the
+// fields from the focused one only once accepted, and that the other choices
+// keep the first value or nothing.
+func TestPasteOffer(t *testing.T) {
+	t.Parallel()
+
+	paste := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("30 6 * * MON ignored"), Paste: true}
+
+	m := initialModel()
+	m.setFocus(fieldIndexMinute)
+	m.Update(paste)
+
+	if m.pasteOffer == nil || m.buildCronExpression() != initialCron {
+		t.Fatalf("Expected an offer and the fields unchanged, got %q", m.buildCronExpression())
+	}
+
+	if view := m.View(); !strings.Contains(view, "spread them over the minute, hour, day, month, weekday fields, dropping the last 1") {
+		t.Errorf("Expected the offer shown, got:\n%s", view)
+	}
+
+	pressKey(t, m, "s")
+
+	if m.pasteOffer != nil || m.buildCronExpression() != "30 6 * * MON" || m.focusIndex != fieldIndexWeekday {
+		t.Errorf("Expected the values spread, got %q focused on %d", m.buildCronExpression(), m.focusIndex)
+	}
+
+	m = initialModel()
+	m.setFocus(fieldIndexDay)
+	m.inputs[fieldIndexDay].SetValue("")
+	m.Update(paste)
+	pressKey(t, m, "f")
+
+	if m.pasteOffer != nil || m.buildCronExpression() != "20 4 30 * *" {
+		t.Errorf("Expected only the first value pasted, got %q", m.buildCronExpression())
+	}
+
+	m.Update(paste)
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.pasteOffer != nil || m.buildCronExpression() != "20 4 30 * *" {
+		t.Errorf("Expected the paste cancelled, got %q", m.buildCronExpression())
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+	"github.com/mattn/go-isatty"
+)
+
+// ErrPickCanceled is returned when the picker is closed without accepting an
+// expression, so a script reading it stops rather than using an empty one
+var ErrPickCanceled = errors.New("no expression picked") //nolint:gochecknoglobals
+
+// pickerKeys are the keys the picker passes to the fields besides the
+// characters typed into them. The editor's other shortcuts open panels the
+// picker does not show.
+var pickerKeys = map[string]bool{ //nolint:gochecknoglobals
+	"tab": true, "shift+tab": true, " ": true, "backspace": true, "delete": true,
+	"left": true, "right": true, "home": true, "end": true,
+}
+
+// picker is the editor stripped to its fields and description, for another
+// program to ask for an expression. Enter accepts a valid expression and
+// Esc gives up.
+type picker struct {
+	editor *model // Editor whose fields and description are shown
+	picked bool   // Whether an expression was accepted
+	closed bool   // Whether the picker was closed, with or without one
+	status string // Why Enter did not accept the expression, "" for nothing
+}
+
+// newPicker opens the picker on an expression, the editor's default when ""
+func newPicker(expr string) *picker {
+	editor := initialModel()
+	if expr != "" {
+		editor.setExpression(expr)
+	}
+
+	editor.updateDescription()
+
+	return &picker{editor: editor}
+}
+
+// Init starts the cursor blinking
+func (p *picker) Init() tea.Cmd {
+	return p.editor.Init()
+}
+
+// Update accepts or cancels on Enter and Esc, and passes editing keys and
+// everything else but keys to the editor
+func (p *picker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		_, cmd := p.editor.Update(msg)
+
+		return p, cmd
+	}
+
+	switch key.String() {
+	case "enter":
+		if result := computeSchedule(p.editor.buildCronExpression(), time.Now()); result.err != nil {
+			p.status = result.err.Error()
+
+			return p, nil
+		}
+
+		p.picked, p.closed = true, true
+
+		return p, tea.Quit
+	case "esc", "ctrl+c":
+		p.closed = true
+
+		return p, tea.Quit
+	case "y", "?":
+		return p, nil
+	}
+
+	if key.Type != tea.KeyRunes && !pickerKeys[key.String()] {
+		return p, nil
+	}
+
+	p.status = ""
+	_, cmd := p.editor.Update(msg)
+
+	return p, cmd
+}
+
+// View renders the fields and their description, and nothing once closed so
+// the terminal is left as the picker found it
+func (p *picker) View() string {
+	if p.closed {
+		return ""
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(p.editor.renderDescription())
+	builder.WriteString(p.editor.renderInputs())
+	builder.WriteString(p.editor.renderLabels())
+
+	if p.status != "" {
+		builder.WriteString(p.editor.place(errorStyle.Render(p.status)) + "\n")
+	}
+
+	builder.WriteString(p.editor.place(dimStyle.Render("enter: accept · esc: cancel")) + "\n")
+
+	return builder.String()
+}
+
+// runPick asks for an expression in a picker drawn on stderr and prints the
+// accepted one to stdout, so it can be read with $(crontab-guru pick)
+func runPick(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("pick", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	expr := strings.Join(positional, " ")
+	if expr != "" {
+		if _, err := splitRawExpression(expr); err != nil {
+			return fmt.Errorf("%w: crontab-guru pick [EXPRESSION]: %w", ErrUsage, err)
+		}
+	}
+
+	if !isatty.IsTerminal(os.Stderr.Fd()) {
+		return fmt.Errorf("%w: crontab-guru pick draws on stderr, which must be a terminal", ErrUsage)
+	}
+
+	options := []tea.ProgramOption{tea.WithOutput(os.Stderr)}
+	if !isatty.IsTerminal(os.Stdin.Fd()) {
+		options = append(options, tea.WithInputTTY())
+	}
+
+	final, err := tea.NewProgram(newPicker(expr), options...).Run()
+	if err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	result, ok := final.(*picker)
+	if !ok || !result.picked {
+		return ErrPickCanceled
+	}
+
+	fmt.Fprintln(stdout, result.editor.buildCronExpression())
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestPicker verifies that the picker edits the fields, refuses an invalid
+// expression, and accepts a valid one on Enter.
+func TestPicker(t *testing.T) {
+	t.Parallel()
+
+	p := newPicker("0 9 * * 1-5")
+	if view := p.View(); !strings.Contains(view, "enter: accept") || strings.Contains(view, "? for help") {
+		t.Errorf("Expected only the fields, description, and keys:\n%s", view)
+	}
+
+	// Shortcuts for panels the picker does not show are ignored
+	p.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	if p.editor.rawMode {
+		t.Error("Expected Ctrl+R to be ignored")
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("75")})
+
+	if _, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || p.picked || p.status == "" {
+		t.Errorf("Expected minute 75 to be refused, got %q", p.status)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	p.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("30")})
+
+	if _, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || !p.picked {
+		t.Fatalf("Expected Enter to accept, got %q", p.status)
+	}
+
+	if expr := p.editor.buildCronExpression(); expr != "30 9 * * 1-5" {
+		t.Errorf("Expected 30 9 * * 1-5, got %q", expr)
+	}
+
+	if view := p.View(); view != "" {
+		t.Errorf("Expected nothing left drawn, got %q", view)
+	}
+
+	canceled := newPicker("")
+	if _, cmd := canceled.Update(tea.KeyMsg{Type: tea.KeyEsc}); cmd == nil || canceled.picked {
+		t.Error("Expected Esc to close the picker without an expression")
+	}
+}
+
+// TestRunPickUsage verifies that pick rejects a malformed starting expression
+// and needs a terminal to draw on.
+func TestRunPickUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{{"0 9 * *"}, {"--bogus"}, {"@daily"}} {
+		if err := runPick(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runPick(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	pinnedRuns       = 6                      // Runs listed on each side of the pinned comparison
+	pinnedTimeLayout = "Mon 2006-01-02 15:04" // Layout of the compared runs
+)
+
+// togglePin pins the next runs of the current expression beside those of the
+// expression being edited, or unpins them
+func (m *model) togglePin() {
+	if m.pinned != "" {
+		m.pinned = ""
+
+		return
+	}
+
+	m.pinned = m.buildCronExpression()
+}
+
+// upcomingRuns lists the next runs of an expression after now, resolving H
+// tokens like the tabs do, nil when it does not parse
+func (m *model) upcomingRuns(expr string, now time.Time) []time.Time {
+	resolved, err := m.resolveTabExpression(expr)
+	if err != nil {
+		return nil
+	}
+
+	schedule, err := standardParser.Parse(resolved)
+	if err != nil {
+		return nil
+	}
+
+	var runs []time.Time
+
+	next := now
+	for range pinnedRuns {
+		if next = schedule.Next(next); next.IsZero() {
+			break
+		}
+
+		runs = append(runs, next)
+	}
+
+	return runs
+}
+
+// pinnedLines compares the next runs of the pinned and current expressions
+// side by side, like a diff: pinned runs the current expression no longer
+// has are marked "-" and runs it adds are marked "+". It also reports which
+// lines hold a marked run.
+func (m *model) pinnedLines(now time.Time) ([]string, []bool) {
+	current := m.buildCronExpression()
+	pinned, edited := m.upcomingRuns(m.pinned, now), m.upcomingRuns(current, now)
+
+	cells := [][]string{{"pinned " + m.pinned, "current " + current}}
+	changed := []bool{false}
+
+	for index := range max(len(pinned), len(edited), 1) {
+		row, moved := []string{"", ""}, false
+
+		switch {
+		case index < len(pinned):
+			row[0] = pinnedCell(pinned[index], edited, "-")
+			moved = !slices.ContainsFunc(edited, pinned[index].Equal)
+		case index == 0:
+			row[0] = "  invalid"
+		}
+
+		switch {
+		case index < len(edited):
+			row[1] = pinnedCell(edited[index], pinned, "+")
+			moved = moved || !slices.ContainsFunc(pinned, edited[index].Equal)
+		case index == 0:
+			row[1] = "  invalid"
+		}
+
+		cells = append(cells, row)
+		changed = append(changed, moved)
+	}
+
+	width := 0
+	for _, row := range cells {
+		width = max(width, len(row[0]))
+	}
+
+	lines := make([]string, 0, len(cells))
+	for _, row := range cells {
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("%-*s  %s", width, row[0], row[1]), " "))
+	}
+
+	return lines, changed
+}
+
+// pinnedCell formats a run, marked when the other side does not have it
+func pinnedCell(run time.Time, other []time.Time, marker string) string {
+	if !slices.ContainsFunc(other, run.Equal) {
+		return marker + " " + run.Format(pinnedTimeLayout)
+	}
+
+	return "  " + run.Format(pinnedTimeLayout)
+}
+
+// renderPinned renders the pinned comparison while runs are pinned
+func (m *model) renderPinned() string {
+	if m.pinned == "" {
+		return ""
+	}
+
+	lines, changed := m.pinnedLines(time.Now())
+	for index, line := range lines {
+		switch {
+		case index == 0:
+			lines[index] = labelStyle.Render(line)
+		case changed[index]:
+			lines[index] = conflictStyle.Render(line)
+		}
+	}
+
+	return m.place(peekStyle.Render(strings.Join(lines, "\n"))) + "\n"
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestPinnedLines verifies that the pinned and current runs are listed side
+// by side, with runs only one side has marked like a diff.
+func TestPinnedLines(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 2 * * *")
+	m.togglePin()
+	m.setExpression("0 2 * * 1-5")
+
+	lines, changed := m.pinnedLines(time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC))
+
+	expected := []string{
+		"pinned 0 2 * * *        current 0 2 * * 1-5",
+		"  Fri 2026-10-16 02:00    Fri 2026-10-16 02:00",
+		"- Sat 2026-10-17 02:00    Mon 2026-10-19 02:00",
+		"- Sun 2026-10-18 02:00    Tue 2026-10-20 02:00",
+		"  Mon 2026-10-19 02:00    Wed 2026-10-21 02:00",
+		"  Tue 2026-10-20 02:00  + Thu 2026-10-22 02:00",
+		"  Wed 2026-10-21 02:00  + Fri 2026-10-23 02:00",
+	}
+
+	if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected comparison:\n%s\nexpected:\n%s", strings.Join(lines, "\n"), strings.Join(expected, "\n"))
+	}
+
+	for index, want := range []bool{false, false, true, true, false, true, true} {
+		if changed[index] != want {
+			t.Errorf("Line %d changed = %v, expected %v", index, changed[index], want)
+		}
+	}
+
+	m.setExpression("61 2 * * *")
+
+	if lines, _ = m.pinnedLines(time.Now()); !strings.HasSuffix(lines[1], "  invalid") {
+		t.Errorf("Expected the current side marked invalid, got %q", lines[1])
+	}
+}
+
+// TestPinToggle verifies that alt+p pins the runs, that the comparison
+// follows edits in the styled and plain views, and that alt+p unpins them.
+func TestPinToggle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width, m.height = 120, 60
+	m.setExpression("0 2 * * *")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p"), Alt: true})
+	m = assertModelType(t, newModel)
+
+	m.setExpression("30 3 * * *")
+
+	if m.pinned != "0 2 * * *" || !strings.Contains(m.View(), "current 30 3 * * *") {
+		t.Errorf("Expected the pinned runs shown, got:\n%s", m.View())
+	}
+
+	m.plain = true
+	if !strings.Contains(m.View(), "pinned runs:\n  pinned 0 2 * * *") {
+		t.Errorf("Expected the pinned runs in plain mode, got:\n%s", m.View())
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p"), Alt: true})
+	m = assertModelType(t, newModel)
+
+	if m.pinned != "" || strings.Contains(m.View(), "pinned") {
+		t.Error("Expected the pinned runs hidden")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+const (
+	defaultPrevRuns = 1    // Runs printed without --count
+	maxPrevRuns     = 1000 // Most runs --count may ask for
+)
+
+// runLookback is how far back past runs are searched, widening until enough
+// are found, so frequent jobs are cheap and a job on February 29 is still
+// found
+//
+//nolint:gochecknoglobals
+var runLookback = []time.Duration{
+	time.Hour, 24 * time.Hour, 32 * 24 * time.Hour, 367 * 24 * time.Hour, 4 * 367 * 24 * time.Hour,
+}
+
+// previousTimes lists up to count runs of a schedule at or before now,
+// newest first. Fewer are listed when the widest lookback has fewer.
+func previousTimes(schedule cronparser.Schedule, now time.Time, count int) []time.Time {
+	var runs []time.Time
+
+	for _, lookback := range runLookback {
+		runs = runs[:0]
+
+		for run := schedule.Next(now.Add(-lookback)); !run.IsZero() && !run.After(now); run = schedule.Next(run) {
+			if runs = append(runs, run); len(runs) > count {
+				runs = slices.Delete(runs, 0, 1)
+			}
+		}
+
+		if len(runs) == count {
+			break
+		}
+	}
+
+	slices.Reverse(runs)
+
+	return runs
+}
+
+// runPrev prints the most recent past runs of an expression, newest first,
+// one per line, in the formats next offers
+func runPrev(args []string, stdout, stderr io.Writer) error {
+	var (
+		from, seed, timezone, format string
+		count                        int
+	)
+
+	flags := flag.NewFlagSet("prev", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.IntVar(&count, "count", defaultPrevRuns, "past runs printed")
+	flags.StringVar(&format, "format", nextFormatRFC3339, "unix, rfc3339, relative, csv, or a Go time layout")
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&timezone, "timezone", "", "IANA time zone the schedule is read in, local by default")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: crontab-guru prev [--count N] [--format FORMAT] EXPRESSION", ErrUsage)
+	}
+
+	if count < 1 || count > maxPrevRuns {
+		return fmt.Errorf("%w: --count must be between 1 and %d", ErrUsage, maxPrevRuns)
+	}
+
+	query, err := readServeQuery(strings.Join(positional, " "), from, seed, timezone, time.Local)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(query.location)
+
+	formatRun, err := nextFormatter(format, now)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := specSchedule(query.spec)
+	if err != nil {
+		return err
+	}
+
+	for _, note := range query.notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+
+	_, err = writeRuns(stdout, csvHeader(format), formatRun, slices.Values(previousTimes(schedule, now, count)))
+
+	return err
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// TestPreviousTimes verifies that past runs are listed newest first,
+// including one at now, and that rare runs are found as far back as the
+// widest lookback reaches.
+func TestPreviousTimes(t *testing.T) {
+	t.Parallel()
+
+	parser := cronparser.NewParser(cronParserOptions)
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	hourly, err := parser.Parse("0 */6 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runs := previousTimes(hourly, now, 3)
+
+	expected := []time.Time{now, now.Add(-6 * time.Hour), now.Add(-12 * time.Hour)}
+	if len(runs) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, runs)
+	}
+
+	for index := range expected {
+		if !runs[index].Equal(expected[index]) {
+			t.Errorf("Run %d = %v, expected %v", index, runs[index], expected[index])
+		}
+	}
+
+	leap, err := parser.Parse("0 0 29 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if runs = previousTimes(leap, now, 5); len(runs) != 1 || runs[0].Year() != 2024 {
+		t.Errorf("Expected only February 29, 2024, got %v", runs)
+	}
+}
+
+// TestRunPrev verifies the counted output, past relative times, and that a
+// bad count or expression is rejected.
+func TestRunPrev(t *testing.T) {
+	t.Parallel()
+
+	var stdout strings.Builder
+	if err := runPrev([]string{"--count", "3", "@hourly"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Fields(stdout.String())
+	if len(lines) != 3 || lines[0] <= lines[1] {
+		t.Errorf("Expected three runs, newest first, got %q", lines)
+	}
+
+	stdout.Reset()
+
+	if err := runPrev([]string{"--format", "relative", "*/5 * * * *"}, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.HasSuffix(strings.TrimSpace(stdout.String()), " ago") && strings.TrimSpace(stdout.String()) != "now" {
+		t.Errorf("Expected a past relative time, got %q", stdout.String())
+	}
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{nil, ErrUsage},
+		{[]string{"--count", "0", "@daily"}, ErrUsage},
+		{[]string{"--count", "1001", "@daily"}, ErrUsage},
+		{[]string{"61 * * * *"}, ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		if err := runPrev(test.args, io.Discard, io.Discard); !errors.Is(err, test.expected) {
+			t.Errorf("runPrev(%q) = %v, expected %v", test.args, err, test.expected)
+		}
+	}
+}
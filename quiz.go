@@ -0,0 +1,309 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	quizChoices       = 4  // Choices offered for each question
+	quizDefaultRounds = 10 // Questions asked unless --rounds says otherwise
+	quizMaxAttempts   = 50 // Random picks tried for a choice that reads differently from the others
+)
+
+// quizQuestion asks for the description of an expression or the expression
+// of a description, among choices that differ from the answer by one field
+type quizQuestion struct {
+	ask     string   // What is asked for
+	prompt  string   // Expression or description asked about
+	choices []string // Descriptions or expressions to pick from
+	answer  int      // Index of the right choice
+}
+
+// quizGenerator builds random valid expressions and questions about them
+type quizGenerator struct {
+	rng *rand.Rand // Source of every random pick
+}
+
+// randomField picks a value for a field: most often "*", otherwise a single
+// value or one of the field's help examples
+func (g *quizGenerator) randomField(index int) string {
+	switch pick := g.rng.IntN(10); {
+	case pick < 4:
+		return "*"
+	case pick < 7:
+		values := fullFieldSet(index).Values()
+
+		return fmt.Sprint(values[g.rng.IntN(len(values))])
+	default:
+		examples := fieldExamples[index]
+
+		return examples[g.rng.IntN(len(examples))]
+	}
+}
+
+// randomExpression builds a random valid five-field expression
+func (g *quizGenerator) randomExpression() []string {
+	fields := make([]string, numCronFields)
+	for index := range fields {
+		fields[index] = g.randomField(index)
+	}
+
+	return fields
+}
+
+// describe describes an expression, "" when the descriptor cannot
+func (g *quizGenerator) describe(fields []string) string {
+	description, err := describeExpression(strings.Join(fields, " "))
+	if err != nil {
+		return ""
+	}
+
+	return description
+}
+
+// question picks a random expression and three others that change one of
+// its fields, all reading differently, and asks for the description of the
+// expression or, every other time on average, for the expression of the
+// description
+func (g *quizGenerator) question() quizQuestion {
+	var expressions, descriptions []string
+
+	for len(expressions) == 0 {
+		answer := g.randomExpression()
+		if description := g.describe(answer); description != "" {
+			expressions, descriptions = []string{strings.Join(answer, " ")}, []string{description}
+		}
+	}
+
+	answer := strings.Fields(expressions[0])
+
+	for attempt := 0; len(expressions) < quizChoices; attempt++ {
+		candidate := g.randomExpression()
+		if attempt < quizMaxAttempts {
+			// A wrong choice one field away teaches more than an unrelated one
+			candidate = append([]string(nil), answer...)
+			index := g.rng.IntN(numCronFields)
+			candidate[index] = g.randomField(index)
+		}
+
+		description := g.describe(candidate)
+		if description == "" || containsFold(descriptions, description) {
+			continue
+		}
+
+		expressions = append(expressions, strings.Join(candidate, " "))
+		descriptions = append(descriptions, description)
+	}
+
+	order := g.rng.Perm(quizChoices)
+	question := quizQuestion{ask: "Which description fits this expression?", choices: make([]string, quizChoices)}
+	prompts, choices := expressions, descriptions
+
+	if g.rng.IntN(2) == 1 {
+		question.ask = "Which expression fits this description?"
+		prompts, choices = descriptions, expressions
+	}
+
+	question.prompt = prompts[0]
+
+	for position, index := range order {
+		question.choices[position] = choices[index]
+		if index == 0 {
+			question.answer = position
+		}
+	}
+
+	return question
+}
+
+// containsFold reports whether values holds value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// quizModel asks a number of questions one after another, keeping score
+type quizModel struct {
+	generator *quizGenerator // Source of the questions
+	rounds    int            // Questions asked in all
+	asked     int            // Questions asked so far, the current one included
+	score     int            // Questions answered right
+	question  quizQuestion   // Current question
+	cursor    int            // Index of the highlighted choice
+	chosen    int            // Index of the choice picked, -1 until one is
+}
+
+// newQuizModel starts a quiz of rounds questions
+func newQuizModel(generator *quizGenerator, rounds int) *quizModel {
+	quiz := &quizModel{generator: generator, rounds: rounds}
+	quiz.next()
+
+	return quiz
+}
+
+// next moves on to a new question
+func (q *quizModel) next() {
+	q.question = q.generator.question()
+	q.asked++
+	q.cursor = 0
+	q.chosen = -1
+}
+
+// finished reports whether the last question has been answered
+func (q *quizModel) finished() bool {
+	return q.asked == q.rounds && q.chosen >= 0
+}
+
+// choose answers the current question with a choice
+func (q *quizModel) choose(index int) {
+	q.cursor = index
+	q.chosen = index
+
+	if index == q.question.answer {
+		q.score++
+	}
+}
+
+// Init starts the quiz with nothing to do
+func (q *quizModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update picks a choice with its number or the arrows and enter, and once it
+// is picked moves on with enter or space
+func (q *quizModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return q, nil
+	}
+
+	switch key.String() {
+	case "ctrl+c", "esc", "q":
+		return q, tea.Quit
+	}
+
+	if q.chosen >= 0 {
+		switch {
+		case key.String() != "enter" && key.String() != " ":
+		case q.finished():
+			return q, tea.Quit
+		default:
+			q.next()
+		}
+
+		return q, nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		q.cursor = max(0, q.cursor-1)
+	case "down", "j":
+		q.cursor = min(quizChoices-1, q.cursor+1)
+	case "enter", " ":
+		q.choose(q.cursor)
+	case "1", "2", "3", "4":
+		q.choose(int(key.String()[0] - '1'))
+	}
+
+	return q, nil
+}
+
+// View shows the question and its choices, marking the right one and the
+// one picked once answered
+func (q *quizModel) View() string {
+	var builder strings.Builder
+
+	builder.WriteString(titleStyle.Render(fmt.Sprintf("crontab guru: quiz, question %d of %d, score %d", q.asked, q.rounds, q.score)) + "\n")
+
+	builder.WriteString(labelStyle.Render(q.question.ask) + "\n\n")
+	builder.WriteString(previewStyle.Render("  "+q.question.prompt) + "\n\n")
+
+	for index, choice := range q.question.choices {
+		row := fmt.Sprintf("%d. %s", index+1, choice)
+
+		switch {
+		case q.chosen >= 0 && index == q.question.answer:
+			builder.WriteString(infoStyle.Render("✓ "+row) + "\n")
+		case q.chosen >= 0 && index == q.chosen:
+			builder.WriteString(conflictStyle.Render("✗ "+row) + "\n")
+		case q.chosen < 0 && index == q.cursor:
+			builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+		default:
+			builder.WriteString(labelStyle.Render("  "+row) + "\n")
+		}
+	}
+
+	help := "1-4 or up/down and enter: answer · q: quit"
+
+	switch {
+	case q.finished():
+		help = fmt.Sprintf("final score %d of %d · enter: quit", q.score, q.rounds)
+	case q.chosen >= 0:
+		help = "enter: next question · q: quit"
+	}
+
+	builder.WriteString("\n" + helpStyle.Render(help))
+
+	return builder.String()
+}
+
+// runQuiz asks random questions about expressions and their descriptions and
+// prints the score at the end
+func runQuiz(args []string, stdout, stderr io.Writer) error {
+	var rounds int
+
+	flags := flag.NewFlagSet("quiz", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.IntVar(&rounds, "rounds", quizDefaultRounds, "number of questions")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 0 || rounds < 1 {
+		return fmt.Errorf("%w: crontab-guru quiz [--rounds N]", ErrUsage)
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("%w: crontab-guru quiz needs a terminal", ErrUsage)
+	}
+
+	now := uint64(time.Now().UnixNano()) //nolint:gosec // Any seed will do for quiz questions
+
+	generator := &quizGenerator{rng: rand.New(rand.NewPCG(now, now>>32))} //nolint:gosec // Quiz questions need no secure randomness
+
+	final, err := tea.NewProgram(newQuizModel(generator, rounds)).Run()
+	if err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	if quiz, ok := final.(*quizModel); ok {
+		answered := quiz.asked
+		if quiz.chosen < 0 {
+			answered--
+		}
+
+		fmt.Fprintf(stdout, "score: %d of %d\n", quiz.score, answered)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestQuizGenerator creates a generator with a fixed seed.
+func newTestQuizGenerator(t *testing.T) *quizGenerator {
+	t.Helper()
+
+	return &quizGenerator{rng: rand.New(rand.NewPCG(1, 2))} //nolint:gosec // A fixed seed keeps the test repeatable
+}
+
+// TestQuizQuestions verifies that every question offers distinct choices
+// of valid expressions and their descriptions, with the right one matching
+// the prompt, and asks both ways round.
+func TestQuizQuestions(t *testing.T) {
+	t.Parallel()
+
+	generator := newTestQuizGenerator(t)
+	asks := map[string]bool{}
+
+	for range 200 {
+		question := generator.question()
+		asks[question.ask] = true
+
+		if len(question.choices) != quizChoices {
+			t.Fatalf("Expected %d choices, got %q", quizChoices, question.choices)
+		}
+
+		seen := map[string]bool{}
+		for _, choice := range question.choices {
+			if seen[choice] {
+				t.Errorf("Expected distinct choices, got %q", question.choices)
+			}
+
+			seen[choice] = true
+		}
+
+		expression, description := question.prompt, question.choices[question.answer]
+		if strings.HasPrefix(question.ask, "Which expression") {
+			expression, description = description, expression
+		}
+
+		if err := validateStandardFields(strings.Fields(expression)); err != nil {
+			t.Errorf("Expected a valid expression, got %q: %v", expression, err)
+		}
+
+		if got := generator.describe(strings.Fields(expression)); got != description {
+			t.Errorf("Expected %q described as %q, got %q", expression, description, got)
+		}
+	}
+
+	if len(asks) != 2 {
+		t.Errorf("Expected questions asked both ways, got %v", asks)
+	}
+}
+
+// TestQuizModel verifies that answers are scored, the right choice is shown
+// after each, and the quiz ends after its last question.
+func TestQuizModel(t *testing.T) {
+	t.Parallel()
+
+	quiz := newQuizModel(newTestQuizGenerator(t), 2)
+	right := quiz.question.answer
+
+	quiz.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{rune('1' + right)}})
+
+	if quiz.score != 1 || !strings.Contains(quiz.View(), "✓ ") || !strings.Contains(quiz.View(), "enter: next question") {
+		t.Errorf("Expected the right answer scored, got score %d and:\n%s", quiz.score, quiz.View())
+	}
+
+	quiz.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if quiz.asked != 2 || quiz.chosen != -1 {
+		t.Fatalf("Expected the second question, got question %d", quiz.asked)
+	}
+
+	wrong := (quiz.question.answer + 1) % quizChoices
+	for range wrong {
+		quiz.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	quiz.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if quiz.score != 1 || !strings.Contains(quiz.View(), "✗ ") || !strings.Contains(quiz.View(), "final score 1 of 2") {
+		t.Errorf("Expected the wrong answer marked, got score %d and:\n%s", quiz.score, quiz.View())
+	}
+
+	if _, cmd := quiz.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Error("Expected enter to end the quiz after the last question")
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	rawInputWidth = 40 // Visual width of the raw expression input
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrFieldCount is returned when an expression does not have the expected number of fields
+	ErrFieldCount = errors.New("wrong number of fields")
+
+	// Nonstandard macros accepted in the raw input and their five-field equivalents
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// newRawInput creates the free-text input used to edit the whole expression at once
+func newRawInput() textinput.Model {
+	raw := textinput.New()
+	raw.Placeholder = "* * * * *"
+	raw.CharLimit = 0 // Too long fields are reported by validateCronParts instead of cut off
+	raw.Width = rawInputWidth
+
+	return raw
+}
+
+// splitRawExpression splits a raw expression into its five fields, expanding
+// macros like @daily. It returns an error describing why the text cannot be
+// mapped onto the fields when it is not exactly five fields.
+func splitRawExpression(raw string) ([]string, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if expanded, ok := cronMacros[strings.ToLower(trimmed)]; ok {
+		trimmed = expanded
+	}
+
+	parts := strings.Fields(trimmed)
+	if len(parts) != numCronFields {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrFieldCount, numCronFields, len(parts))
+	}
+
+	return parts, nil
+}
+
+// toggleRawMode switches between editing the five fields and editing the whole
+// expression as free text. Unparseable raw text is discarded when leaving raw mode
+// so both views agree again.
+func (m *model) toggleRawMode() tea.Cmd {
+	m.rawMode = !m.rawMode
+	m.rawConflict = ""
+
+	if m.rawMode {
+		m.inputs[m.focusIndex].Blur()
+		m.syncRawFromFields()
+		m.rawInput.CursorEnd()
+
+		return m.rawInput.Focus()
+	}
+
+	m.rawInput.Blur()
+	m.syncRawFromFields()
+
+	return m.inputs[m.focusIndex].Focus()
+}
+
+// handleRawKey processes keyboard input while the raw input is focused
+func (m *model) handleRawKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Raw), msg.String() == "enter":
+		if m.guardRaw() {
+			return m, nil
+		}
+
+		return m, tea.Batch(m.toggleRawMode(), textinput.Blink)
+	}
+
+	var cmd tea.Cmd
+
+	m.rawInput, cmd = m.rawInput.Update(msg)
+	if msg.Paste && m.guardRaw() {
+		return m, cmd
+	}
+
+	m.syncFieldsFromRaw()
+
+	return m, tea.Batch(cmd, m.scheduleCmd())
+}
+
+// syncFieldsFromRaw copies the raw expression into the five fields. A whole
+// crontab line may be pasted: its variables and command are kept aside and
+// shown read-only. While the raw text cannot be split into five fields, or is
+// written in another dialect, the fields keep their last good values and the
+// conflict is reported instead.
+func (m *model) syncFieldsFromRaw() {
+	if guard := m.detectForeignDialect(m.rawInput.Value()); guard != nil {
+		m.rawConflict = fmt.Sprintf("reads as %s, not %s; press enter to convert it or switch; "+
+			"fields keep their last valid values", guard.origin, m.dialect)
+
+		return
+	}
+
+	line, err := m.readRaw()
+	if err != nil {
+		m.rawConflict = err.Error() + "; fields keep their last valid values"
+
+		return
+	}
+
+	m.rawConflict = ""
+	m.lineEnv = line.env
+	m.lineCommand = line.command
+
+	for index, part := range line.fields {
+		if part == "*" && m.inputs[index].Value() == "" {
+			continue // Empty fields already mean "*"
+		}
+
+		m.inputs[index].SetValue(part)
+	}
+}
+
+// syncRawFromFields copies the composed field values, with any pasted
+// variables and command, into the raw input. Other dialects than the
+// five-field ones get the expression converted.
+func (m *model) syncRawFromFields() {
+	if isFiveFieldDialect(m.dialect) {
+		m.rawInput.SetValue(m.crontabLineText())
+
+		return
+	}
+
+	conv, err := convertExpression(m.buildCronExpression(), dialectJenkins, m.dialect, m.hashSeed)
+	if err != nil {
+		m.rawInput.SetValue(m.buildCronExpression())
+		m.rawConflict = err.Error()
+
+		return
+	}
+
+	m.rawInput.SetValue(conv.expression)
+}
+
+// renderRaw renders the raw expression input and any sync conflict
+func (m *model) renderRaw() string {
+	if !m.rawMode {
+		return ""
+	}
+
+	box := focusedInputBoxStyle.Render(m.rawInput.View())
+	label := "raw "
+	if m.dialect != dialectStandard {
+		label = string(m.dialect) + " "
+	}
+
+	rendered := m.place(lipgloss.JoinHorizontal(lipgloss.Center, labelStyle.Render(label), box))
+
+	if m.rawConflict != "" {
+		rendered += "\n" + m.place(conflictStyle.Render(m.rawConflict))
+	}
+
+	return rendered + "\n"
+}
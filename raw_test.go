@@ -0,0 +1,157 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// typeRaw sends each rune of text to the model as a key press
+func typeRaw(t *testing.T, m *model, text string) *model {
+	t.Helper()
+
+	for _, char := range text {
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{char}})
+		m = assertModelType(t, newModel)
+	}
+
+	return m
+}
+
+// TestSplitRawExpression verifies splitting raw text into fields, including
+// macro expansion and rejection of the wrong number of fields.
+func TestSplitRawExpression(t *testing.T) {
+	t.Parallel()
+
+	parts, err := splitRawExpression("  */5  9-17 * *   MON-FRI ")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Join(parts, " ") != "*/5 9-17 * * MON-FRI" {
+		t.Errorf("Unexpected parts: %q", parts)
+	}
+
+	parts, err = splitRawExpression("@Daily")
+	if err != nil || strings.Join(parts, " ") != "0 0 * * *" {
+		t.Errorf("Expected @daily to expand to midnight, got %q, %v", parts, err)
+	}
+
+	if _, err := splitRawExpression("0 0 *"); !errors.Is(err, ErrFieldCount) {
+		t.Errorf("Expected ErrFieldCount for three fields, got %v", err)
+	}
+}
+
+// TestRawModeSyncsFields verifies that ctrl+r opens the raw input with the current
+// expression and that typing into it updates the fields in real time.
+func TestRawModeSyncsFields(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m = assertModelType(t, newModel)
+
+	if !m.rawMode || !m.rawInput.Focused() || m.inputs[0].Focused() {
+		t.Fatal("Expected ctrl+r to focus the raw input")
+	}
+
+	if m.rawInput.Value() != "20 4 * * *" {
+		t.Errorf("Expected raw input to start with the current expression, got %q", m.rawInput.Value())
+	}
+
+	m.rawInput.SetValue("")
+	m = typeRaw(t, m, "*/15 9 * * 1")
+
+	expected := []string{"*/15", "9", "*", "*", "1"}
+	for index, value := range expected {
+		if got := m.inputs[index].Value(); got != value && (value != "*" || got != "") {
+			t.Errorf("Expected field %d to be %q, got %q", index, value, got)
+		}
+	}
+
+	if m.rawConflict != "" {
+		t.Errorf("Expected no conflict for a complete expression, got %q", m.rawConflict)
+	}
+
+	if !strings.Contains(m.View(), "raw") {
+		t.Error("Expected the raw input to be rendered in raw mode")
+	}
+}
+
+// TestRawModeConflictKeepsFields verifies that unparseable raw text leaves the
+// fields untouched, reports the conflict, and is discarded when leaving raw mode.
+func TestRawModeConflictKeepsFields(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+
+	m = typeRaw(t, m, " 5")
+
+	if m.rawConflict == "" {
+		t.Fatal("Expected a conflict for a six-field raw expression")
+	}
+
+	if m.inputs[0].Value() != "20" || m.inputs[1].Value() != "4" {
+		t.Error("Expected fields to keep their last valid values during a conflict")
+	}
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = assertModelType(t, newModel)
+
+	if m.rawMode || !m.inputs[m.focusIndex].Focused() {
+		t.Error("Expected enter to return focus to the fields")
+	}
+
+	if m.rawInput.Value() != "20 4 * * *" || m.rawConflict != "" {
+		t.Errorf("Expected raw text to be resynced from the fields, got %q", m.rawInput.Value())
+	}
+}
+
+// TestRawModeLongLine verifies that a long raw line is kept whole, with a
+// field over the length limit reported rather than cut off.
+func TestRawModeLongLine(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+	m.rawInput.SetValue("")
+
+	minutes := make([]string, 0, 60)
+	for minute := range 60 {
+		minutes = append(minutes, strconv.Itoa(minute))
+	}
+
+	line := strings.Join(minutes, ",") + " * * * * " + strings.Repeat("x", 1024)
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(line)})
+	m = assertModelType(t, newModel)
+
+	if m.rawInput.Value() != line || m.inputs[0].Value() != strings.Join(minutes, ",") {
+		t.Fatalf("Expected the whole line kept, got %d characters", len(m.rawInput.Value()))
+	}
+
+	if m.err == nil || !strings.Contains(m.err.Error(), "characters long") {
+		t.Errorf("Expected the long minute field reported, got %v", m.err)
+	}
+}
+
+// TestFieldEditsSyncRaw verifies that editing a field keeps the raw expression in sync.
+func TestFieldEditsSyncRaw(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m = typeRaw(t, m, "5")
+
+	if m.rawInput.Value() != "205 4 * * *" {
+		t.Errorf("Expected raw input to follow field edits, got %q", m.rawInput.Value())
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// relativeInterval is how often the time left until the next run is redrawn,
+// often enough for the seconds shown in its last minute
+const relativeInterval = time.Second
+
+// relativeTick redraws the time left until the next run
+type relativeTick time.Time
+
+// relativeTickCmd schedules the next redraw
+func relativeTickCmd() tea.Cmd {
+	return tea.Tick(relativeInterval, func(t time.Time) tea.Msg {
+		return relativeTick(t)
+	})
+}
+
+// relativeRun phrases when a run happens as seen from now: "in 45s" within a
+// minute, "in 3h 12m" later today, "tomorrow at 04:20" tomorrow, and "in 3d
+// 4h" after that
+func relativeRun(run, now time.Time) string {
+	left := run.Sub(now)
+	year, month, day := run.Date()
+	tomorrow := now.AddDate(0, 0, 1)
+
+	switch {
+	case left < time.Minute:
+		return formatRelative(left)
+	case now.Year() == year && now.Month() == month && now.Day() == day:
+		return formatRelative(left.Truncate(time.Minute))
+	case tomorrow.Year() == year && tomorrow.Month() == month && tomorrow.Day() == day:
+		return "tomorrow at " + run.Format("15:04")
+	default:
+		return formatRelative(left.Truncate(time.Hour))
+	}
+}
+
+// handleRelativeTick moves the clock the next run is measured from, and
+// computes the run after it once it has passed
+func (m *model) handleRelativeTick(msg relativeTick) tea.Cmd {
+	m.now = time.Time(msg)
+
+	if m.nextRun != "" && !m.nextTime.After(m.now) {
+		m.lastCronExpr = ""
+
+		return tea.Batch(relativeTickCmd(), m.scheduleCmd())
+	}
+
+	return relativeTickCmd()
+}
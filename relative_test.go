@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRelativeRun verifies the phrasing of the time left until a run within a
+// minute, later today, tomorrow, and further off.
+func TestRelativeRun(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 15, 21, 30, 15, 0, time.UTC)
+	tests := []struct {
+		run      time.Time
+		expected string
+	}{
+		{now.Add(45 * time.Second), "in 45s"},
+		{now, "now"},
+		{time.Date(2026, time.October, 15, 23, 42, 0, 0, time.UTC), "in 2h 11m"},
+		{time.Date(2026, time.October, 16, 4, 20, 0, 0, time.UTC), "tomorrow at 04:20"},
+		{time.Date(2026, time.October, 19, 2, 0, 0, 0, time.UTC), "in 3d 4h"},
+	}
+
+	for _, test := range tests {
+		if phrase := relativeRun(test.run, now); phrase != test.expected {
+			t.Errorf("relativeRun(%v) = %q, expected %q", test.run, phrase, test.expected)
+		}
+	}
+}
+
+// TestRelativeTick verifies that each tick redraws the time left and that
+// the following run is computed once the next one has passed.
+func TestRelativeTick(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 9 * * *")
+	m.updateDescription()
+
+	if view := m.View(); !strings.Contains(view, "next at "+m.nextRun+" (") {
+		t.Errorf("Expected the time left beside the next run:\n%s", view)
+	}
+
+	passed := m.nextTime
+	m.Update(relativeTick(passed.Add(-time.Hour)))
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "next run: "+m.nextRun+" (in 1h)\n") {
+		t.Errorf("Expected an hour left in plain mode:\n%s", plain)
+	}
+
+	_, cmd := m.Update(relativeTick(passed))
+	if cmd == nil || !m.computing {
+		t.Fatal("Expected the next run to be computed again once it passed")
+	}
+}
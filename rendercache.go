@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+// renderKey is what the cached blocks of the view depend on: where they are
+// placed and the expression they show
+type renderKey struct {
+	width   int    // Terminal width the blocks were placed in
+	compact bool   // Whether the blocks were placed in the compact layout
+	expr    string // Expression in the fields
+}
+
+// renderCache keeps blocks of the view that change only with the layout or
+// the expression, such as the help panel and the compatibility matrix, so
+// frames redrawn for a cursor blink or a tick reuse them. Over SSH every
+// frame counts, and styling these blocks is most of the work of a frame.
+type renderCache struct {
+	key    renderKey         // What the blocks were rendered for
+	blocks map[string]string // Rendered blocks by name
+	size   int               // Length of the last frame, to size the next one's buffer up front
+}
+
+// cached returns the named block, rendering it when the layout or the
+// expression has changed since it was last rendered
+func (m *model) cached(block string, render func() string) string {
+	key := renderKey{width: m.width, compact: m.compactLayout(), expr: m.buildCronExpression()}
+	if key != m.renderCache.key || m.renderCache.blocks == nil {
+		m.renderCache.key = key
+		m.renderCache.blocks = make(map[string]string)
+	}
+
+	rendered, ok := m.renderCache.blocks[block]
+	if !ok {
+		rendered = render()
+		m.renderCache.blocks[block] = rendered
+	}
+
+	return rendered
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// richModel opens the editor at a terminal size with most panels shown.
+func richModel(width int) *model {
+	m := initialModel()
+	m.Update(tea.WindowSizeMsg{Width: width, Height: 60})
+	m.setExpression("*/15 9-17 * * 1-5")
+	m.Update(computeSchedule("*/15 9-17 * * 1-5", time.Now()))
+	m.showHelp, m.showDial, m.showChips, m.showCompat, m.showPeek = true, true, true, true, true
+	m.pinned = "0 9 * * 1-5"
+
+	return m
+}
+
+// TestRenderCacheFollowsChanges verifies that cached blocks are rendered
+// again when the expression or the terminal width changes, so a frame
+// always matches one drawn from scratch.
+func TestRenderCacheFollowsChanges(t *testing.T) {
+	t.Parallel()
+
+	m := richModel(160)
+	m.View()
+
+	m.setExpression("* * * * *")
+
+	if view, fresh := m.View(), m.renderCompat(); !strings.Contains(view, fresh) || m.renderCache.key.expr != "* * * * *" {
+		t.Errorf("Expected the compatibility matrix of the new expression, got:\n%s", view)
+	}
+
+	m.renderCache = renderCache{}
+	expected := m.View()
+
+	m.Update(tea.WindowSizeMsg{Width: 60, Height: 60})
+	m.View()
+	m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+
+	if view := m.View(); view != expected {
+		t.Errorf("Expected the same frame after resizing back, got:\n%s\nexpected:\n%s", view, expected)
+	}
+}
+
+// BenchmarkView measures a frame of the editor with the help panel, dials,
+// chips, peek, pinned runs, and compatibility matrix shown.
+func BenchmarkView(b *testing.B) {
+	m := richModel(160)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		m.View()
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// validateDefaultExpression checks the expression configured to start with,
+// which must be five standard fields or a macro like @daily
+func validateDefaultExpression(expr string) error {
+	fields, err := splitRawExpression(expr)
+	if err == nil {
+		err = validateStandardFields(fields)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: expression %q: %w", ErrInvalidConfig, expr, err)
+	}
+
+	return nil
+}
+
+// clearField empties the focused field, which then stands for any value
+func (m *model) clearField() tea.Cmd {
+	m.inputs[m.focusIndex].SetValue("")
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
+
+// clearFields sets every field to *, to build the expression from scratch
+func (m *model) clearFields() tea.Cmd {
+	m.setExpression(strings.TrimSpace(strings.Repeat("* ", numCronFields)))
+
+	return m.scheduleCmd()
+}
+
+// restoreDefault loads the expression the editor started with, the
+// configured one or initialCron
+func (m *model) restoreDefault() tea.Cmd {
+	expr := m.defaultExpr
+	if expr == "" {
+		expr = initialCron
+	}
+
+	m.setExpression(expr)
+
+	return m.scheduleCmd()
+}
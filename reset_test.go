@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestClearAndRestore verifies that ctrl+u clears the focused field, alt+u
+// clears every field, and alt+r restores the configured expression.
+func TestClearAndRestore(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.applyStartup(options{expression: "0 9 * * 1-5", field: fieldIndexHour})
+
+	if expr := m.buildCronExpression(); expr != "0 9 * * 1-5" {
+		t.Fatalf("Expected the configured expression at startup, got %q", expr)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+
+	if expr := m.buildCronExpression(); expr != "0 * * * 1-5" || m.inputs[fieldIndexHour].Value() != "" {
+		t.Errorf("Expected the hour cleared, got %q", expr)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u"), Alt: true})
+
+	if expr := m.buildCronExpression(); expr != "* * * * *" || m.inputs[fieldIndexWeekday].Value() != "*" {
+		t.Errorf("Expected every field cleared to *, got %q", expr)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r"), Alt: true})
+
+	if expr := m.buildCronExpression(); expr != "0 9 * * 1-5" || m.description == "" {
+		t.Errorf("Expected the configured expression restored, got %q", expr)
+	}
+
+	m = initialModel()
+	m.clearFields()
+	m.restoreDefault()
+
+	if expr := m.buildCronExpression(); expr != initialCron {
+		t.Errorf("Expected the built-in default restored, got %q", expr)
+	}
+}
+
+// TestParseOptionsExpression verifies that the configured expression is
+// checked when the config is read.
+func TestParseOptionsExpression(t *testing.T) {
+	t.Parallel()
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"expression": "@daily"}`)})
+	if err != nil || opts.expression != "@daily" {
+		t.Errorf("Expected the configured expression, got %+v, %v", opts, err)
+	}
+
+	if _, err := parseOptions([]string{"--config", writeConfig(t, `{"expression": "0 25 * * *"}`)}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected an invalid expression rejected, got %v", err)
+	}
+}
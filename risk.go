@@ -0,0 +1,218 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	minutesPerHour = 60      // Minutes in an hour
+	minutesPerDay  = 24 * 60 // Minutes in a day
+)
+
+// riskLevel ranks how risky a schedule is; higher values are riskier
+type riskLevel int
+
+const (
+	riskLow    riskLevel = iota // No rule matched, or only low rules
+	riskMedium                  // Worth a second look
+	riskHigh                    // Likely to cause load or run at a sensitive time
+)
+
+// riskRule assigns a risk level to schedules matching every condition it sets.
+// Rules come from the "risk_rules" config setting; unset conditions always match.
+type riskRule struct {
+	Level       string `json:"level"`                          // low, medium, or high
+	Reason      string `json:"reason"`                         // Why the rule applies, shown with the badge
+	MaxInterval int    `json:"max_interval_minutes,omitempty"` // Runs at most this many minutes apart
+	MinRuns     int    `json:"min_runs_per_day,omitempty"`     // Runs at least this many times a day
+	Hours       string `json:"hours,omitempty"`                // Runs during any of these hours, e.g. "0-5"
+	Weekdays    string `json:"weekdays,omitempty"`             // Runs on any of these weekdays, e.g. "SAT,SUN"
+}
+
+// riskAssessment is the highest risk level matched by a schedule and why
+type riskAssessment struct {
+	level   riskLevel // Highest level among the matched rules
+	reasons []string  // Reasons of the rules at that level
+}
+
+//nolint:gochecknoglobals
+var (
+	// Names of the risk levels, indexed by riskLevel
+	riskLevelNames = []string{"low", "medium", "high"}
+
+	// Rules used when the config does not define any
+	defaultRiskRules = []riskRule{
+		{Level: "high", Reason: "runs every minute", MaxInterval: 1},
+		{Level: "medium", Reason: "runs at least every 5 minutes", MaxInterval: 5},
+		{Level: "medium", Reason: "runs overnight", Hours: "0-5"},
+	}
+
+	// Badge colors for low, medium, and high risk
+	riskBadgeStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Bold(true).Padding(0, 1).Foreground(lipgloss.Color("#000000")).Background(lipgloss.Color("#00CC66")),
+		lipgloss.NewStyle().Bold(true).Padding(0, 1).Foreground(lipgloss.Color("#000000")).Background(lipgloss.Color("#FFAA00")),
+		lipgloss.NewStyle().Bold(true).Padding(0, 1).Foreground(colorWhite).Background(colorRed),
+	}
+)
+
+// String returns the name of the level
+func (level riskLevel) String() string {
+	return riskLevelNames[level]
+}
+
+// parseRiskLevel looks up a risk level by name
+func parseRiskLevel(name string) (riskLevel, error) {
+	for index, levelName := range riskLevelNames {
+		if strings.EqualFold(name, levelName) {
+			return riskLevel(index), nil
+		}
+	}
+
+	return riskLow, fmt.Errorf("%w: risk level %q (expected one of %s)",
+		ErrInvalidConfig, name, strings.Join(riskLevelNames, ", "))
+}
+
+// validateRiskRules checks the levels and field values of configured rules
+func validateRiskRules(rules []riskRule) error {
+	for _, rule := range rules {
+		if _, err := parseRiskLevel(rule.Level); err != nil {
+			return err
+		}
+
+		if _, err := expandField(rule.Hours, 1); rule.Hours != "" && err != nil {
+			return fmt.Errorf("%w: risk rule %q hours: %w", ErrInvalidConfig, rule.Reason, err)
+		}
+
+		if _, err := expandField(rule.Weekdays, fieldIndexWeekday); rule.Weekdays != "" && err != nil {
+			return fmt.Errorf("%w: risk rule %q weekdays: %w", ErrInvalidConfig, rule.Reason, err)
+		}
+	}
+
+	return nil
+}
+
+// minInterval returns the shortest gap in minutes between two runs in a day,
+// wrapping from the last run of the day to the first run of the next
+func minInterval(minutes fieldSet, hours fieldSet) int {
+	var times []int
+
+	for _, hour := range hours.Values() {
+		for _, minute := range minutes.Values() {
+			times = append(times, hour*minutesPerHour+minute)
+		}
+	}
+
+	if len(times) == 0 {
+		return 0
+	}
+
+	shortest := times[0] + minutesPerDay - times[len(times)-1]
+	for index := 1; index < len(times); index++ {
+		shortest = min(shortest, times[index]-times[index-1])
+	}
+
+	return shortest
+}
+
+// matches reports whether a schedule satisfies every condition the rule sets
+func (rule riskRule) matches(sets []fieldSet) bool {
+	if rule.MaxInterval > 0 && minInterval(sets[0], sets[1]) > rule.MaxInterval {
+		return false
+	}
+
+	if rule.MinRuns > 0 && sets[0].Len()*sets[1].Len() < rule.MinRuns {
+		return false
+	}
+
+	if hours, err := expandField(rule.Hours, 1); rule.Hours != "" && (err != nil || hours&sets[1] == 0) {
+		return false
+	}
+
+	if weekdays, err := expandField(rule.Weekdays, fieldIndexWeekday); rule.Weekdays != "" &&
+		(err != nil || weekdays&sets[fieldIndexWeekday] == 0) {
+		return false
+	}
+
+	return true
+}
+
+// assessRisk evaluates the rules against an expression. Invalid expressions
+// are assessed as low risk with no reasons.
+func assessRisk(expr string, rules []riskRule) riskAssessment {
+	var assessment riskAssessment
+
+	fields := strings.Fields(expr)
+	if len(fields) != numCronFields {
+		return assessment
+	}
+
+	sets := make([]fieldSet, numCronFields)
+
+	for index, field := range fields {
+		set, err := expandField(field, index)
+		if err != nil {
+			return assessment
+		}
+
+		sets[index] = set
+	}
+
+	for _, rule := range rules {
+		level, err := parseRiskLevel(rule.Level)
+		if err != nil || !rule.matches(sets) {
+			continue
+		}
+
+		if level > assessment.level {
+			assessment = riskAssessment{level: level}
+		}
+
+		if level == assessment.level && rule.Reason != "" {
+			assessment.reasons = append(assessment.reasons, rule.Reason)
+		}
+	}
+
+	return assessment
+}
+
+// risk assesses the current expression, using the values H resolves to
+func (m *model) risk() riskAssessment {
+	expr := m.buildCronExpression()
+	if m.hashResolved != "" {
+		expr = m.hashResolved
+	}
+
+	rules := m.riskRules
+	if rules == nil {
+		rules = defaultRiskRules
+	}
+
+	return assessRisk(expr, rules)
+}
+
+// label returns the badge text and reasons, e.g. "high risk: runs every minute"
+func (assessment riskAssessment) label() string {
+	label := assessment.level.String() + " risk"
+	if len(assessment.reasons) > 0 {
+		label += ": " + strings.Join(assessment.reasons, ", ")
+	}
+
+	return label
+}
+
+// renderBadge renders the risk level as a colored chip followed by its reasons
+func (assessment riskAssessment) renderBadge() string {
+	badge := riskBadgeStyles[assessment.level].Render(strings.ToUpper(assessment.level.String()))
+	if len(assessment.reasons) == 0 {
+		return badge
+	}
+
+	return badge + " " + labelStyle.Render(strings.Join(assessment.reasons, ", "))
+}
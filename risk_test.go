@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestAssessRiskDefaults verifies the default rules: every-minute schedules
+// are high risk, frequent or overnight schedules medium, and others low.
+func TestAssessRiskDefaults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected riskLevel
+		reason   string
+	}{
+		{"* * * * *", riskHigh, "runs every minute"},
+		{"*/5 9-17 * * *", riskMedium, "runs at least every 5 minutes"},
+		{"30 2 * * *", riskMedium, "runs overnight"},
+		{"0 9 * * MON-FRI", riskLow, ""},
+		{"59 23 * * *", riskLow, ""},
+		{"61 * * * *", riskLow, ""},
+	}
+
+	for _, tt := range tests {
+		assessment := assessRisk(tt.expr, defaultRiskRules)
+		if assessment.level != tt.expected {
+			t.Errorf("assessRisk(%q) = %s, expected %s", tt.expr, assessment.level, tt.expected)
+		}
+
+		if !strings.Contains(strings.Join(assessment.reasons, ","), tt.reason) {
+			t.Errorf("assessRisk(%q) reasons %v, expected %q", tt.expr, assessment.reasons, tt.reason)
+		}
+	}
+}
+
+// TestAssessRiskCustomRules verifies that every condition of a rule must match
+// and that the highest matching level wins.
+func TestAssessRiskCustomRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []riskRule{
+		{Level: "high", Reason: "weekend prod window", Hours: "0-5", Weekdays: "SAT,SUN"},
+		{Level: "medium", Reason: "busy", MinRuns: 24},
+	}
+
+	if got := assessRisk("0 3 * * SAT", rules); got.level != riskHigh || got.label() != "high risk: weekend prod window" {
+		t.Errorf("Expected high risk for a weekend night, got %q", got.label())
+	}
+
+	if got := assessRisk("0 3 * * MON", rules); got.level != riskLow {
+		t.Errorf("Expected low risk on a weekday, got %q", got.label())
+	}
+
+	if got := assessRisk("0 * * * MON", rules); got.level != riskMedium {
+		t.Errorf("Expected medium risk for 24 runs a day, got %q", got.label())
+	}
+
+	if minInterval(fullFieldSet(0), fullFieldSet(1)) != 1 {
+		t.Error("Expected a one-minute interval for every minute")
+	}
+
+	if minInterval(1<<0, 1<<23|1<<1) != 2*minutesPerHour {
+		t.Error("Expected the gap to wrap from the last run of the day to the first")
+	}
+}
+
+// TestRiskRulesConfig verifies that rules are read from the config and that
+// invalid levels or fields are rejected.
+func TestRiskRulesConfig(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"risk_rules": [{"level": "high", "reason": "business hours", "hours": "9-17"}]}`)
+
+	opts, err := parseOptions([]string{"--config", path})
+	if err != nil || len(opts.riskRules) != 1 {
+		t.Fatalf("Expected one risk rule, got %+v, %v", opts.riskRules, err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+	m.inputs[1].SetValue("10")
+
+	if got := m.risk(); got.level != riskHigh {
+		t.Errorf("Expected the configured rule to apply, got %q", got.label())
+	}
+
+	for _, content := range []string{
+		`{"risk_rules": [{"level": "severe"}]}`,
+		`{"risk_rules": [{"level": "high", "hours": "25"}]}`,
+		`{"risk_rules": [{"level": "high", "weekdays": "FUN"}]}`,
+	} {
+		if _, err := parseOptions([]string{"--config", writeConfig(t, content)}); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("Expected ErrInvalidConfig for %s, got %v", content, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json" // Schema of the log format
+	sarifVersion = "2.1.0"                                         // Version of the log format
+)
+
+// sarifLog is a SARIF log, the format code scanning services such as
+// GitHub's read findings from
+type sarifLog struct {
+	Schema  string     `json:"$schema"` // Schema of the log format
+	Version string     `json:"version"` // Version of the log format
+	Runs    []sarifRun `json:"runs"`    // One run of one tool
+}
+
+// sarifRun is the findings of one run of a tool
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`    // Tool that made the findings
+	Results []sarifResult `json:"results"` // Findings, empty when there are none
+}
+
+// sarifTool names the tool and the rules its findings follow
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"` // The tool itself
+}
+
+// sarifDriver is the tool that made the findings
+type sarifDriver struct {
+	Name           string      `json:"name"`           // Tool name
+	Version        string      `json:"version"`        // Tool version
+	InformationURI string      `json:"informationUri"` // Where to read about the tool
+	Rules          []sarifRule `json:"rules"`          // Diagnostic codes the findings use
+}
+
+// sarifRule is a diagnostic code
+type sarifRule struct {
+	ID string `json:"id"` // Diagnostic code, e.g. "day-or-weekday"
+}
+
+// sarifMessage is text for people
+type sarifMessage struct {
+	Text string `json:"text"` // The text
+}
+
+// sarifResult is one finding
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`    // Diagnostic code
+	Level     string          `json:"level"`     // "error", "warning", or "note"
+	Message   sarifMessage    `json:"message"`   // What is wrong
+	Locations []sarifLocation `json:"locations"` // Where it is
+}
+
+// sarifLocation is where a finding is
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"` // Place in a file
+}
+
+// sarifPhysicalLocation is a place in a file
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"` // The file
+	Region           sarifRegion           `json:"region"`           // The place in it
+}
+
+// sarifArtifactLocation is a file
+type sarifArtifactLocation struct {
+	URI string `json:"uri"` // Path with forward slashes
+}
+
+// sarifRegion is a stretch of one line, with columns counted from 1 and the
+// end column just past the last character
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`   // Line number, counting from 1
+	StartColumn int `json:"startColumn"` // Column of the first character
+	EndColumn   int `json:"endColumn"`   // Column after the last character
+}
+
+// sarifLevel maps a diagnostic severity to a SARIF level
+func sarifLevel(severity diagnosticSeverity) string {
+	switch severity {
+	case severityError:
+		return "error"
+	case severityWarning:
+		return "warning"
+	case severityInfo:
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+// newSarifLog turns lint findings into a SARIF log with a rule per code used
+func newSarifLog(findings []lintFinding) sarifLog {
+	driver := sarifDriver{
+		Name:           "crontab-guru",
+		Version:        version,
+		InformationURI: "https://github.com/techquestsdev/crontab-guru",
+		Rules:          []sarifRule{},
+	}
+	results := make([]sarifResult, 0, len(findings))
+
+	for _, finding := range findings {
+		if !slices.ContainsFunc(driver.Rules, func(rule sarifRule) bool { return rule.ID == finding.Code }) {
+			driver.Rules = append(driver.Rules, sarifRule{ID: finding.Code})
+		}
+
+		message := finding.Message
+		if finding.Suggestion != "" {
+			message += fmt.Sprintf(" (write %q)", finding.Suggestion)
+		}
+
+		results = append(results, sarifResult{
+			RuleID:  finding.Code,
+			Level:   sarifLevel(finding.Severity),
+			Message: sarifMessage{Text: message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(finding.Path)},
+				Region: sarifRegion{
+					StartLine:   finding.Line,
+					StartColumn: finding.Span.Start + 1,
+					EndColumn:   finding.Span.End + 1,
+				},
+			}}},
+		})
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+}
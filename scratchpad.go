@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	scratchpadWidth  = 50 // Width of the scratchpad text area
+	scratchpadHeight = 6  // Visible lines of the scratchpad text area
+)
+
+// newScratchpad creates the text area for session notes
+func newScratchpad() textarea.Model {
+	pad := textarea.New()
+	pad.Placeholder = "Notes and alternate expressions for this session"
+	pad.ShowLineNumbers = false
+	pad.CharLimit = 0
+	pad.SetWidth(scratchpadWidth)
+	pad.SetHeight(scratchpadHeight)
+
+	return pad
+}
+
+// toggleScratchpad opens the scratchpad with focus, or closes it and returns
+// focus to the field or raw input that had it
+func (m *model) toggleScratchpad() tea.Cmd {
+	m.showScratchpad = !m.showScratchpad
+
+	if m.showScratchpad {
+		m.inputs[m.focusIndex].Blur()
+		m.rawInput.Blur()
+
+		return m.scratchpad.Focus()
+	}
+
+	m.scratchpad.Blur()
+
+	if m.rawMode {
+		return m.rawInput.Focus()
+	}
+
+	return tea.Batch(m.inputs[m.focusIndex].Focus(), textinput.Blink)
+}
+
+// handleScratchpadKey processes keyboard input while the scratchpad is focused
+func (m *model) handleScratchpadKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "ctrl+n":
+		return m, m.toggleScratchpad()
+	case "ctrl+y":
+		m.parkExpression()
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	m.scratchpad, cmd = m.scratchpad.Update(msg)
+
+	return m, cmd
+}
+
+// parkExpression appends the current expression to the scratchpad on its own line
+func (m *model) parkExpression() {
+	value := m.scratchpad.Value()
+	if value != "" && !strings.HasSuffix(value, "\n") {
+		value += "\n"
+	}
+
+	m.scratchpad.SetValue(value + m.buildCronExpression())
+}
+
+// renderScratchpad renders the scratchpad panel when it is open
+func (m *model) renderScratchpad() string {
+	if !m.showScratchpad {
+		return ""
+	}
+
+	title := labelStyle.Render("scratchpad (ctrl+y parks the expression, esc closes)")
+	box := focusedInputBoxStyle.Render(m.scratchpad.View())
+
+	return m.place(lipgloss.JoinVertical(lipgloss.Left, title, box)) + "\n"
+}
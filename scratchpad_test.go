@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestScratchpadToggle verifies that ctrl+n opens the scratchpad with focus,
+// that typing goes to it rather than the fields, and that esc closes it.
+func TestScratchpadToggle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 80
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = assertModelType(t, newModel)
+
+	if !m.showScratchpad || !m.scratchpad.Focused() || m.inputs[0].Focused() {
+		t.Fatal("Expected the scratchpad to take focus")
+	}
+
+	for _, r := range "try y" {
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = assertModelType(t, newModel)
+	}
+
+	if m.scratchpad.Value() != "try y" || m.copyMessage != "" || m.buildCronExpression() != initialCron {
+		t.Errorf("Expected keys to be typed into the scratchpad, got %q", m.scratchpad.Value())
+	}
+
+	if !strings.Contains(m.View(), "scratchpad") {
+		t.Error("Expected the scratchpad panel in the view")
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = assertModelType(t, newModel)
+
+	if m.showScratchpad || !m.inputs[m.focusIndex].Focused() {
+		t.Error("Expected esc to close the scratchpad and refocus the field")
+	}
+}
+
+// TestScratchpadParkExpression verifies that ctrl+y appends the current
+// expression on its own line, and that raw mode regains focus on close.
+func TestScratchpadParkExpression(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.toggleRawMode()
+	m.toggleScratchpad()
+	m.scratchpad.SetValue("option A")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	m = assertModelType(t, newModel)
+
+	if m.scratchpad.Value() != "option A\n"+initialCron {
+		t.Errorf("Expected the expression to be parked, got %q", m.scratchpad.Value())
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = assertModelType(t, newModel)
+
+	if !m.rawInput.Focused() {
+		t.Error("Expected the raw input to regain focus")
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fitHeight shows the body above the footer. A body taller than the terminal
+// leaves room for the footer, is cut to the rows that fit, and scrolls with
+// PgUp and PgDn, instead of the terminal cutting off its end.
+func (m *model) fitHeight(body, footer string) string {
+	rows := m.height - lipgloss.Height(footer)
+	if m.height == 0 || strings.Count(body, "\n") <= rows {
+		m.viewport.YOffset = 0
+
+		return body + footer
+	}
+
+	m.viewport.Width, m.viewport.Height = m.width, max(1, rows)
+	m.viewport.SetContent(strings.TrimSuffix(body, "\n"))
+	m.viewport.SetYOffset(m.viewport.YOffset)
+
+	return m.viewport.View() + "\n" + footer
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestFitHeight verifies that a view taller than the terminal is cut to fit
+// with the status bar still shown, and that PgDn and PgUp scroll it.
+func TestFitHeight(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width, m.height = 120, 12
+	m.showHelp = true
+
+	top := m.View()
+	if rows := strings.Count(top, "\n") + 1; rows > m.height || !strings.Contains(top, "seconds off") {
+		t.Fatalf("Expected %d rows ending in the status bar, got %d:\n%s", m.height, rows, top)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+
+	scrolled := m.View()
+	if m.viewport.YOffset == 0 || scrolled == top || !strings.Contains(scrolled, "seconds off") {
+		t.Errorf("Expected PgDn to scroll the view, got:\n%s", scrolled)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+
+	if m.View() != top {
+		t.Errorf("Expected PgUp to scroll back to the top")
+	}
+
+	m.showHelp = false
+	m.height = 100
+	m.View()
+
+	if m.viewport.YOffset != 0 {
+		t.Errorf("Expected the scroll reset once the view fits, got offset %d", m.viewport.YOffset)
+	}
+}
+
+// TestFitHeightClicks verifies that clicks on the expression preview find
+// their field when the view is scrolled.
+func TestFitHeightClicks(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width, m.height = 120, 8
+	m.View()
+	m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	m.View()
+
+	offset := m.viewport.YOffset
+	if offset == 0 {
+		t.Fatal("Expected the mouse wheel to scroll the view")
+	}
+
+	m.handleMouse(tea.MouseMsg{X: m.previewCol + len("20 4 "), Y: m.previewRow - offset, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+
+	if m.focusIndex != fieldIndexDay {
+		t.Errorf("Expected the click to focus the day, got field %d", m.focusIndex)
+	}
+}
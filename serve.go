@@ -0,0 +1,315 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultServeListen = ":8080"          // Address the server listens on
+	defaultServeRuns   = 5                // Runs /next lists without a count
+	maxServeRuns       = 100              // Most runs /next lists
+	serveHeaderTimeout = 10 * time.Second // Time allowed to read a request's headers
+)
+
+// serveDescription is the body of a /describe response
+type serveDescription struct {
+	Expression  string   `json:"expression"`      // Equivalent five-field standard expression
+	Description string   `json:"description"`     // Natural-language description
+	Next        string   `json:"next"`            // Next run in RFC 3339
+	Notes       []string `json:"notes,omitempty"` // Explanations of noteworthy readings, e.g. resolved H tokens
+}
+
+// serveRuns is the body of a /next response
+type serveRuns struct {
+	Expression string   `json:"expression"` // Equivalent five-field standard expression
+	Timezone   string   `json:"timezone"`   // Time zone the runs are given in
+	Runs       []string `json:"runs"`       // Upcoming runs in RFC 3339
+}
+
+// serveValidation is the body of a /validate response
+type serveValidation struct {
+	Valid       bool         `json:"valid"`                 // Whether the expression parses in its dialect
+	Expression  string       `json:"expression,omitempty"`  // Equivalent five-field standard expression when valid
+	Error       string       `json:"error,omitempty"`       // Why the expression is invalid
+	Diagnostics []diagnostic `json:"diagnostics,omitempty"` // Findings with spans in the trimmed expression
+}
+
+// serveError is the body of a failed request
+type serveError struct {
+	Error string `json:"error"` // What was wrong with the request
+}
+
+// serveQuery is an expression read from a request's expr, dialect, seed,
+// and timezone parameters
+type serveQuery struct {
+	spec     cronSpec       // Parsed expression
+	notes    []string       // Explanations of noteworthy readings
+	location *time.Location // Time zone the runs are given in
+}
+
+// newServeMux routes the endpoints of serve. Runs are given in the
+// timezone parameter, or in location without one.
+func newServeMux(location *time.Location, now func() time.Time) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /describe", func(writer http.ResponseWriter, request *http.Request) {
+		query, err := parseServeQuery(request, location)
+		if err != nil {
+			writeServeError(writer, err)
+
+			return
+		}
+
+		description, err := query.describe(now())
+		if err != nil {
+			writeServeError(writer, err)
+
+			return
+		}
+
+		writeServeJSON(writer, http.StatusOK, description)
+	})
+
+	mux.HandleFunc("GET /next", func(writer http.ResponseWriter, request *http.Request) {
+		query, err := parseServeQuery(request, location)
+		if err != nil {
+			writeServeError(writer, err)
+
+			return
+		}
+
+		count := defaultServeRuns
+		if value := request.FormValue("count"); value != "" {
+			if count, err = strconv.Atoi(value); err != nil {
+				count = 0
+			}
+		}
+
+		runs, err := query.nextRuns(request.Context(), now(), count)
+		if err != nil {
+			writeServeError(writer, err)
+
+			return
+		}
+
+		writeServeJSON(writer, http.StatusOK, runs)
+	})
+
+	mux.HandleFunc("GET /validate", func(writer http.ResponseWriter, request *http.Request) {
+		validation, err := validateServeExpression(request.FormValue("expr"), request.FormValue("dialect"),
+			request.FormValue("seed"), location)
+		if err != nil {
+			writeServeError(writer, err)
+
+			return
+		}
+
+		writeServeJSON(writer, http.StatusOK, validation)
+	})
+
+	return mux
+}
+
+// parseServeQuery reads the expression of a request in its dialect
+func parseServeQuery(request *http.Request, location *time.Location) (serveQuery, error) {
+	return readServeQuery(request.FormValue("expr"), request.FormValue("dialect"), request.FormValue("seed"),
+		request.FormValue("timezone"), location)
+}
+
+// readServeQuery reads an expression in the named dialect, standard when
+// empty, with runs given in timezone, or in location when empty. A missing
+// expression or unknown time zone is a usage error.
+func readServeQuery(expr, dialectName, seed, timezone string, location *time.Location) (serveQuery, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return serveQuery{}, fmt.Errorf("%w: the expr parameter is required", ErrUsage)
+	}
+
+	if timezone != "" {
+		loaded, err := time.LoadLocation(timezone)
+		if err != nil {
+			return serveQuery{}, fmt.Errorf("%w: timezone %q", ErrUsage, timezone)
+		}
+
+		location = loaded
+	}
+
+	from := dialectStandard
+	if dialectName != "" {
+		var err error
+		if from, err = parseDialect(dialectName); err != nil {
+			return serveQuery{}, err
+		}
+	}
+
+	spec, notes, err := parseSpec(expr, from, seed)
+	if err != nil {
+		return serveQuery{}, err
+	}
+
+	if spec.year != "" && spec.year != "*" {
+		notes = append(notes, fmt.Sprintf("year %q is not included in the description or next run", spec.year))
+	}
+
+	return serveQuery{spec: spec, notes: notes, location: location}, nil
+}
+
+// describe describes the expression and finds its next run after now
+func (q serveQuery) describe(now time.Time) (serveDescription, error) {
+	result, err := explainSpec(q.spec, now.In(q.location))
+	if err != nil {
+		return serveDescription{}, err
+	}
+
+	return serveDescription{
+		Expression:  strings.Join(q.spec.fields, " "),
+		Description: result.description,
+		Next:        formatServeRun(result.next),
+		Notes:       q.notes,
+	}, nil
+}
+
+// nextRuns lists up to count runs of the expression after now, stopping
+// early with ctx's error when it is done. A count out of range is a usage
+// error.
+func (q serveQuery) nextRuns(ctx context.Context, now time.Time, count int) (serveRuns, error) {
+	if count < 1 || count > maxServeRuns {
+		return serveRuns{}, fmt.Errorf("%w: count must be between 1 and %d", ErrUsage, maxServeRuns)
+	}
+
+	schedule, err := specSchedule(q.spec)
+	if err != nil {
+		return serveRuns{}, err
+	}
+
+	runs := make([]string, 0, count)
+
+	for run := range occurrences(ctx, schedule, now.In(q.location)) {
+		if runs = append(runs, formatServeRun(run)); len(runs) == count {
+			break
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return serveRuns{}, err
+	}
+
+	return serveRuns{Expression: strings.Join(q.spec.fields, " "), Timezone: q.location.String(), Runs: runs}, nil
+}
+
+// validateServeExpression answers whether an expression is valid in its
+// dialect, with diagnostics saying why not or what to look out for. An
+// invalid expression is an answer rather than an error; only a malformed
+// query, such as an unknown dialect, is returned as one. Standard expressions
+// get every diagnostic; other dialects only the reason they are invalid.
+func validateServeExpression(expr, dialectName, seed string, location *time.Location) (serveValidation, error) {
+	query, err := readServeQuery(expr, dialectName, seed, "", location)
+	if err == nil {
+		_, err = specSchedule(query.spec)
+	}
+
+	if errors.Is(err, ErrUsage) || errors.Is(err, ErrUnknownDialect) {
+		return serveValidation{}, err
+	}
+
+	var diagnostics []diagnostic
+
+	expr = strings.TrimSpace(expr)
+	if from, _ := parseDialect(cmp.Or(dialectName, string(dialectStandard))); from == dialectStandard {
+		diagnostics = diagnoseExpression(expr)
+	}
+
+	if err != nil {
+		if !slices.ContainsFunc(diagnostics, func(d diagnostic) bool { return d.Severity == severityError }) {
+			diagnostics = append(diagnostics, errorDiagnostic(err, expr))
+		}
+
+		return serveValidation{Error: err.Error(), Diagnostics: diagnostics}, nil
+	}
+
+	return serveValidation{Valid: true, Expression: strings.Join(query.spec.fields, " "), Diagnostics: diagnostics}, nil
+}
+
+// formatServeRun formats a run in RFC 3339, "" when there is none
+func formatServeRun(run time.Time) string {
+	if run.IsZero() {
+		return ""
+	}
+
+	return run.Format(time.RFC3339)
+}
+
+// writeServeJSON writes a response body as JSON
+func writeServeJSON(writer http.ResponseWriter, status int, body any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	// The status is already sent, so a failed write only loses the body
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+// writeServeError answers a request that could not be served, blaming the
+// request unless the description library failed
+func writeServeError(writer http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if errors.Is(err, ErrCronDescriptor) {
+		status = http.StatusInternalServerError
+	}
+
+	writeServeJSON(writer, status, serveError{Error: err.Error()})
+}
+
+// runServe answers describe, next, and validate requests over HTTP until the
+// server fails
+func runServe(args []string, stdout, stderr io.Writer) error {
+	var listen, timezone string
+
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&listen, "listen", defaultServeListen, "address to listen on, e.g. :8080 or 127.0.0.1:8080")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone runs are given in without a timezone parameter")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 0 || listen == "" {
+		return fmt.Errorf("%w: crontab-guru serve [--listen ADDRESS] [--timezone ZONE]", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           newServeMux(location, time.Now),
+		ReadHeaderTimeout: serveHeaderTimeout,
+	}
+
+	fmt.Fprintf(stdout, "listening on %s\n", listen)
+
+	if err := server.ListenAndServe(); err != nil {
+		return fmt.Errorf("serve failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// serveGet sends a GET request to the serve endpoints and decodes the JSON
+// response into body, returning the status
+func serveGet(t *testing.T, target string, body any) int {
+	t.Helper()
+
+	now := func() time.Time { return time.Date(2026, time.October, 15, 12, 0, 30, 0, time.UTC) }
+	recorder := httptest.NewRecorder()
+	newServeMux(time.UTC, now).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("GET %s: expected JSON, got %q", target, contentType)
+	}
+
+	if err := json.Unmarshal(recorder.Body.Bytes(), body); err != nil {
+		t.Fatalf("GET %s: %v in %q", target, err, recorder.Body.String())
+	}
+
+	return recorder.Code
+}
+
+// TestServeDescribe verifies descriptions in the standard and other
+// dialects, with notes and the next run in the requested time zone.
+func TestServeDescribe(t *testing.T) {
+	t.Parallel()
+
+	var description serveDescription
+	if status := serveGet(t, "/describe?expr="+url.QueryEscape("30 2 * * 1-5"), &description); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+
+	if description.Expression != "30 2 * * 1-5" || description.Description != "At 02:30 AM, Monday through Friday" ||
+		description.Next != "2026-10-16T02:30:00Z" {
+		t.Errorf("Unexpected description %+v", description)
+	}
+
+	target := "/describe?dialect=quartz&timezone=Europe/Lisbon&expr=" + url.QueryEscape("0 0 12 ? * MON 2027")
+	if status := serveGet(t, target, &description); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+
+	if description.Expression != "0 12 * * MON" || description.Next != "2026-10-19T12:00:00+01:00" || len(description.Notes) == 0 {
+		t.Errorf("Unexpected Quartz description %+v", description)
+	}
+}
+
+// TestServeNext verifies that /next lists the requested number of runs and
+// rejects counts out of range.
+func TestServeNext(t *testing.T) {
+	t.Parallel()
+
+	var runs serveRuns
+	if status := serveGet(t, "/next?count=3&expr="+url.QueryEscape("*/15 * * * *"), &runs); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+
+	expected := []string{"2026-10-15T12:15:00Z", "2026-10-15T12:30:00Z", "2026-10-15T12:45:00Z"}
+	if runs.Timezone != "UTC" || !slices.Equal(runs.Runs, expected) {
+		t.Errorf("Unexpected runs %+v", runs)
+	}
+
+	var failure serveError
+	for _, count := range []string{"0", "101", "many"} {
+		if status := serveGet(t, "/next?expr=@daily&count="+count, &failure); status != http.StatusBadRequest {
+			t.Errorf("count=%s: expected 400, got %d", count, status)
+		}
+	}
+}
+
+// TestServeValidate verifies that invalid expressions are reported as
+// answers with diagnostics while missing parameters and unknown dialects are
+// bad requests.
+func TestServeValidate(t *testing.T) {
+	t.Parallel()
+
+	var validation serveValidation
+	if status := serveGet(t, "/validate?expr=@hourly", &validation); status != http.StatusOK ||
+		!validation.Valid || validation.Expression != "0 * * * *" {
+		t.Errorf("Expected @hourly to be valid, got %d %+v", status, validation)
+	}
+
+	validation = serveValidation{}
+	if status := serveGet(t, "/validate?expr="+url.QueryEscape("61 * * * *"), &validation); status != http.StatusOK ||
+		validation.Valid || validation.Error == "" {
+		t.Errorf("Expected 61 to be invalid, got %d %+v", status, validation)
+	}
+
+	if len(validation.Diagnostics) != 1 || validation.Diagnostics[0].Code != codeInvalidValue ||
+		validation.Diagnostics[0].Field != "minute" || validation.Diagnostics[0].Span != (diagnosticSpan{Start: 0, End: 2}) {
+		t.Errorf("Expected a diagnostic on the minute, got %+v", validation.Diagnostics)
+	}
+
+	validation = serveValidation{}
+	if status := serveGet(t, "/validate?expr="+url.QueryEscape("0 0 30 2 *"), &validation); status != http.StatusOK ||
+		!validation.Valid || len(validation.Diagnostics) != 1 || validation.Diagnostics[0].Code != codeNeverRuns {
+		t.Errorf("Expected February 30 to be valid with a warning, got %d %+v", status, validation)
+	}
+
+	var failure serveError
+	for _, target := range []string{"/validate", "/validate?expr=@daily&dialect=cobol", "/describe?expr=@daily&timezone=Mars/Base"} {
+		if status := serveGet(t, target, &failure); status != http.StatusBadRequest || failure.Error == "" {
+			t.Errorf("GET %s: expected 400 with an error, got %d %+v", target, status, failure)
+		}
+	}
+}
+
+// TestRunServeUsage verifies that arguments and an unknown time zone are
+// rejected before listening.
+func TestRunServeUsage(t *testing.T) {
+	t.Parallel()
+
+	if err := runServe([]string{"extra"}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+
+	if err := runServe([]string{"--timezone", "Mars/Base"}, io.Discard, io.Discard); !errors.Is(err, ErrInvalidValue) ||
+		!strings.Contains(err.Error(), "Mars/Base") {
+		t.Errorf("Expected ErrInvalidValue, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultSessionName = "default"  // Session used when --session is not given
+	sessionDirName     = "sessions" // Directory under the config directory holding session files
+)
+
+// ErrInvalidSession is returned when a session name or file is invalid
+var ErrInvalidSession = errors.New("invalid session") //nolint:gochecknoglobals
+
+// session is the state kept between runs of the editor under one session name
+type session struct {
+	Scratchpad string   `json:"scratchpad,omitempty"` // Free-form notes and parked expressions
+	Tabs       []string `json:"tabs,omitempty"`       // Expressions of the open tabs when more than one is open
+	ActiveTab  int      `json:"activeTab,omitempty"`  // Index of the tab being edited
+}
+
+// sessionPath returns the file a named session is stored in
+func sessionPath(name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("%w: name %q", ErrInvalidSession, name)
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidSession, err)
+	}
+
+	return filepath.Join(dir, configDirName, sessionDirName, name+".json"), nil
+}
+
+// loadSession reads a session file. A missing file is a new, empty session.
+func loadSession(path string) (session, error) {
+	var state session
+
+	data, err := os.ReadFile(path) //nolint:gosec // The path is the user's own session file
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+
+	if err != nil {
+		return state, fmt.Errorf("%w: %w", ErrInvalidSession, err)
+	}
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("%w: %s: %w", ErrInvalidSession, path, err)
+	}
+
+	return state, nil
+}
+
+// saveSession writes a session file, creating its directory if needed
+func saveSession(path string, state session) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSession, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+
+	return nil
+}
+
+// sessionState collects the parts of the model kept with the session
+func (m *model) sessionState() session {
+	state := session{Scratchpad: m.scratchpad.Value()}
+
+	if len(m.tabs) > 1 {
+		state.Tabs = m.tabExpressions()
+		state.ActiveTab = m.activeTab
+	}
+
+	return state
+}
+
+// restoreSession applies a loaded session to the model
+func (m *model) restoreSession(state session) {
+	m.scratchpad.SetValue(state.Scratchpad)
+
+	if len(state.Tabs) > 1 && len(state.Tabs) <= maxTabs {
+		m.tabs = state.Tabs
+		m.activeTab = min(max(state.ActiveTab, 0), len(m.tabs)-1)
+		m.setExpression(m.tabs[m.activeTab])
+		m.updateDescription()
+	}
+}
+
+// openSession restores the named session and remembers where to save it on exit
+func (m *model) openSession(name string) error {
+	path, err := sessionPath(name)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadSession(path)
+	if err != nil {
+		return err
+	}
+
+	m.restoreSession(state)
+	m.sessionFile = path
+	m.sessionLoaded = m.sessionState()
+
+	return nil
+}
+
+// closeSession saves the session on exit if the scratchpad or tabs changed
+// since it was restored, so an editor that only looked at an expression
+// leaves no session file behind
+func (m *model) closeSession() error {
+	if m.sessionFile == "" {
+		return nil
+	}
+
+	state := m.sessionState()
+	if state.Scratchpad == m.sessionLoaded.Scratchpad && slices.Equal(state.Tabs, m.sessionLoaded.Tabs) &&
+		state.ActiveTab == m.sessionLoaded.ActiveTab {
+		return nil
+	}
+
+	return saveSession(m.sessionFile, state)
+}
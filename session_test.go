@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestSessionRoundTrip verifies that a saved session is restored, and that a
+// missing session file starts an empty session.
+func TestSessionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), sessionDirName, "maintenance.json")
+
+	state, err := loadSession(path)
+	if err != nil || state.Scratchpad != "" {
+		t.Fatalf("Expected an empty session for a missing file, got %+v, %v", state, err)
+	}
+
+	m := initialModel()
+	m.scratchpad.SetValue("window A\n0 2 * * SUN")
+
+	if err := saveSession(path, m.sessionState()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	restored := initialModel()
+
+	state, err = loadSession(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	restored.restoreSession(state)
+
+	if restored.scratchpad.Value() != "window A\n0 2 * * SUN" {
+		t.Errorf("Expected the scratchpad to be restored, got %q", restored.scratchpad.Value())
+	}
+}
+
+// TestSessionErrors verifies that unsafe session names and corrupt files are rejected.
+func TestSessionErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"", "..", "a/b", `a\\b`} {
+		if _, err := sessionPath(name); !errors.Is(err, ErrInvalidSession) {
+			t.Errorf("sessionPath(%q) expected ErrInvalidSession, got %v", name, err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "corrupt.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("Failed to write session: %v", err)
+	}
+
+	if _, err := loadSession(path); !errors.Is(err, ErrInvalidSession) {
+		t.Errorf("Expected ErrInvalidSession for a corrupt file, got %v", err)
+	}
+}
+
+// TestCloseSession verifies that a session is only written on exit once its
+// scratchpad or tabs have changed.
+func TestCloseSession(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.sessionFile = filepath.Join(t.TempDir(), sessionDirName, defaultSessionName+".json")
+
+	if err := m.closeSession(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(m.sessionFile); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected no session file for an unchanged session, got %v", err)
+	}
+
+	m.scratchpad.SetValue("try 0 3 * * *")
+
+	if err := m.closeSession(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	state, err := loadSession(m.sessionFile)
+	if err != nil || state.Scratchpad != "try 0 3 * * *" {
+		t.Errorf("Expected the changed scratchpad to be saved, got %+v, %v", state, err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// minStepValues is the fewest values written as a step; two are as short as a list
+const minStepValues = 3
+
+// fieldSimplification is a shorter value selecting the same set as a field
+type fieldSimplification struct {
+	fieldIndex int    // Field the value belongs to
+	simpler    string // Shortest value selecting the same set
+}
+
+// simplestValue returns the shortest value selecting set: "*", a step such
+// as "*/10" or "5-55/10", or the list with runs written as ranges. Months
+// and weekdays get names when named. allowStar reports whether a set of
+// every value may be written as "*".
+func simplestValue(set fieldSet, fieldIndex int, allowStar, named bool) string {
+	if set == fullFieldSet(fieldIndex) && allowStar {
+		return "*"
+	}
+
+	simplest := set.compactString()
+
+	if step, ok := stepValue(set, fieldIndex, allowStar); ok && len(step) < len(simplest) {
+		simplest = step
+	}
+
+	return rewriteNotation(simplest, fieldIndex, named)
+}
+
+// stepValue writes a set of evenly spaced values as a step, "*/n" when it
+// runs over the whole field and "a-b/n" otherwise. Some crons read a day
+// field starting with * as unrestricted, so "*/n" needs allowStar too.
+func stepValue(set fieldSet, fieldIndex int, allowStar bool) (string, bool) {
+	values := set.Values()
+	if len(values) < minStepValues {
+		return "", false
+	}
+
+	step := values[1] - values[0]
+	if step == 1 {
+		return "", false // Consecutive values are shorter as a range
+	}
+
+	for index := 2; index < len(values); index++ {
+		if values[index]-values[index-1] != step {
+			return "", false
+		}
+	}
+
+	full := fullFieldSet(fieldIndex).Values()
+	last := full[len(full)-1]
+
+	// The step must run to the end of the field for "*/n" to stop where the set does
+	if allowStar && values[0] == full[0] && values[len(values)-1]+step > last {
+		return "*/" + strconv.Itoa(step), true
+	}
+
+	return strconv.Itoa(values[0]) + "-" + strconv.Itoa(values[len(values)-1]) + "/" + strconv.Itoa(step), true
+}
+
+// simplifications returns the fields that a shorter value selects the same
+// values for, in field order. Fields with overlapping items are left to
+// collapseOverlaps, and values that cannot be expanded, like H, are skipped.
+func (m *model) simplifications() []fieldSimplification {
+	fields := make([]string, numCronFields)
+	for index, input := range m.inputs {
+		fields[index] = cmp.Or(input.Value(), "*")
+	}
+
+	var found []fieldSimplification
+
+	for index, value := range fields {
+		if _, overlaps := findOverlap(value, index); overlaps || value == "*" {
+			continue
+		}
+
+		set, err := expandField(value, index)
+		if err != nil {
+			continue
+		}
+
+		simpler := simplestValue(set, index, starAllowed(fields, index), hasLetters(value))
+		if len(simpler) < len(value) {
+			found = append(found, fieldSimplification{fieldIndex: index, simpler: simpler})
+		}
+	}
+
+	return found
+}
+
+// simplifyFields rewrites every field that has a shorter equivalent with it
+func (m *model) simplifyFields() tea.Cmd {
+	found := m.simplifications()
+	if len(found) == 0 {
+		return nil
+	}
+
+	for _, simplification := range found {
+		m.inputs[simplification.fieldIndex].SetValue(simplification.simpler)
+		m.inputs[simplification.fieldIndex].CursorEnd()
+	}
+
+	m.syncRawFromFields()
+
+	return m.scheduleCmd()
+}
+
+// simplificationHint lists the shorter values, e.g. "minute */10, weekday 1-5"
+func (m *model) simplificationHint() string {
+	found := m.simplifications()
+	parts := make([]string, 0, len(found))
+
+	for _, simplification := range found {
+		parts = append(parts, fieldNames[simplification.fieldIndex]+" "+simplification.simpler)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// renderSimplifications offers the shorter values under the fields
+func (m *model) renderSimplifications() string {
+	hint := m.simplificationHint()
+	if hint == "" || !m.keys.Simplify.Enabled() {
+		return ""
+	}
+
+	return m.place(dimStyle.Render("simpler: "+hint+" · "+m.keys.Simplify.Help().Key+" to apply")) + "\n"
+}
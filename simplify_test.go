@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestSimplifications verifies the shorter equivalents offered for fields,
+// and that fields already as short as they get are left alone.
+func TestSimplifications(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"0,10,20,30,40,50 * * * *", "minute */10"},
+		{"5,15,25,35,45,55 * * * *", "minute 5-55/10"},
+		{"0 1,2,3,4,5 * * *", "hour 1-5"},
+		{"0 9 * * MON,TUE,WED,THU,FRI", "weekday MON-FRI"},
+		{"0 9 1 JAN,APR,JUL,OCT *", "month */3"},
+		{"0 0-23 1-31 * 0-6", "hour *"},
+		{"0 9 1-31 * 1-5", ""},
+		{"0 9 1,3,5,7,9,11,13,15,17,19,21,23,25,27,29,31 * 1", "day 1-31/2"},
+		{"0,30 9 * * 1-5", ""},
+		{"1-10,5 * * * *", ""},
+		{"20 4 * * *", ""},
+	}
+
+	for _, test := range tests {
+		m := initialModel()
+		m.setExpression(test.expr)
+
+		if hint := m.simplificationHint(); hint != test.expected {
+			t.Errorf("simplificationHint() for %q = %q, expected %q", test.expr, hint, test.expected)
+		}
+	}
+}
+
+// TestSimplifyFields verifies that the hint is shown with its key and that
+// the key applies it.
+func TestSimplifyFields(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 120
+	m.setExpression("0,15,30,45 1,2,3,4,5 * * *")
+
+	if view := m.View(); !strings.Contains(view, "simpler: minute */15, hour 1-5 · alt+c to apply") {
+		t.Errorf("Expected the hint shown, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c"), Alt: true})
+
+	if m.buildCronExpression() != "*/15 1-5 * * *" || m.simplificationHint() != "" {
+		t.Errorf("Expected the fields simplified, got %q", m.buildCronExpression())
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//nolint:gochecknoglobals
+var (
+	// APScheduler weekday names, indexed the way it numbers them from Monday
+	apschedulerWeekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+)
+
+// snippetHeader returns the comment lines opening a snippet: the schedule
+// description and, when both day fields are restricted, a warning for
+// libraries that require both to match where cron requires either
+func snippetHeader(job exportJob, comment string, library string, andsDays bool) (string, error) {
+	explained, err := explainSpec(cronSpec{fields: job.fields}, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	header := fmt.Sprintf("%s %s (%s)\n", comment, explained.description, job.timezone)
+
+	if andsDays && job.fields[2] != "*" && job.fields[4] != "*" {
+		header += fmt.Sprintf("%s Careful: %s runs only on days matching both the day and weekday fields;\n", comment, library)
+		header += comment + " cron runs on days matching either.\n"
+	}
+
+	return header, nil
+}
+
+// camelName turns a job name into a lower camel case identifier, e.g.
+// "nightly-backup" becomes "nightlyBackup"
+func camelName(name string) string {
+	words := strings.Split(terraformName(name), "_")
+
+	for index := 1; index < len(words); index++ {
+		if words[index] != "" {
+			words[index] = strings.ToUpper(words[index][:1]) + words[index][1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// jsString quotes a string for JavaScript with single quotes
+func jsString(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+
+	return "'" + strings.ReplaceAll(escaped, "'", `\'`) + "'"
+}
+
+// renderSpring renders a Spring @Scheduled method. Spring reads a leading
+// seconds field, so the standard expression is prefixed with 0.
+func renderSpring(job exportJob) (string, error) {
+	header, err := snippetHeader(job, "//", "Spring", true)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := convertExpression(strings.Join(job.fields, " "), dialectStandard, dialectSeconds, "")
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(header)
+	builder.WriteString("// Spring cron has a leading seconds field; enable with @EnableScheduling\n")
+	fmt.Fprintf(&builder, "@Scheduled(cron = %s, zone = %s)\n", strconv.Quote(result.expression), strconv.Quote(job.timezone))
+	fmt.Fprintf(&builder, "public void %s() {\n", camelName(job.name))
+	fmt.Fprintf(&builder, "    // %s\n", job.command)
+	builder.WriteString("}\n")
+
+	return builder.String(), nil
+}
+
+// renderNodeCron renders a node-cron schedule call, which reads standard
+// five-field expressions unchanged
+func renderNodeCron(job exportJob) (string, error) {
+	header, err := snippetHeader(job, "//", "node-cron", true)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("const cron = require('node-cron');\n\n")
+	builder.WriteString(header)
+	fmt.Fprintf(&builder, "cron.schedule(%s, () => {\n", jsString(strings.Join(job.fields, " ")))
+	fmt.Fprintf(&builder, "  // %s\n", job.command)
+	fmt.Fprintf(&builder, "}, { name: %s, timezone: %s });\n", jsString(job.name), jsString(job.timezone))
+
+	return builder.String(), nil
+}
+
+// apschedulerWeekday rewrites a weekday field with names. APScheduler numbers
+// weekdays from Monday as 0, so numbers copied from cron would shift by a day.
+func apschedulerWeekday(weekday string) (string, error) {
+	if weekday == "*" {
+		return weekday, nil
+	}
+
+	set, err := expandField(weekday, fieldIndexWeekday)
+	if err != nil {
+		return "", err
+	}
+
+	// Move Sunday from the front of the week to the back
+	set = set>>1 | set&1<<6
+
+	names := strings.Split(set.compactString(), ",")
+	for index, item := range names {
+		low, high, isRange := strings.Cut(item, "-")
+
+		first, _ := strconv.Atoi(low)
+		names[index] = apschedulerWeekdays[first]
+
+		if isRange {
+			last, _ := strconv.Atoi(high)
+			names[index] += "-" + apschedulerWeekdays[last]
+		}
+	}
+
+	return strings.Join(names, ","), nil
+}
+
+// renderAPScheduler renders an APScheduler CronTrigger built from the crontab
+// expression, with the weekday field written as names
+func renderAPScheduler(job exportJob) (string, error) {
+	header, err := snippetHeader(job, "#", "APScheduler", true)
+	if err != nil {
+		return "", err
+	}
+
+	weekday, err := apschedulerWeekday(job.fields[fieldIndexWeekday])
+	if err != nil {
+		return "", err
+	}
+
+	fields := append(append([]string{}, job.fields[:fieldIndexWeekday]...), weekday)
+	function := terraformName(job.name)
+
+	var builder strings.Builder
+
+	builder.WriteString("from apscheduler.triggers.cron import CronTrigger\n\n")
+	builder.WriteString(header)
+
+	if weekday != job.fields[fieldIndexWeekday] {
+		builder.WriteString("# Weekdays are names: APScheduler counts Monday as 0, not Sunday\n")
+	}
+
+	fmt.Fprintf(&builder, "trigger = CronTrigger.from_crontab(%s, timezone=%s)\n",
+		strconv.Quote(strings.Join(fields, " ")), strconv.Quote(job.timezone))
+	fmt.Fprintf(&builder, "scheduler.add_job(%s, trigger, id=%s)  # %s\n", function, strconv.Quote(job.name), job.command)
+
+	return builder.String(), nil
+}
+
+// renderRobfig renders a robfig/cron AddFunc call. robfig/cron reads the same
+// fields as the editor, so only the time zone is added as a CRON_TZ prefix.
+func renderRobfig(job exportJob) (string, error) {
+	header, err := snippetHeader(job, "//", "robfig/cron", false)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("c := cron.New()\n\n")
+	builder.WriteString(header)
+	fmt.Fprintf(&builder, "if _, err := c.AddFunc(%s, func() {\n",
+		strconv.Quote("CRON_TZ="+job.timezone+" "+strings.Join(job.fields, " ")))
+	fmt.Fprintf(&builder, "\t// %s\n", job.command)
+	builder.WriteString("}); err != nil {\n")
+	builder.WriteString("\tlog.Fatal(err)\n")
+	builder.WriteString("}\n\n")
+	builder.WriteString("c.Start()\n")
+
+	return builder.String(), nil
+}
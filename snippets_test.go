@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSnippetExports verifies that each code snippet embeds the expression
+// adjusted for its library, the time zone, and the job name.
+func TestSnippetExports(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "nightly-backup", "backup.sh", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		render   func(exportJob) (string, error)
+		expected []string
+	}{
+		{renderSpring, []string{
+			`@Scheduled(cron = "0 30 2 * * 1-5", zone = "Europe/Lisbon")`,
+			"public void nightlyBackup() {",
+		}},
+		{renderNodeCron, []string{
+			"cron.schedule('30 2 * * 1-5', () => {",
+			"timezone: 'Europe/Lisbon'",
+		}},
+		{renderAPScheduler, []string{
+			`CronTrigger.from_crontab("30 2 * * mon-fri", timezone="Europe/Lisbon")`,
+			"scheduler.add_job(nightly_backup, trigger",
+			"counts Monday as 0",
+		}},
+		{renderRobfig, []string{
+			`c.AddFunc("CRON_TZ=Europe/Lisbon 30 2 * * 1-5", func() {`,
+		}},
+	}
+
+	for _, tt := range tests {
+		rendered, err := tt.render(job)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+
+			continue
+		}
+
+		for _, want := range append(tt.expected, "At 02:30 AM", "backup.sh") {
+			if !strings.Contains(rendered, want) {
+				t.Errorf("Expected %q in:\n%s", want, rendered)
+			}
+		}
+
+		if strings.Contains(rendered, "Careful") {
+			t.Errorf("Expected no day-matching warning when only the weekday is set:\n%s", rendered)
+		}
+	}
+}
+
+// TestSnippetDayMatchingWarning verifies that libraries requiring both day
+// fields to match warn when both are restricted, and robfig/cron does not.
+func TestSnippetDayMatchingWarning(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("0 9 1 * MON", "report", "report.sh", "UTC")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, render := range []func(exportJob) (string, error){renderSpring, renderNodeCron, renderAPScheduler} {
+		if rendered, _ := render(job); !strings.Contains(rendered, "Careful") {
+			t.Errorf("Expected a day-matching warning in:\n%s", rendered)
+		}
+	}
+
+	if rendered, _ := renderRobfig(job); strings.Contains(rendered, "Careful") {
+		t.Errorf("Expected no warning for robfig/cron, which matches either field:\n%s", rendered)
+	}
+}
+
+// TestAPSchedulerWeekday verifies that weekday numbers become names ordered
+// from Monday, with Sunday moved to the end of the week.
+func TestAPSchedulerWeekday(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		weekday  string
+		expected string
+	}{
+		{"*", "*"},
+		{"0", "sun"},
+		{"1-5", "mon-fri"},
+		{"0,6", "sat,sun"},
+		{"0-2", "mon,tue,sun"},
+		{"*/2", "tue,thu,sat,sun"},
+		{"SAT,SUN", "sat,sun"},
+	}
+
+	for _, tt := range tests {
+		got, err := apschedulerWeekday(tt.weekday)
+		if err != nil {
+			t.Errorf("apschedulerWeekday(%q) unexpected error: %v", tt.weekday, err)
+
+			continue
+		}
+
+		if got != tt.expected {
+			t.Errorf("apschedulerWeekday(%q) = %q, expected %q", tt.weekday, got, tt.expected)
+		}
+	}
+}
+
+// TestSnippetQuoting verifies that names and commands cannot break out of
+// the generated string literals.
+func TestSnippetQuoting(t *testing.T) {
+	t.Parallel()
+
+	if got := jsString(`it's \ fine`); got != `'it\'s \\ fine'` {
+		t.Errorf("jsString = %q", got)
+	}
+
+	if got := camelName("Nightly DB backup"); got != "nightlyDbBackup" {
+		t.Errorf("camelName = %q, expected nightlyDbBackup", got)
+	}
+
+	if got := camelName("42"); got != "job42" {
+		t.Errorf("camelName = %q, expected job42", got)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Format of the prefix that delays a command by up to the given seconds.
+// Cron reads a bare % as a newline, so it is escaped.
+const staggerSleepPrefix = `sleep $((RANDOM \%% %d)) && `
+
+// staggerMove is the change proposed for one clashing job
+type staggerMove struct {
+	job    int           // Index of the job changed
+	expr   string        // Expression after the move, unchanged when the job sleeps instead
+	offset int           // Minutes the job was moved later, 0 when it sleeps instead
+	sleep  time.Duration // Longest random delay added before the command, 0 when moved
+}
+
+// describe explains the move, e.g. "b.sh: 0 2 * * * -> 6 2 * * *"
+func (move staggerMove) describe(jobs []clashJob) string {
+	job := jobs[move.job]
+	if move.sleep > 0 {
+		return fmt.Sprintf("%s: sleeps up to %s before running", job.name, formatGap(move.sleep))
+	}
+
+	return fmt.Sprintf("%s: %s -> %s", job.name, job.expr, move.expr)
+}
+
+// shiftMinutes moves every minute of an expression offset minutes later. It
+// reports false when a minute would pass the end of the hour, which includes
+// every-minute schedules.
+func shiftMinutes(expr string, offset int) (string, bool) {
+	fields := strings.Fields(expr)
+	if len(fields) != numCronFields {
+		return expr, false
+	}
+
+	minutes, err := expandField(fields[fieldIndexMinute], fieldIndexMinute)
+	if err != nil {
+		return expr, false
+	}
+
+	shifted := minutes.shift(offset)
+	if values := shifted.Values(); shifted.Len() != minutes.Len() || values[len(values)-1] > fieldRanges[fieldIndexMinute].max {
+		return expr, false
+	}
+
+	fields[fieldIndexMinute] = shifted.compactString()
+
+	return strings.Join(fields, " "), true
+}
+
+// staggerGroups joins clashes that share a job, so jobs clashing in a chain
+// are spread as one group. Each group lists its jobs in job order.
+func staggerGroups(jobs []clashJob, clashes []clash) [][]int {
+	parent := make([]int, len(jobs))
+	for index := range parent {
+		parent[index] = index
+	}
+
+	var root func(int) int
+
+	root = func(job int) int {
+		if parent[job] != job {
+			parent[job] = root(parent[job])
+		}
+
+		return parent[job]
+	}
+
+	clashing := make([]bool, len(jobs))
+
+	for _, found := range clashes {
+		for _, job := range found.jobs {
+			clashing[job] = true
+			parent[root(job)] = root(found.jobs[0])
+		}
+	}
+
+	var groups [][]int
+
+	positions := make(map[int]int)
+
+	for job := range jobs {
+		if !clashing[job] {
+			continue
+		}
+
+		position, ok := positions[root(job)]
+		if !ok {
+			position = len(groups)
+			positions[root(job)] = position
+			groups = append(groups, nil)
+		}
+
+		groups[position] = append(groups[position], job)
+	}
+
+	return groups
+}
+
+// planStagger proposes how to spread clashing jobs. The first job of each
+// group keeps its schedule and the others move one step further each, where
+// a step is a minute past the window. Jobs that cannot move within the hour,
+// or every job when jitter is set, sleep up to the window instead.
+func planStagger(jobs []clashJob, clashes []clash, window time.Duration, jitter bool) []staggerMove {
+	step := int(window/time.Minute) + 1
+	sleep := max(window, time.Minute)
+
+	var moves []staggerMove
+
+	for _, group := range staggerGroups(jobs, clashes) {
+		for position, job := range group[1:] {
+			offset := (position + 1) * step
+			if expr, ok := shiftMinutes(jobs[job].expr, offset); ok && !jitter {
+				moves = append(moves, staggerMove{job: job, expr: expr, offset: offset})
+
+				continue
+			}
+
+			moves = append(moves, staggerMove{job: job, expr: jobs[job].expr, sleep: sleep})
+		}
+	}
+
+	return moves
+}
+
+// staggerCrontab rewrites the lines of a crontab the moves change. Moved jobs
+// get the new schedule and sleeping jobs a random delay before the command.
+func staggerCrontab(text string, jobs []clashJob, moves []staggerMove) string {
+	lines := strings.Split(text, "\n")
+
+	for _, move := range moves {
+		source := jobs[move.job].source
+
+		line, err := parseCrontabLine(lines[source])
+		if err != nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(lines[source])
+		schedule := strings.TrimSpace(strings.TrimSuffix(trimmed, line.command))
+
+		if move.sleep > 0 {
+			lines[source] = schedule + " " + fmt.Sprintf(staggerSleepPrefix, int(move.sleep.Seconds())) + line.command
+		} else {
+			lines[source] = move.expr + " " + line.command
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// staggerTabs moves the minutes of clashing tabs apart. Tabs that cannot
+// move within the hour are left as they are.
+func (m *model) staggerTabs() tea.Cmd {
+	clashes, jobs := m.tabClashes(time.Now())
+	if len(clashes) == 0 {
+		return nil
+	}
+
+	expressions := m.tabExpressions()
+
+	for _, move := range planStagger(jobs, clashes, m.clashWindow, false) {
+		if move.offset > 0 {
+			expressions[jobs[move.job].source] = move.expr
+		}
+	}
+
+	copy(m.tabs, expressions)
+	m.setExpression(m.tabs[m.activeTab])
+
+	return m.scheduleCmd()
+}
+
+// runStagger prints a crontab with its clashing jobs spread apart, listing
+// each change on stderr so stdout can be installed with crontab -
+func runStagger(args []string, stdout, stderr io.Writer) error {
+	var (
+		window   time.Duration
+		days     int
+		jitter   bool
+		timezone string
+	)
+
+	flags := flag.NewFlagSet("stagger", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.DurationVar(&window, "window", defaultClashWindow, "runs this close together clash")
+	flags.IntVar(&days, "days", defaultClashDays, "days ahead to check")
+	flags.BoolVar(&jitter, "jitter", false, "add a random sleep instead of moving minutes")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the crontab is read in")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) != 1 {
+		return fmt.Errorf("%w: crontab-guru stagger [--window 5m] [--days 7] [--jitter] CRONTAB", ErrUsage)
+	}
+
+	if window < 0 || days < 1 {
+		return fmt.Errorf("%w: --window must not be negative and --days must be at least 1", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	text, err := readCrontab(paths[0])
+	if err != nil {
+		return err
+	}
+
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", paths[0], err)
+	}
+
+	now := time.Now().In(location)
+
+	clashes, err := findClashes(jobs, now, now.AddDate(0, 0, days), window)
+	if err != nil {
+		return err
+	}
+
+	moves := planStagger(jobs, clashes, window, jitter)
+	if len(moves) == 0 {
+		fmt.Fprintf(stderr, "no jobs run within %s of each other in the next %d days\n", window, days)
+	}
+
+	sleeping := false
+
+	for _, move := range moves {
+		fmt.Fprintln(stderr, move.describe(jobs))
+
+		sleeping = sleeping || move.sleep > 0
+	}
+
+	if sleeping {
+		fmt.Fprintln(stderr, "note: $RANDOM needs SHELL=/bin/bash in the crontab")
+	}
+
+	fmt.Fprint(stdout, staggerCrontab(text, jobs, moves))
+
+	return nil
+}
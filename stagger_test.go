@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestShiftMinutes verifies that minutes move later within the hour and that
+// schedules which would spill into the next hour are refused.
+func TestShiftMinutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		offset   int
+		expected string
+		ok       bool
+	}{
+		{"0 2 * * *", 6, "6 2 * * *", true},
+		{"*/15 * * * *", 6, "6,21,36,51 * * * *", true},
+		{"50 2 * * *", 12, "50 2 * * *", false},
+		{"* 2 * * *", 1, "* 2 * * *", false},
+		{"0 2 * *", 1, "0 2 * *", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := shiftMinutes(tt.expr, tt.offset)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("shiftMinutes(%q, %d) = %q, %v, expected %q, %v", tt.expr, tt.offset, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+// TestPlanStagger verifies that clashing jobs are spread a step past the
+// window apart, that jobs which cannot move sleep instead, and that jitter
+// makes every job sleep.
+func TestPlanStagger(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+	jobs := []clashJob{
+		{name: "a.sh", expr: "0 2 * * *"},
+		{name: "b.sh", expr: "0 2 * * *"},
+		{name: "c.sh", expr: "3 2 * * *"},
+		{name: "d.sh", expr: "55 3 * * *"},
+		{name: "e.sh", expr: "58 3 * * *"},
+	}
+
+	clashes, err := findClashes(jobs, now, now.AddDate(0, 0, 7), 5*time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	moves := planStagger(jobs, clashes, 5*time.Minute, false)
+
+	expected := []staggerMove{
+		{job: 1, expr: "6 2 * * *", offset: 6},
+		{job: 2, expr: "15 2 * * *", offset: 12},
+		{job: 4, expr: "58 3 * * *", sleep: 5 * time.Minute},
+	}
+
+	if len(moves) != len(expected) {
+		t.Fatalf("Expected %d moves, got %+v", len(expected), moves)
+	}
+
+	for index, move := range moves {
+		if move != expected[index] {
+			t.Errorf("Move %d = %+v, expected %+v", index, move, expected[index])
+		}
+	}
+
+	if got := moves[0].describe(jobs); got != "b.sh: 0 2 * * * -> 6 2 * * *" {
+		t.Errorf("Unexpected description %q", got)
+	}
+
+	if got := moves[2].describe(jobs); got != "e.sh: sleeps up to 5m before running" {
+		t.Errorf("Unexpected description %q", got)
+	}
+
+	for _, move := range planStagger(jobs, clashes, 5*time.Minute, true) {
+		if move.offset != 0 || move.sleep != 5*time.Minute {
+			t.Errorf("Expected every job to sleep with jitter, got %+v", move)
+		}
+	}
+}
+
+// TestRunStagger verifies that the rewritten crontab keeps comments,
+// variables, and commands, and that jitter adds an escaped sleep prefix.
+func TestRunStagger(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	crontab := "MAILTO=ops\n# nightly\n0 2 * * * a.sh\n0 2 * * * b.sh --all  >/dev/null\n@daily c.sh\n"
+
+	if err := os.WriteFile(path, []byte(crontab), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	if err := runStagger([]string{"--timezone", "UTC", path}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "MAILTO=ops\n# nightly\n0 2 * * * a.sh\n6 2 * * * b.sh --all  >/dev/null\n@daily c.sh\n"
+	if stdout.String() != expected {
+		t.Errorf("Unexpected crontab:\n%s", stdout.String())
+	}
+
+	if !strings.Contains(stderr.String(), "b.sh --all >/dev/null: 0 2 * * * -> 6 2 * * *") {
+		t.Errorf("Expected the move on stderr, got %q", stderr.String())
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+
+	if err := runStagger([]string{path, "--jitter", "--timezone", "UTC"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), `0 2 * * * sleep $((RANDOM \% 300)) && b.sh --all  >/dev/null`+"\n") ||
+		!strings.Contains(stderr.String(), "SHELL=/bin/bash") {
+		t.Errorf("Expected a sleep prefix, got:\n%s\n%s", stdout.String(), stderr.String())
+	}
+
+	if err := runStagger(nil, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage without a crontab, got %v", err)
+	}
+}
+
+// TestStaggerTabs verifies that alt+s moves clashing tabs apart.
+func TestStaggerTabs(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.tabs = []string{"0 2 * * *", "0 2 * * *", "0 4 * * *"}
+	m.setExpression("0 2 * * *")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}, Alt: true})
+	updated := assertModelType(t, newModel)
+
+	expressions := updated.tabExpressions()
+	if expressions[0] != "0 2 * * *" || expressions[1] != "6 2 * * *" || expressions[2] != "0 4 * * *" {
+		t.Errorf("Unexpected tabs after staggering: %q", expressions)
+	}
+
+	if updated.renderClashes() != "" {
+		t.Error("Expected no clashes after staggering")
+	}
+}
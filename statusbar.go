@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statusSeparator separates the items of the status bar
+const statusSeparator = " │ "
+
+//nolint:gochecknoglobals
+var (
+	// Mode indicators on the left of the status bar
+	statusBarStyle = lipgloss.NewStyle().
+			Foreground(colorWhite).
+			Background(lipgloss.Color("#333333"))
+
+	// Unsaved-changes indicator
+	statusUnsavedStyle = statusBarStyle.
+				Foreground(colorYellow).
+				Bold(true)
+)
+
+// hasSeconds reports whether expressions of the dialect start with seconds
+func (d dialect) hasSeconds() bool {
+	switch d {
+	case dialectSeconds, dialectAzure, dialectQuartz:
+		return true
+	case dialectStandard, dialectAWS, dialectJenkins:
+		return false
+	}
+
+	return false
+}
+
+// statusItems returns the mode indicators: the raw input's dialect, the time
+// zone runs are shown in, the language of month and weekday names, and
+// whether the dialect has seconds
+func (m *model) statusItems() []string {
+	locale := m.locale.code
+	if locale == "" {
+		locale = "en"
+	}
+
+	seconds := "seconds off"
+	if m.dialect.hasSeconds() {
+		seconds = "seconds on"
+	}
+
+	zone, _ := m.now.Zone()
+
+	return []string{string(m.dialect), zone, locale, seconds}
+}
+
+// unsaved reports whether the editor was opened on a job of a crontab file
+// that has changes not yet written back, counting the edit in progress
+func (m *model) unsaved() bool {
+	if m.openedExpr == "" {
+		return false
+	}
+
+	return m.pendingWrite || m.buildCronExpression() != m.openedExpr || m.lineCommand != m.openedCommand
+}
+
+// keyHints returns the keys for help, copying, and quitting as bound
+func (m *model) keyHints() string {
+	return m.keys.Help.Help().Key + " for help · " + m.copyHint() + " · " + m.keys.Quit.Help().Key + " to quit"
+}
+
+// renderStatusBar draws the mode indicators and key hints on one line across
+// the bottom of the editor, or on two when the terminal is too narrow
+func (m *model) renderStatusBar() string {
+	left := " " + strings.Join(m.statusItems(), statusSeparator)
+	if m.unsaved() {
+		left += statusSeparator + statusUnsavedStyle.Render("● unsaved")
+	}
+
+	right := " " + m.keyHints() + " "
+
+	width := lipgloss.Width(left) + lipgloss.Width(right)
+	if m.width == 0 || width <= m.width {
+		gap := strings.Repeat(" ", max(0, m.width-width))
+
+		return statusBarStyle.Render(left) + statusBarStyle.Render(gap+right)
+	}
+
+	line := statusBarStyle.Width(m.width).MaxWidth(m.width)
+
+	return line.Render(left) + "\n" + line.Render(right)
+}
+
+// plainStatus renders the status bar as a labeled line for plain mode
+func (m *model) plainStatus() string {
+	items := m.statusItems()
+	status := "status: dialect " + items[0] + ", time zone " + items[1] + ", locale " + items[2] + ", " + items[3]
+
+	if m.unsaved() {
+		status += ", unsaved changes"
+	}
+
+	return status + "\n" + m.keyHints() + "\n"
+}
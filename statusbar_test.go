@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestStatusBar verifies that the status bar shows the dialect, time zone,
+// locale, and seconds mode, and keeps the key hints in the footer.
+func TestStatusBar(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.width = 120
+	m.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if items := m.statusItems(); !slices.Equal(items, []string{"standard", "UTC", "en", "seconds off"}) {
+		t.Errorf("Unexpected status items %v", items)
+	}
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{}`), "--dialect", "quartz", "--locale", "pt"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	m.dialect, m.locale = opts.dialect, opts.locale
+
+	if items := m.statusItems(); !slices.Equal(items, []string{"quartz", "UTC", "pt", "seconds on"}) {
+		t.Errorf("Unexpected status items %v", items)
+	}
+
+	footer := m.renderFooter()
+	if !strings.Contains(footer, "quartz │ UTC │ pt │ seconds on") || !strings.Contains(footer, "y to copy") ||
+		strings.Contains(footer, "unsaved") {
+		t.Errorf("Unexpected status bar:\n%s", footer)
+	}
+
+	m.plain = true
+	if view := m.View(); !strings.Contains(view, "status: dialect quartz, time zone UTC, locale pt, seconds on\n") {
+		t.Errorf("Expected the plain status line, got:\n%s", view)
+	}
+}
+
+// TestStatusBarUnsaved verifies that an editor opened on a crontab job shows
+// unsaved changes once the job or an earlier one is edited.
+func TestStatusBarUnsaved(t *testing.T) {
+	t.Parallel()
+
+	b, _, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n*/5 * * * * poll.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.editor.unsaved() {
+		t.Error("Expected no unsaved changes before editing")
+	}
+
+	b.editor.setExpression("0 3 * * *")
+
+	if !b.editor.unsaved() || !strings.Contains(b.editor.renderStatusBar(), "● unsaved") {
+		t.Errorf("Expected unsaved changes shown, got %q", b.editor.renderStatusBar())
+	}
+
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendBrowserKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !b.editor.unsaved() {
+		t.Error("Expected the edit of backup.sh to count as unsaved")
+	}
+
+	if initialModel().unsaved() {
+		t.Error("Expected no unsaved changes outside crontab-file mode")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// suggestedStep is the step offered for a "/" left without one
+const suggestedStep = "5"
+
+// suggestField returns the likely intended value of an invalid field, ""
+// when no fix makes it valid. Each list item is fixed on its own: names
+// are cut to their abbreviation ("JUNE" to "JUN"), a missing step is filled
+// in ("*/" to "*/5"), a range written backwards is turned around ("30-5" to
+// "5-30"), and 24 o'clock or minute 60 becomes 0. With strict name matching,
+// only full names are cut, not words like "JANUX" that merely start with one.
+func suggestField(value string, fieldIndex int, strict bool) string {
+	items := strings.Split(value, ",")
+	for index, item := range items {
+		items[index] = suggestItem(item, fieldIndex, strict)
+	}
+
+	fixed := strings.Join(items, ",")
+	if fixed == value {
+		return ""
+	}
+
+	if _, err := expandField(fixed, fieldIndex); err != nil {
+		return ""
+	}
+
+	return fixed
+}
+
+// suggestItem fixes one list item of a field
+func suggestItem(item string, fieldIndex int, strict bool) string {
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep && step == "" {
+		step = suggestedStep
+	}
+
+	if low, high, isRange := strings.Cut(base, "-"); isRange {
+		low, high = suggestValue(low, fieldIndex, strict), suggestValue(high, fieldIndex, strict)
+
+		start, startErr := parseFieldValue(low, fieldIndex)
+		end, endErr := parseFieldValue(high, fieldIndex)
+
+		if startErr == nil && endErr == nil && start > end {
+			low, high = high, low
+		}
+
+		base = low + "-" + high
+	} else {
+		base = suggestValue(base, fieldIndex, strict)
+	}
+
+	if hasStep {
+		return base + "/" + step
+	}
+
+	return base
+}
+
+// suggestValue fixes a single value: a month or weekday name written out or
+// in lower case, or the hour or minute one past the last
+func suggestValue(value string, fieldIndex int, strict bool) string {
+	if number, err := strconv.Atoi(value); err == nil {
+		if (fieldIndex == fieldIndexMinute || fieldIndex == fieldIndexHour) && number == fieldRanges[fieldIndex].max+1 {
+			return "0"
+		}
+
+		return value
+	}
+
+	if !isLetters(value) {
+		return value
+	}
+
+	if abbreviation, ok := nameAbbreviation(value, fieldIndex, strict); ok {
+		return abbreviation
+	}
+
+	return value
+}
+
+// didYouMean offers a fix for the first invalid field, "" when every field
+// is valid or none can be fixed
+func (m *model) didYouMean() string {
+	for index, input := range m.inputs {
+		value := input.Value()
+		if isValidCronPart(value, index) {
+			if _, err := expandField(value, index); err == nil {
+				continue
+			}
+		}
+
+		if fixed := suggestField(value, index, m.strictNames); fixed != "" {
+			return fmt.Sprintf("did you mean %s for the %s?", fixed, fieldNames[index])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSuggestField verifies the fixes offered for common mistakes and that
+// nothing is offered when no fix makes the field valid.
+func TestSuggestField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		field    int
+		expected string
+	}{
+		{"JUNE", fieldIndexMonth, "JUN"},
+		{"1,june-august", fieldIndexMonth, "1,JUN-AUG"},
+		{"Tuesday", fieldIndexWeekday, "TUE"},
+		{"*/", fieldIndexMinute, "*/5"},
+		{"30-5", fieldIndexMinute, "5-30"},
+		{"FRI-MON/2", fieldIndexWeekday, "MON-FRI/2"},
+		{"24", fieldIndexHour, "0"},
+		{"60", fieldIndexMinute, "0"},
+		{"5", fieldIndexMinute, ""},
+		{"XYZ", fieldIndexMonth, ""},
+		{"32", fieldIndexDay, ""},
+	}
+
+	for _, test := range tests {
+		if fixed := suggestField(test.value, test.field, false); fixed != test.expected {
+			t.Errorf("suggestField(%q, %d) = %q, expected %q", test.value, test.field, fixed, test.expected)
+		}
+	}
+}
+
+// TestSuggestFieldStrict verifies that strict name matching shortens full
+// names only, not other words that start with an abbreviation.
+func TestSuggestFieldStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		field   int
+		lenient string
+		strict  string
+	}{
+		{"JUNE", fieldIndexMonth, "JUN", "JUN"},
+		{"september", fieldIndexMonth, "SEP", "SEP"},
+		{"JANU", fieldIndexMonth, "JAN", ""},
+		{"TUESDAYFOO", fieldIndexWeekday, "TUE", ""},
+		{"MON-FRIDAYS", fieldIndexWeekday, "MON-FRI", ""},
+		{"Tues", fieldIndexWeekday, "TUE", ""},
+	}
+
+	for _, test := range tests {
+		if fixed := suggestField(test.value, test.field, false); fixed != test.lenient {
+			t.Errorf("suggestField(%q, %d, false) = %q, expected %q", test.value, test.field, fixed, test.lenient)
+		}
+
+		if fixed := suggestField(test.value, test.field, true); fixed != test.strict {
+			t.Errorf("suggestField(%q, %d, true) = %q, expected %q", test.value, test.field, fixed, test.strict)
+		}
+	}
+
+	opts, err := parseOptions([]string{"--config", writeConfig(t, `{"strict_names": true}`)})
+	if err != nil || !opts.strictNames {
+		t.Fatalf("Expected strict names from the config, got %t: %v", opts.strictNames, err)
+	}
+
+	m := initialModel()
+	m.applyStartup(opts)
+	m.setExpression("0 9 * * TUESDAYFOO")
+
+	if suggestion := m.didYouMean(); suggestion != "" {
+		t.Errorf("Expected no suggestion with strict names, got %q", suggestion)
+	}
+}
+
+// TestDidYouMean verifies that the editor and the diagnostics offer the fix
+// for an invalid field.
+func TestDidYouMean(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 9 * JUNE *")
+	m.updateDescription()
+
+	if view := m.View(); !strings.Contains(view, "did you mean JUN for the month?") {
+		t.Errorf("Expected a suggestion under the error:\n%s", view)
+	}
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "did you mean JUN for the month?\n") {
+		t.Errorf("Expected a suggestion in plain mode:\n%s", plain)
+	}
+
+	diagnostics := diagnoseExpression("30-5 9 * * *")
+	if len(diagnostics) != 1 || diagnostics[0].Suggestion != "5-30" {
+		t.Errorf("Expected the range turned around, got %v", diagnostics)
+	}
+}
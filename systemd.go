@@ -0,0 +1,446 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+)
+
+//nolint:gochecknoglobals
+var (
+	// ErrSystemdCalendar is returned for an OnCalendar expression cron cannot express
+	ErrSystemdCalendar = errors.New("cannot convert OnCalendar")
+
+	// OnCalendar shorthands and their cron equivalents
+	systemdShorthands = map[string]string{
+		"minutely":     "* * * * *",
+		"hourly":       "0 * * * *",
+		"daily":        "0 0 * * *",
+		"weekly":       "0 0 * * 1",
+		"monthly":      "0 0 1 * *",
+		"quarterly":    "0 0 1 1,4,7,10 *",
+		"semiannually": "0 0 1 1,7 *",
+		"yearly":       "0 0 1 1 *",
+		"annually":     "0 0 1 1 *",
+	}
+)
+
+// systemdTimer is a timer unit and the calendar events it triggers on
+type systemdTimer struct {
+	unit      string   // Timer unit, e.g. logrotate.timer
+	activates string   // Unit the timer starts, e.g. logrotate.service
+	calendars []string // OnCalendar= values in the order they are set
+}
+
+// calendarConversion is an OnCalendar expression written as cron
+type calendarConversion struct {
+	calendar string   // OnCalendar= value as written
+	strict   bool     // Whether weekdays must be abbreviated or written out exactly
+	expr     string   // Cron expression, "" when there is none
+	location string   // Time zone named in the expression, "" for the local one
+	notes    []string // Differences worth knowing about
+	err      error    // Why there is no cron expression
+}
+
+// convertCalendarValue rewrites an OnCalendar list of numbers, ranges (a..b),
+// and repetitions (a/step) in cron syntax
+func convertCalendarValue(value string, fieldIndex int) (string, error) {
+	bounds := fieldRanges[fieldIndex]
+
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+
+		start, end, isRange := strings.Cut(base, "..")
+		for _, number := range []string{start, end} {
+			if number == "*" || (number == "" && !isRange) {
+				continue
+			}
+
+			if _, err := strconv.Atoi(number); err != nil {
+				return "", fmt.Errorf("%w: %s %q", ErrSystemdCalendar, fieldNames[fieldIndex], value)
+			}
+		}
+
+		converted := trimLeadingZeros(start)
+		if isRange {
+			converted += "-" + trimLeadingZeros(end)
+		}
+
+		if hasStep {
+			switch {
+			case base == "*" || converted == strconv.Itoa(bounds.min):
+				converted = "*"
+			case !isRange:
+				converted += "-" + strconv.Itoa(bounds.max)
+			}
+
+			converted += "/" + trimLeadingZeros(step)
+		}
+
+		items = append(items, converted)
+	}
+
+	return strings.Join(items, ","), nil
+}
+
+// trimLeadingZeros writes "07" as "7" and "00" as "0"
+func trimLeadingZeros(number string) string {
+	if trimmed := strings.TrimLeft(number, "0"); trimmed != "" {
+		return trimmed
+	}
+
+	if number == "" {
+		return ""
+	}
+
+	return "0"
+}
+
+// convertCalendarWeekdays rewrites an OnCalendar weekday list such as
+// "Mon..Fri,Sun" with cron's three-letter names, see nameAbbreviation for
+// strict
+func convertCalendarWeekdays(value string, strict bool) (string, error) {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		separator := ".."
+		if !strings.Contains(item, separator) {
+			separator = "-"
+		}
+
+		var names []string
+
+		for _, day := range strings.Split(item, separator) {
+			name, ok := nameAbbreviation(day, fieldIndexWeekday, strict)
+			if !ok {
+				return "", fmt.Errorf("%w: weekday %q", ErrSystemdCalendar, day)
+			}
+
+			names = append(names, name)
+		}
+
+		items = append(items, strings.Join(names, "-"))
+	}
+
+	return strings.Join(items, ","), nil
+}
+
+// convertCalendar writes an OnCalendar expression as cron. The expression is
+// a shorthand such as "daily" or "[weekdays] [date] [time] [time zone]" with
+// the date and time defaulting to every day at midnight.
+func convertCalendar(calendar string, strict bool) calendarConversion {
+	conv := calendarConversion{calendar: calendar, strict: strict}
+	conv.expr, conv.err = conv.convert()
+
+	if conv.err != nil {
+		conv.expr = ""
+	}
+
+	return conv
+}
+
+// convert does the work of convertCalendar, collecting notes as it goes
+func (conv *calendarConversion) convert() (string, error) {
+	tokens := strings.Fields(conv.calendar)
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("%w: empty expression", ErrSystemdCalendar)
+	}
+
+	if expr, ok := systemdShorthands[strings.ToLower(tokens[0])]; ok && len(tokens) == 1 {
+		if strings.EqualFold(tokens[0], "weekly") {
+			conv.notes = append(conv.notes, "weekly runs on Mondays, unlike cron's @weekly")
+		}
+
+		return expr, nil
+	}
+
+	weekday, date, clock := "*", "*-*-*", "00:00:00"
+
+	for index, token := range tokens {
+		switch {
+		case index == 0 && unicode.IsLetter(rune(token[0])):
+			converted, err := convertCalendarWeekdays(token, conv.strict)
+			if err != nil {
+				return "", err
+			}
+
+			weekday = converted
+		case strings.Contains(token, ":"):
+			clock = token
+		case strings.Contains(token, "-") || strings.HasPrefix(token, "*"):
+			date = token
+		case index == len(tokens)-1:
+			if _, err := time.LoadLocation(token); err != nil {
+				return "", fmt.Errorf("%w: time zone %q", ErrSystemdCalendar, token)
+			}
+
+			conv.location = token
+			conv.notes = append(conv.notes, fmt.Sprintf("runs in %s; set CRON_TZ=%s where the cron daemon supports it", token, token))
+		default:
+			return "", fmt.Errorf("%w: %q", ErrSystemdCalendar, token)
+		}
+	}
+
+	dateParts := strings.Split(date, "-")
+	if len(dateParts) == 2 {
+		dateParts = append([]string{"*"}, dateParts...)
+	}
+
+	if len(dateParts) != 3 {
+		return "", fmt.Errorf("%w: date %q", ErrSystemdCalendar, date)
+	}
+
+	if dateParts[0] != "*" {
+		return "", fmt.Errorf("%w: year %q (cron has no year field)", ErrSystemdCalendar, dateParts[0])
+	}
+
+	if strings.Contains(dateParts[2], "~") {
+		return "", fmt.Errorf("%w: day %q counts from the end of the month", ErrSystemdCalendar, dateParts[2])
+	}
+
+	clockParts := strings.Split(clock, ":")
+	if len(clockParts) < 2 || len(clockParts) > 3 {
+		return "", fmt.Errorf("%w: time %q", ErrSystemdCalendar, clock)
+	}
+
+	if len(clockParts) == 3 && trimLeadingZeros(strings.Split(clockParts[2], ".")[0]) != "0" {
+		return "", fmt.Errorf("%w: second %q (cron runs on whole minutes)", ErrSystemdCalendar, clockParts[2])
+	}
+
+	fields := []string{clockParts[1], clockParts[0], dateParts[2], dateParts[1]}
+
+	for fieldIndex, value := range fields {
+		converted, err := convertCalendarValue(value, fieldIndex)
+		if err != nil {
+			return "", err
+		}
+
+		fields[fieldIndex] = converted
+	}
+
+	fields = append(fields, weekday)
+
+	// systemd runs when both the date and the weekday match, cron when either does
+	if fields[2] != "*" && weekday != "*" {
+		return "", fmt.Errorf("%w: %q needs both the day and the weekday to match; cron runs when either does",
+			ErrSystemdCalendar, conv.calendar)
+	}
+
+	if err := validateStandardFields(fields); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrSystemdCalendar, err)
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+// parseTimerUnit reads the OnCalendar= and Unit= settings of a timer unit,
+// following systemctl cat output through drop-ins, where an empty
+// OnCalendar= clears the ones set before it
+func parseTimerUnit(unit, text string) systemdTimer {
+	timer := systemdTimer{unit: unit, activates: strings.TrimSuffix(unit, ".timer") + ".service"}
+	section := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "["):
+			section = line
+		case section != "[Timer]":
+			continue
+		case strings.HasPrefix(line, "OnCalendar="):
+			value := strings.TrimSpace(strings.TrimPrefix(line, "OnCalendar="))
+			if value == "" {
+				timer.calendars = nil
+			} else {
+				timer.calendars = append(timer.calendars, value)
+			}
+		case strings.HasPrefix(line, "Unit="):
+			timer.activates = strings.TrimSpace(strings.TrimPrefix(line, "Unit="))
+		}
+	}
+
+	return timer
+}
+
+// listTimerUnits picks the timer units out of systemctl list-timers output
+func listTimerUnits(text string) []string {
+	var units []string
+
+	for _, line := range strings.Split(text, "\n") {
+		for _, token := range strings.Fields(line) {
+			if strings.HasSuffix(token, ".timer") {
+				units = append(units, token)
+			}
+		}
+	}
+
+	return units
+}
+
+// loadSystemdTimers reads the named timer unit files, or asks systemctl for
+// every timer when none are named. Names that are not files are read with
+// systemctl cat.
+func loadSystemdTimers(names []string, user bool, run commandRunner) ([]systemdTimer, error) {
+	systemctl := []string{"systemctl"}
+	if user {
+		systemctl = append(systemctl, "--user")
+	}
+
+	if len(names) == 0 {
+		out, err := run(append(systemctl, "list-timers", "--all", "--no-legend", "--no-pager"), "")
+		if err != nil {
+			return nil, err
+		}
+
+		names = listTimerUnits(out)
+	}
+
+	timers := make([]systemdTimer, 0, len(names))
+
+	for _, name := range names {
+		data, err := os.ReadFile(name) //nolint:gosec // Reading the unit files the user names is the point
+		text := string(data)
+
+		if err != nil {
+			if text, err = run(append(systemctl, "cat", "--no-pager", name), ""); err != nil {
+				return nil, err
+			}
+		}
+
+		timers = append(timers, parseTimerUnit(filepath.Base(name), text))
+	}
+
+	return timers, nil
+}
+
+// renderTimerTable lists each timer's calendar events with their cron
+// equivalents and next runs, and the notes and errors under them
+func renderTimerTable(timers []systemdTimer, now time.Time, strict bool) string {
+	rows := [][]string{{"UNIT", "ONCALENDAR", "CRON", "NEXT RUN"}}
+
+	var notes [][]string
+
+	for _, timer := range timers {
+		for _, calendar := range timer.calendars {
+			conv := convertCalendar(calendar, strict)
+			row := []string{timer.unit, calendar, orDash(conv.expr), "-"}
+
+			if conv.expr != "" {
+				location := time.Local
+				if conv.location != "" {
+					location, _ = time.LoadLocation(conv.location)
+				}
+
+				row[3] = orDash(nextJobRun(browserJob{expr: conv.expr, location: location}, now))
+			}
+
+			rows = append(rows, row)
+
+			lines := conv.notes
+			if conv.err != nil {
+				lines = append(lines, conv.err.Error())
+			}
+
+			notes = append(notes, lines)
+		}
+	}
+
+	widths := make([]int, len(rows[0]))
+
+	for _, row := range rows {
+		for column, cell := range row {
+			widths[column] = max(widths[column], len(cell))
+		}
+	}
+
+	var builder strings.Builder
+
+	for index, row := range rows {
+		fmt.Fprintf(&builder, "%-*s  %-*s  %-*s  %s\n", widths[0], row[0], widths[1], row[1], widths[2], row[2], row[3])
+
+		if index > 0 {
+			for _, note := range notes[index-1] {
+				builder.WriteString("  note: " + note + "\n")
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// renderTimerCrontab writes the timers as crontab lines that start the units
+// they activate, leaving calendar events cron cannot express as comments
+func renderTimerCrontab(timers []systemdTimer, user, strict bool) string {
+	systemctl := "systemctl"
+	if user {
+		systemctl += " --user"
+	}
+
+	var builder strings.Builder
+
+	for _, timer := range timers {
+		for _, calendar := range timer.calendars {
+			conv := convertCalendar(calendar, strict)
+			fmt.Fprintf(&builder, "# %s: OnCalendar=%s\n", timer.unit, calendar)
+
+			for _, note := range conv.notes {
+				builder.WriteString("# note: " + note + "\n")
+			}
+
+			if conv.err != nil {
+				builder.WriteString("# " + conv.err.Error() + "\n")
+
+				continue
+			}
+
+			fmt.Fprintf(&builder, "%s %s start %s\n", conv.expr, systemctl, shellQuote(timer.activates))
+		}
+	}
+
+	return builder.String()
+}
+
+// runSystemd lists systemd timers with the cron equivalents of their
+// OnCalendar= settings, or writes them as a crontab with --crontab
+func runSystemd(args []string, stdout, stderr io.Writer) error {
+	var user, crontab, strict bool
+
+	flags := flag.NewFlagSet("systemd", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&user, "user", false, "read the user's timers instead of the system's")
+	flags.BoolVar(&crontab, "crontab", false, "write the timers as crontab lines")
+	flags.BoolVar(&strict, "strict-names", false, "take only weekday abbreviations and full names, like Mon and Monday")
+
+	names, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	timers, err := loadSystemdTimers(names, user, execRunner)
+	if err != nil {
+		return err
+	}
+
+	if crontab {
+		fmt.Fprint(stdout, renderTimerCrontab(timers, user, strict))
+	} else {
+		fmt.Fprint(stdout, renderTimerTable(timers, time.Now(), strict))
+	}
+
+	return nil
+}
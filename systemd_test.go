@@ -0,0 +1,213 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestConvertCalendar verifies OnCalendar shorthands, weekdays, dates,
+// repetitions, and time zones written as cron.
+func TestConvertCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		calendar string
+		expected string
+		note     string
+	}{
+		{"daily", "0 0 * * *", ""},
+		{"weekly", "0 0 * * 1", "Mondays"},
+		{"Mon..Fri *-*-* 06:30:00", "30 6 * * MON-FRI", ""},
+		{"Sat,Sun 10:00", "0 10 * * SAT,SUN", ""},
+		{"*:0/15", "*/15 * * * *", ""},
+		{"*-*-01 04:05", "5 4 1 * *", ""},
+		{"*-01,07-01 00:00:00", "0 0 1 1,7 *", ""},
+		{"08..17:00/30", "*/30 8-17 * * *", ""},
+		{"*:05/20", "5-59/20 * * * *", ""},
+		{"Mon", "0 0 * * MON", ""},
+		{"03:00 Europe/Berlin", "0 3 * * *", "CRON_TZ=Europe/Berlin"},
+	}
+
+	for _, tt := range tests {
+		conv := convertCalendar(tt.calendar, true)
+		if conv.err != nil || conv.expr != tt.expected {
+			t.Errorf("convertCalendar(%q) = %q, %v, expected %q", tt.calendar, conv.expr, conv.err, tt.expected)
+		}
+
+		if tt.note != "" && !strings.Contains(strings.Join(conv.notes, "\n"), tt.note) {
+			t.Errorf("convertCalendar(%q) notes %q, expected one mentioning %q", tt.calendar, conv.notes, tt.note)
+		}
+	}
+}
+
+// TestConvertCalendarStrictWeekdays verifies that strict matching takes
+// weekdays abbreviated or written out, as systemd does, and rejects other
+// words that start with a weekday.
+func TestConvertCalendarStrictWeekdays(t *testing.T) {
+	t.Parallel()
+
+	for _, calendar := range []string{"Monday..Friday 06:00", "mon..FRI 06:00"} {
+		if conv := convertCalendar(calendar, true); conv.expr != "0 6 * * MON-FRI" {
+			t.Errorf("convertCalendar(%q, true) = %q, %v, expected 0 6 * * MON-FRI", calendar, conv.expr, conv.err)
+		}
+	}
+
+	for _, calendar := range []string{"Tues 06:00", "TuesdayFoo 06:00"} {
+		if conv := convertCalendar(calendar, false); conv.expr != "0 6 * * TUE" {
+			t.Errorf("convertCalendar(%q, false) = %q, %v, expected 0 6 * * TUE", calendar, conv.expr, conv.err)
+		}
+
+		if conv := convertCalendar(calendar, true); !errors.Is(conv.err, ErrSystemdCalendar) {
+			t.Errorf("convertCalendar(%q, true) = %q, %v, expected ErrSystemdCalendar", calendar, conv.expr, conv.err)
+		}
+	}
+}
+
+// TestConvertCalendarErrors verifies that events cron cannot express are
+// reported rather than converted to something that runs at other times.
+func TestConvertCalendarErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, calendar := range []string{
+		"",
+		"2026-*-* 00:00",
+		"*-*-* 00:00:30",
+		"*-02~03",
+		"Mon *-*-01 00:00",
+		"Someday",
+		"*-*-* 25:00",
+		"12:00 Mars/Olympus",
+	} {
+		conv := convertCalendar(calendar, false)
+		if !errors.Is(conv.err, ErrSystemdCalendar) || conv.expr != "" {
+			t.Errorf("convertCalendar(%q) = %q, %v, expected ErrSystemdCalendar", calendar, conv.expr, conv.err)
+		}
+	}
+}
+
+// TestParseTimerUnit verifies that drop-ins can reset OnCalendar= and that
+// Unit= overrides the activated service.
+func TestParseTimerUnit(t *testing.T) {
+	t.Parallel()
+
+	text := `# /usr/lib/systemd/system/backup.timer
+[Unit]
+Description=Nightly backup
+
+[Timer]
+OnCalendar=daily
+Persistent=true
+
+# /etc/systemd/system/backup.timer.d/override.conf
+[Timer]
+OnCalendar=
+OnCalendar=Mon..Fri 02:00
+OnCalendar=Sat 04:00
+Unit=archive.service
+`
+
+	timer := parseTimerUnit("backup.timer", text)
+	if timer.activates != "archive.service" || !slices.Equal(timer.calendars, []string{"Mon..Fri 02:00", "Sat 04:00"}) {
+		t.Errorf("Unexpected timer %+v", timer)
+	}
+
+	if timer = parseTimerUnit("logrotate.timer", "[Timer]\nOnCalendar=daily\n"); timer.activates != "logrotate.service" {
+		t.Errorf("Expected logrotate.service, got %q", timer.activates)
+	}
+}
+
+// TestLoadSystemdTimers verifies that timers are listed with systemctl
+// list-timers, read with systemctl cat, or read from the named files.
+func TestLoadSystemdTimers(t *testing.T) {
+	t.Parallel()
+
+	var ran []string
+
+	run := func(argv []string, _ string) (string, error) {
+		ran = append(ran, strings.Join(argv, " "))
+
+		switch argv[len(argv)-1] {
+		case "--no-pager":
+			return "Thu 2026-10-15 00:00:00 UTC 5h left - - logrotate.timer logrotate.service\n", nil
+		case "logrotate.timer":
+			return "[Timer]\nOnCalendar=daily\n", nil
+		}
+
+		return "", fmt.Errorf("%w: no such unit", ErrCrontabCommand)
+	}
+
+	timers, err := loadSystemdTimers(nil, true, run)
+	if err != nil || len(timers) != 1 || timers[0].unit != "logrotate.timer" || timers[0].calendars[0] != "daily" {
+		t.Fatalf("Unexpected timers %+v, %v", timers, err)
+	}
+
+	expected := []string{
+		"systemctl --user list-timers --all --no-legend --no-pager",
+		"systemctl --user cat --no-pager logrotate.timer",
+	}
+	if !slices.Equal(ran, expected) {
+		t.Errorf("Ran %q, expected %q", ran, expected)
+	}
+
+	path := filepath.Join(t.TempDir(), "report.timer")
+	if err := os.WriteFile(path, []byte("[Timer]\nOnCalendar=hourly\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	timers, err = loadSystemdTimers([]string{path}, false, run)
+	if err != nil || timers[0].unit != "report.timer" || timers[0].calendars[0] != "hourly" {
+		t.Errorf("Unexpected timers %+v, %v", timers, err)
+	}
+
+	if _, err := loadSystemdTimers([]string{"missing.timer"}, false, run); !errors.Is(err, ErrCrontabCommand) {
+		t.Errorf("Expected ErrCrontabCommand, got %v", err)
+	}
+}
+
+// TestRenderTimers verifies the table and the crontab written for timers,
+// with events cron cannot express left as comments.
+func TestRenderTimers(t *testing.T) {
+	t.Parallel()
+
+	timers := []systemdTimer{
+		{unit: "backup.timer", activates: "backup.service", calendars: []string{"Mon..Fri 02:00", "*-*-* 00:00:30"}},
+		{unit: "report.timer", activates: "report.service", calendars: []string{"weekly"}},
+	}
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.Local)
+
+	table := renderTimerTable(timers, now, false)
+	for _, want := range []string{
+		"UNIT          ONCALENDAR      CRON             NEXT RUN",
+		"backup.timer  Mon..Fri 02:00  0 2 * * MON-FRI  Fri 2026-10-16 02:00",
+		"backup.timer  *-*-* 00:00:30  -                -\n  note: cannot convert OnCalendar: second",
+		"report.timer  weekly          0 0 * * 1",
+		"  note: weekly runs on Mondays",
+	} {
+		if !strings.Contains(table, want) {
+			t.Errorf("Table missing %q:\n%s", want, table)
+		}
+	}
+
+	crontab := renderTimerCrontab(timers, true, false)
+	for _, want := range []string{
+		"# backup.timer: OnCalendar=Mon..Fri 02:00\n0 2 * * MON-FRI systemctl --user start 'backup.service'\n",
+		"# backup.timer: OnCalendar=*-*-* 00:00:30\n# cannot convert OnCalendar: second",
+		"# note: weekly runs on Mondays, unlike cron's @weekly\n0 0 * * 1 systemctl --user start 'report.service'\n",
+	} {
+		if !strings.Contains(crontab, want) {
+			t.Errorf("Crontab missing %q:\n%s", want, crontab)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	maxTabs      = 9     // Most expressions open at once, one per alt+digit key
+	mergedRuns   = 8     // Runs listed in the merged timeline of all tabs
+	tabSeparator = " │ " // Drawn between tab labels
+)
+
+// mergedRun is one upcoming run in the merged timeline of all tabs
+type mergedRun struct {
+	at  time.Time // When the run happens
+	tab int       // Index of the tab whose expression runs
+}
+
+// tabExpressions returns the expression of every open tab, reading the
+// active one from the fields. Without tabs there is one implicit tab.
+func (m *model) tabExpressions() []string {
+	if len(m.tabs) == 0 {
+		return []string{m.buildCronExpression()}
+	}
+
+	expressions := append([]string(nil), m.tabs...)
+	expressions[m.activeTab] = m.buildCronExpression()
+
+	return expressions
+}
+
+// setExpression replaces the field values with those of an expression
+func (m *model) setExpression(expr string) {
+	parts, err := splitRawExpression(expr)
+	if err != nil {
+		return
+	}
+
+	for index, part := range parts {
+		m.inputs[index].SetValue(part)
+		m.inputs[index].CursorEnd()
+	}
+
+	m.syncRawFromFields()
+	m.rawConflict = ""
+}
+
+// switchTab stores the fields in the active tab and loads another one
+func (m *model) switchTab(index int) tea.Cmd {
+	if index < 0 || index >= len(m.tabs) || index == m.activeTab {
+		return nil
+	}
+
+	m.tabs[m.activeTab] = m.buildCronExpression()
+	m.activeTab = index
+	m.setExpression(m.tabs[index])
+
+	return m.scheduleCmd()
+}
+
+// newTab opens a tab starting from the current expression, so related jobs
+// can be designed from a common base
+func (m *model) newTab() tea.Cmd {
+	m.tabs = m.tabExpressions()
+	if len(m.tabs) >= maxTabs {
+		return nil
+	}
+
+	m.tabs = append(m.tabs, m.buildCronExpression())
+	m.activeTab = len(m.tabs) - 1
+
+	return nil
+}
+
+// closeTab closes the active tab and loads its neighbour. The last tab
+// cannot be closed.
+func (m *model) closeTab() tea.Cmd {
+	if len(m.tabs) < 2 {
+		return nil
+	}
+
+	m.tabs = append(m.tabs[:m.activeTab], m.tabs[m.activeTab+1:]...)
+	m.activeTab = min(m.activeTab, len(m.tabs)-1)
+	m.setExpression(m.tabs[m.activeTab])
+
+	return m.scheduleCmd()
+}
+
+// handleTabKey opens, closes, switches, and staggers tabs. It reports whether the key
+// was a tab key.
+func (m *model) handleTabKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch {
+	case key.Matches(msg, m.keys.NewTab):
+		return m.newTab(), true
+	case key.Matches(msg, m.keys.CloseTab):
+		return m.closeTab(), true
+	case key.Matches(msg, m.keys.Stagger):
+		return m.staggerTabs(), true
+	case key.Matches(msg, m.keys.NextTab):
+		if len(m.tabs) == 0 {
+			return nil, true
+		}
+
+		return m.switchTab((m.activeTab + 1) % len(m.tabs)), true
+	case key.Matches(msg, m.keys.PrevTab):
+		if len(m.tabs) == 0 {
+			return nil, true
+		}
+
+		return m.switchTab((m.activeTab + len(m.tabs) - 1) % len(m.tabs)), true
+	}
+
+	if digit, ok := strings.CutPrefix(msg.String(), "alt+"); ok {
+		if number, err := strconv.Atoi(digit); err == nil && number >= 1 && number <= maxTabs {
+			return m.switchTab(number - 1), true
+		}
+	}
+
+	return nil, false
+}
+
+// resolveTabExpression resolves the Jenkins H tokens of a tab's expression
+// with the editor's seed
+func (m *model) resolveTabExpression(expr string) (string, error) {
+	if !hasJenkinsHash(expr) {
+		return expr, nil
+	}
+
+	return resolveJenkinsHash(expr, m.hashSeed)
+}
+
+// mergedTimeline returns the next runs of every tab in time order. Tabs
+// whose expressions do not parse are left out.
+func (m *model) mergedTimeline(now time.Time) []mergedRun {
+	var runs []mergedRun
+
+	for index, expr := range m.tabExpressions() {
+		resolved, err := m.resolveTabExpression(expr)
+		if err != nil {
+			continue
+		}
+
+		schedule, err := standardParser.Parse(resolved)
+		if err != nil {
+			continue
+		}
+
+		next := now
+		for range mergedRuns {
+			if next = schedule.Next(next); next.IsZero() {
+				break
+			}
+
+			runs = append(runs, mergedRun{at: next, tab: index})
+		}
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].at.Before(runs[j].at) })
+
+	return runs[:min(len(runs), mergedRuns)]
+}
+
+// renderTabs renders the tab bar while more than one tab is open
+func (m *model) renderTabs() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+
+	labels := make([]string, 0, len(m.tabs))
+
+	for index, expr := range m.tabExpressions() {
+		label := fmt.Sprintf("%d %s", index+1, expr)
+		if index == m.activeTab {
+			labels = append(labels, focusedLabelStyle.Render(label))
+		} else {
+			labels = append(labels, labelStyle.Render(label))
+		}
+	}
+
+	return m.place(strings.Join(labels, labelStyle.Render(tabSeparator))) + "\n\n"
+}
+
+// renderMergedTimeline renders the next runs of all tabs while more than one
+// tab is open
+func (m *model) renderMergedTimeline() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+
+	expressions := m.tabExpressions()
+	lines := []string{labelStyle.Render("all next runs")}
+
+	for _, run := range m.mergedTimeline(time.Now()) {
+		lines = append(lines, fmt.Sprintf("%s  %s", infoStyle.Render(run.at.Format("2006-01-02 15:04:05")),
+			labelStyle.Render(fmt.Sprintf("%d %s", run.tab+1, expressions[run.tab]))))
+	}
+
+	return m.place(peekStyle.Render(strings.Join(lines, "\n"))) + "\n"
+}
+
+// renderPlainTabs lists the tabs and the merged timeline as plain lines
+func (m *model) renderPlainTabs() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+
+	var builder strings.Builder
+
+	expressions := m.tabExpressions()
+	for index, expr := range expressions {
+		marker := "  "
+		if index == m.activeTab {
+			marker = "> "
+		}
+
+		fmt.Fprintf(&builder, "%stab %d: %s\n", marker, index+1, expr)
+	}
+
+	builder.WriteString("all next runs:\n")
+
+	for _, run := range m.mergedTimeline(time.Now()) {
+		fmt.Fprintf(&builder, "  %s  tab %d\n", run.at.Format("2006-01-02 15:04:05"), run.tab+1)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// altKey returns an alt-modified key press
+func altKey(keyType tea.KeyType, runes ...rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: keyType, Runes: runes, Alt: true}
+}
+
+// TestTabsOpenAndSwitch verifies that alt+n opens a tab from the current
+// expression and that switching tabs keeps each tab's own fields.
+func TestTabsOpenAndSwitch(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("0 9 * * 1-5")
+
+	newModel, _ := m.Update(altKey(tea.KeyRunes, 'n'))
+	m = assertModelType(t, newModel)
+
+	if len(m.tabs) != 2 || m.activeTab != 1 || m.buildCronExpression() != "0 9 * * 1-5" {
+		t.Fatalf("Expected a second tab with the same expression, got %q at %d", m.tabs, m.activeTab)
+	}
+
+	m.setExpression("30 17 * * 1-5")
+
+	newModel, _ = m.Update(altKey(tea.KeyRunes, '1'))
+	m = assertModelType(t, newModel)
+
+	if m.activeTab != 0 || m.buildCronExpression() != "0 9 * * 1-5" || m.rawInput.Value() != "0 9 * * 1-5" {
+		t.Errorf("Expected alt+1 to load the first tab, got %q", m.buildCronExpression())
+	}
+
+	newModel, _ = m.Update(altKey(tea.KeyRight))
+	m = assertModelType(t, newModel)
+
+	if m.activeTab != 1 || m.buildCronExpression() != "30 17 * * 1-5" {
+		t.Errorf("Expected alt+right to load the second tab, got %q", m.buildCronExpression())
+	}
+
+	newModel, _ = m.Update(altKey(tea.KeyRight))
+	m = assertModelType(t, newModel)
+
+	if m.activeTab != 0 {
+		t.Errorf("Expected alt+right to wrap to the first tab, got %d", m.activeTab)
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "1 0 9 * * 1-5") || !strings.Contains(view, "2 30 17 * * 1-5") || !strings.Contains(view, "all next runs") {
+		t.Errorf("Expected the tab bar and merged timeline in the view, got:\n%s", view)
+	}
+}
+
+// TestTabsClose verifies that alt+w closes the active tab and loads its
+// neighbour, and that the last tab stays open.
+func TestTabsClose(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.tabs = []string{"0 1 * * *", "0 2 * * *", "0 3 * * *"}
+	m.activeTab = 2
+	m.setExpression("0 3 * * *")
+
+	newModel, _ := m.Update(altKey(tea.KeyRunes, 'w'))
+	m = assertModelType(t, newModel)
+
+	if len(m.tabs) != 2 || m.activeTab != 1 || m.buildCronExpression() != "0 2 * * *" {
+		t.Errorf("Expected the second tab to be loaded, got %q at %d", m.tabs, m.activeTab)
+	}
+
+	m.closeTab()
+	m.closeTab()
+
+	if len(m.tabs) != 1 || m.buildCronExpression() != "0 1 * * *" {
+		t.Errorf("Expected the last tab to stay open, got %q", m.tabs)
+	}
+
+	if m.renderTabs() != "" {
+		t.Error("Expected no tab bar with a single tab")
+	}
+}
+
+// TestMergedTimeline verifies that the runs of all tabs are merged in time
+// order and that invalid tabs are left out.
+func TestMergedTimeline(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.tabs = []string{"0 9 * * *", "30 8,10 * * *", "61 * * * *"}
+	m.setExpression("0 9 * * *")
+
+	now := time.Date(2025, time.March, 7, 8, 0, 0, 0, time.UTC)
+	runs := m.mergedTimeline(now)
+
+	if len(runs) != mergedRuns {
+		t.Fatalf("Expected %d runs, got %d", mergedRuns, len(runs))
+	}
+
+	expected := []struct {
+		hour, minute, tab int
+	}{
+		{8, 30, 1}, {9, 0, 0}, {10, 30, 1},
+	}
+
+	for index, want := range expected {
+		got := runs[index]
+		if got.at.Hour() != want.hour || got.at.Minute() != want.minute || got.tab != want.tab {
+			t.Errorf("Run %d = %v on tab %d, expected %02d:%02d on tab %d",
+				index, got.at, got.tab, want.hour, want.minute, want.tab)
+		}
+	}
+
+	for _, run := range runs {
+		if run.tab == 2 {
+			t.Error("Expected the invalid tab to be left out")
+		}
+	}
+}
+
+// TestTabsSession verifies that open tabs are saved with the session and
+// restored into the fields.
+func TestTabsSession(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.tabs = []string{"0 1 * * *", "0 2 * * *"}
+	m.activeTab = 1
+	m.setExpression("15 2 * * *")
+
+	state := m.sessionState()
+	if len(state.Tabs) != 2 || state.Tabs[1] != "15 2 * * *" || state.ActiveTab != 1 {
+		t.Fatalf("Unexpected session tabs %q at %d", state.Tabs, state.ActiveTab)
+	}
+
+	restored := initialModel()
+	restored.restoreSession(state)
+
+	if restored.activeTab != 1 || restored.buildCronExpression() != "15 2 * * *" || restored.description == "" {
+		t.Errorf("Expected the active tab to be restored, got %q", restored.buildCronExpression())
+	}
+
+	if state := initialModel().sessionState(); state.Tabs != nil {
+		t.Errorf("Expected no tabs saved for a single expression, got %q", state.Tabs)
+	}
+}
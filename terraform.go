@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	terraformTargetARN  = "REPLACE_WITH_TARGET_ARN" // Placeholder for the EventBridge Scheduler target
+	terraformRoleARN    = "REPLACE_WITH_ROLE_ARN"   // Placeholder for the role the scheduler assumes
+	terraformTargetURI  = "https://example.com/run" // Placeholder for the Cloud Scheduler HTTP target
+	terraformAppSetting = "_SCHEDULE"               // Suffix of the Azure app setting holding the schedule
+)
+
+// terraformName turns a job name into a Terraform resource name, e.g.
+// "nightly-backup" becomes "nightly_backup"
+func terraformName(name string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToLower(name) {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			builder.WriteRune(char)
+		} else {
+			builder.WriteRune('_')
+		}
+	}
+
+	identifier := strings.Trim(builder.String(), "_")
+	if identifier == "" || unicode.IsDigit(rune(identifier[0])) {
+		identifier = "job_" + identifier
+	}
+
+	return identifier
+}
+
+// hclString quotes a string for HCL, escaping template sequences so values
+// like "${HOME}" are written literally
+func hclString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// awsExpression converts the job's schedule to an EventBridge cron(...) expression
+func awsExpression(job exportJob) (string, error) {
+	result, err := convertExpression(strings.Join(job.fields, " "), dialectStandard, dialectAWS, "")
+	if err != nil {
+		return "", err
+	}
+
+	return result.expression, nil
+}
+
+// renderTerraformEventBridge renders an aws_cloudwatch_event_rule for the schedule
+func renderTerraformEventBridge(job exportJob) (string, error) {
+	expression, err := awsExpression(job)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "resource \"aws_cloudwatch_event_rule\" %q {\n", terraformName(job.name))
+	fmt.Fprintf(&builder, "  name                = %s\n", hclString(terraformName(job.name)))
+	fmt.Fprintf(&builder, "  description         = %s\n", hclString("Runs "+job.command))
+	fmt.Fprintf(&builder, "  schedule_expression = %s\n", hclString(expression))
+	builder.WriteString("}\n")
+
+	return builder.String(), nil
+}
+
+// renderTerraformScheduler renders an aws_scheduler_schedule for the schedule
+func renderTerraformScheduler(job exportJob) (string, error) {
+	expression, err := awsExpression(job)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "resource \"aws_scheduler_schedule\" %q {\n", terraformName(job.name))
+	fmt.Fprintf(&builder, "  name                         = %s\n", hclString(terraformName(job.name)))
+	fmt.Fprintf(&builder, "  description                  = %s\n", hclString("Runs "+job.command))
+	fmt.Fprintf(&builder, "  schedule_expression          = %s\n", hclString(expression))
+	fmt.Fprintf(&builder, "  schedule_expression_timezone = %s\n", hclString(job.timezone))
+	builder.WriteString("\n")
+	builder.WriteString("  flexible_time_window {\n")
+	builder.WriteString("    mode = \"OFF\"\n")
+	builder.WriteString("  }\n")
+	builder.WriteString("\n")
+	builder.WriteString("  target {\n")
+	fmt.Fprintf(&builder, "    arn      = %s\n", hclString(terraformTargetARN))
+	fmt.Fprintf(&builder, "    role_arn = %s\n", hclString(terraformRoleARN))
+	builder.WriteString("  }\n")
+	builder.WriteString("}\n")
+
+	return builder.String(), nil
+}
+
+// renderTerraformGCP renders a google_cloud_scheduler_job; Cloud Scheduler
+// takes standard five-field cron as is
+func renderTerraformGCP(job exportJob) (string, error) {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "resource \"google_cloud_scheduler_job\" %q {\n", terraformName(job.name))
+	fmt.Fprintf(&builder, "  name        = %s\n", hclString(terraformName(job.name)))
+	fmt.Fprintf(&builder, "  description = %s\n", hclString("Runs "+job.command))
+	fmt.Fprintf(&builder, "  schedule    = %s\n", hclString(strings.Join(job.fields, " ")))
+	fmt.Fprintf(&builder, "  time_zone   = %s\n", hclString(job.timezone))
+	builder.WriteString("\n")
+	builder.WriteString("  http_target {\n")
+	fmt.Fprintf(&builder, "    uri         = %s\n", hclString(terraformTargetURI))
+	builder.WriteString("    http_method = \"POST\"\n")
+	builder.WriteString("  }\n")
+	builder.WriteString("}\n")
+
+	return builder.String(), nil
+}
+
+// renderTerraformAzure renders the app setting an Azure Functions timer
+// trigger reads its six-field NCRONTAB schedule from, with the binding that
+// references it
+func renderTerraformAzure(job exportJob) (string, error) {
+	result, err := convertExpression(strings.Join(job.fields, " "), dialectStandard, dialectAzure, "")
+	if err != nil {
+		return "", err
+	}
+
+	setting := strings.ToUpper(terraformName(job.name)) + terraformAppSetting
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "resource \"azurerm_linux_function_app\" %q {\n", terraformName(job.name))
+	builder.WriteString("  # ...\n")
+	builder.WriteString("\n")
+	builder.WriteString("  app_settings = {\n")
+	fmt.Fprintf(&builder, "    %s = %s\n", hclString(setting), hclString(result.expression))
+	builder.WriteString("  }\n")
+	builder.WriteString("}\n")
+	builder.WriteString("\n")
+	builder.WriteString("# function.json timer binding:\n")
+	fmt.Fprintf(&builder, "# { \"name\": \"timer\", \"type\": \"timerTrigger\", \"direction\": \"in\", \"schedule\": \"%%%s%%\" }\n", setting)
+
+	return builder.String(), nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestTerraformExports verifies that each Terraform template embeds the
+// schedule converted for its provider.
+func TestTerraformExports(t *testing.T) {
+	t.Parallel()
+
+	job, err := newExportJob("30 2 * * 1-5", "nightly-backup", "backup.sh", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		render   func(exportJob) (string, error)
+		expected []string
+	}{
+		{renderTerraformEventBridge, []string{
+			`resource "aws_cloudwatch_event_rule" "nightly_backup"`,
+			`schedule_expression = "cron(30 2 ? * 2-6 *)"`,
+		}},
+		{renderTerraformScheduler, []string{
+			`resource "aws_scheduler_schedule" "nightly_backup"`,
+			`"cron(30 2 ? * 2-6 *)"`,
+			`schedule_expression_timezone = "Europe/Lisbon"`,
+			"flexible_time_window",
+		}},
+		{renderTerraformGCP, []string{
+			`resource "google_cloud_scheduler_job" "nightly_backup"`,
+			`schedule    = "30 2 * * 1-5"`,
+		}},
+		{renderTerraformAzure, []string{
+			`"NIGHTLY_BACKUP_SCHEDULE" = "0 30 2 * * 1-5"`,
+			`"schedule": "%NIGHTLY_BACKUP_SCHEDULE%"`,
+		}},
+	}
+
+	for _, tt := range tests {
+		rendered, err := tt.render(job)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+
+			continue
+		}
+
+		for _, want := range tt.expected {
+			if !strings.Contains(rendered, want) {
+				t.Errorf("Expected %q in:\n%s", want, rendered)
+			}
+		}
+	}
+
+	job.fields = strings.Fields("0 0 1 * 1")
+	if _, err := renderTerraformEventBridge(job); !errors.Is(err, ErrUnsupportedSyntax) {
+		t.Errorf("Expected ErrUnsupportedSyntax when both day fields are set, got %v", err)
+	}
+}
+
+// TestTerraformQuoting verifies resource names and escaping of HCL template sequences.
+func TestTerraformQuoting(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"nightly backup": "nightly_backup",
+		"Daily-Report":   "daily_report",
+		"9am":            "job_9am",
+		"--":             "job_",
+	}
+
+	for name, expected := range tests {
+		if got := terraformName(name); got != expected {
+			t.Errorf("terraformName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+
+	if got := hclString(`echo "${HOME}" %{x}`); got != `"echo \"$${HOME}\" %%{x}"` {
+		t.Errorf("Unexpected HCL string %s", got)
+	}
+}
+
+// TestCopyFormatCycle verifies that ctrl+x cycles what y copies and that the
+// footer names the selected format.
+func TestCopyFormatCycle(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+
+	if m.copyHint() != "y to copy" {
+		t.Errorf("Expected the bare expression by default, got %q", m.copyHint())
+	}
+
+	m.cycleCopyFormat()
+	m.cycleCopyFormat()
+
+	if m.copyFormatName() != "terraform-eventbridge" || !strings.Contains(m.renderFooter(), "copy as terraform-eventbridge") {
+		t.Errorf("Expected the second format to be selected, got %q", m.copyFormatName())
+	}
+
+	text, err := m.exportText()
+	if err != nil || !strings.Contains(text, "cron(20 4 * * ? *)") {
+		t.Errorf("Expected the export to embed the expression, got %q, %v", text, err)
+	}
+
+	for range exporters() {
+		m.cycleCopyFormat()
+	}
+
+	if m.copyFormat != 1 {
+		t.Errorf("Expected the cycle to wrap, got %d", m.copyFormat)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// zoneRunLayout is the layout of the next run in each listed time zone
+const zoneRunLayout = "Mon 2006-01-02 15:04 MST"
+
+// zoneList is time zones a run is shown in, in order
+type zoneList []*time.Location
+
+// parseZoneList loads the time zones of a list such as
+// "UTC,America/New_York,Asia/Tokyo", skipping empty names
+func parseZoneList(names []string) (zoneList, error) {
+	var zones zoneList
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		zone, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("time zone %q: %w", name, err)
+		}
+
+		zones = append(zones, zone)
+	}
+
+	return zones, nil
+}
+
+// zonedFormatter formats a run in every zone of a list. The csv format gives
+// the Unix seconds once and then the RFC 3339 time in each zone, under a
+// header naming them; the others give the run in each zone followed by the
+// zone's name.
+func zonedFormatter(format string, formatRun func(time.Time) string, zones zoneList) (string, func(time.Time) string) {
+	if format == nextFormatCSV {
+		names := make([]string, 0, len(zones)+1)
+		names = append(names, "unix")
+
+		for _, zone := range zones {
+			names = append(names, zone.String())
+		}
+
+		return strings.Join(names, ","), func(run time.Time) string {
+			columns := []string{strconv.FormatInt(run.Unix(), 10)}
+			for _, zone := range zones {
+				columns = append(columns, run.In(zone).Format(time.RFC3339))
+			}
+
+			return strings.Join(columns, ",")
+		}
+	}
+
+	return "", func(run time.Time) string {
+		columns := make([]string, 0, len(zones))
+		for _, zone := range zones {
+			columns = append(columns, formatRun(run.In(zone))+" ("+zone.String()+")")
+		}
+
+		return strings.Join(columns, "  ")
+	}
+}
+
+// zoneLines gives the next run in each configured time zone, names padded
+// so the times line up
+func (m *model) zoneLines() []string {
+	if len(m.timezones) == 0 || m.nextTime.IsZero() {
+		return nil
+	}
+
+	width := 0
+	for _, zone := range m.timezones {
+		width = max(width, len(zone.String()))
+	}
+
+	lines := make([]string, 0, len(m.timezones))
+	for _, zone := range m.timezones {
+		lines = append(lines, fmt.Sprintf("%-*s  %s", width, zone.String(), m.nextTime.In(zone).Format(zoneRunLayout)))
+	}
+
+	return lines
+}
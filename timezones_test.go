@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestZonedFormatter verifies each run in every zone, with one Unix column
+// and a header naming the zones in the csv format.
+func TestZonedFormatter(t *testing.T) {
+	t.Parallel()
+
+	zones, err := parseZoneList([]string{"UTC", " America/New_York ", "", "Asia/Tokyo"})
+	if err != nil || len(zones) != 3 {
+		t.Fatalf("Unexpected zones %v, %v", zones, err)
+	}
+
+	run := time.Date(2026, time.October, 16, 14, 30, 0, 0, time.UTC)
+	formatRun, _ := nextFormatter("Mon 15:04", run)
+
+	if header, format := zonedFormatter("Mon 15:04", formatRun, zones); header != "" ||
+		format(run) != "Fri 14:30 (UTC)  Fri 10:30 (America/New_York)  Fri 23:30 (Asia/Tokyo)" {
+		t.Errorf("Unexpected row %q under %q", format(run), header)
+	}
+
+	header, format := zonedFormatter(nextFormatCSV, formatRun, zones)
+	if header != "unix,UTC,America/New_York,Asia/Tokyo" ||
+		format(run) != "1792161000,2026-10-16T14:30:00Z,2026-10-16T10:30:00-04:00,2026-10-16T23:30:00+09:00" {
+		t.Errorf("Unexpected row %q under %q", format(run), header)
+	}
+
+	if _, err := parseZoneList([]string{"Mars/Base"}); err == nil {
+		t.Error("Expected an error for an unknown zone")
+	}
+}
+
+// TestZoneLines verifies that the editor shows the next run in each
+// configured zone with the names lined up.
+func TestZoneLines(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.timezones, _ = parseZoneList([]string{"UTC", "Asia/Tokyo"})
+	m.setExpression("0 9 * * *")
+	m.lastCronExpr = m.buildCronExpression()
+	m.Update(computeSchedule("0 9 * * *", time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)))
+
+	expected := []string{"UTC         Fri 2026-10-16 09:00 UTC", "Asia/Tokyo  Fri 2026-10-16 18:00 JST"}
+	if lines := m.zoneLines(); !slices.Equal(lines, expected) {
+		t.Errorf("Expected %q, got %q", expected, lines)
+	}
+
+	if view := m.View(); !strings.Contains(view, expected[1]) {
+		t.Errorf("Expected the zones in the view:\n%s", view)
+	}
+
+	if plain := m.renderPlain(); !strings.Contains(plain, "next run in "+expected[0]+"\n") {
+		t.Errorf("Expected the zones in plain mode:\n%s", plain)
+	}
+}
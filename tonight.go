@@ -0,0 +1,257 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultShiftLength = 12 * time.Hour             // Length of the window when --to is not given
+	maxHandoffTimes    = 3                          // Runs listed one by one before only the first and last are
+	handoffTimeLayout  = "Mon 15:04"                // Layout of the run times in the table
+	handoffTitleLayout = "Mon 2006-01-02 15:04 MST" // Layout of the window in the title
+)
+
+// handoffJob is one job in a handoff report
+type handoffJob struct {
+	name     string         // Command or workspace entry name
+	owner    string         // Person or team responsible, "" when unknown
+	fields   []string       // Five standard fields, macros expanded
+	location *time.Location // Time zone the schedule is read in
+}
+
+// handoffRow is a job that runs in the window, with when it runs
+type handoffRow struct {
+	job   handoffJob
+	runs  []time.Time // The first maxHandoffTimes runs
+	last  time.Time   // Last run in the window
+	count int         // Runs in the window
+}
+
+// crontabHandoffJobs reads the jobs of a crontab, in location and without owners
+func crontabHandoffJobs(text string, location *time.Location) ([]handoffJob, error) {
+	jobs, err := crontabJobs(text)
+	if err != nil {
+		return nil, err
+	}
+
+	handoff := make([]handoffJob, 0, len(jobs))
+	for _, job := range jobs {
+		handoff = append(handoff, handoffJob{name: job.name, fields: strings.Fields(job.expr), location: location})
+	}
+
+	return handoff, nil
+}
+
+// workspaceHandoffJobs reads the entries of a workspace with their owners,
+// each in its own time zone or location
+func workspaceHandoffJobs(ws workspace, location *time.Location) ([]handoffJob, error) {
+	handoff := make([]handoffJob, 0, len(ws.Entries))
+
+	for _, entry := range ws.Entries {
+		if err := validateEntry(entry); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+
+		fields, _ := splitRawExpression(entry.Schedule) // Validated above
+
+		job := handoffJob{name: entry.Name, owner: entry.Owner, fields: fields, location: location}
+		if entry.Timezone != "" {
+			job.location, _ = time.LoadLocation(entry.Timezone) // Validated above
+		}
+
+		handoff = append(handoff, job)
+	}
+
+	return handoff, nil
+}
+
+// renderHandoff renders what runs from from up to to as Markdown for a
+// handoff document: a title with the window, a table of the jobs that run in
+// it in order of their first run, with owners and risk badges, and the jobs
+// that stay quiet
+func renderHandoff(jobs []handoffJob, rules []riskRule, from, to time.Time) (string, error) {
+	var (
+		rows  []handoffRow
+		quiet []string
+		total int
+	)
+
+	for _, job := range jobs {
+		expr := strings.Join(job.fields, " ")
+
+		schedule, err := standardParser.Parse(expr)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s: %w", ErrCronParse, job.name, err)
+		}
+
+		row := handoffRow{job: job}
+
+		row.count = newRunCounter(cronSpec{fields: job.fields}, schedule).walk(from.In(job.location), to.In(job.location), func(run time.Time) {
+			if len(row.runs) < maxHandoffTimes {
+				row.runs = append(row.runs, run)
+			}
+
+			row.last = run
+		})
+
+		if row.count == 0 {
+			quiet = append(quiet, job.name)
+
+			continue
+		}
+
+		rows = append(rows, row)
+		total += row.count
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].runs[0].Before(rows[j].runs[0]) })
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "**Scheduled jobs, %s to %s**\n\n", from.Format(handoffTitleLayout), to.Format(handoffTitleLayout))
+
+	if len(rows) == 0 {
+		builder.WriteString("Nothing is scheduled to run.\n")
+
+		return builder.String(), nil
+	}
+
+	fmt.Fprintf(&builder, "%d of %d jobs run %d times.\n\n", len(rows), len(jobs), total)
+	builder.WriteString("| When | Job | Schedule | Owner | Risk |\n| --- | --- | --- | --- | --- |\n")
+
+	for _, row := range rows {
+		owner := row.job.owner
+		if owner == "" {
+			owner = "-"
+		}
+
+		fmt.Fprintf(&builder, "| %s | %s | `%s` | %s | %s |\n", row.when(from.Location()), escapeTableCell(row.job.name),
+			strings.Join(row.job.fields, " "), escapeTableCell(owner), assessRisk(strings.Join(row.job.fields, " "), rules).label())
+	}
+
+	if len(quiet) > 0 {
+		fmt.Fprintf(&builder, "\nNot running: %s.\n", strings.Join(quiet, ", "))
+	}
+
+	return builder.String(), nil
+}
+
+// when lists the runs of a row in location, or for frequent jobs how many
+// there are between the first and the last
+func (row handoffRow) when(location *time.Location) string {
+	if row.count > maxHandoffTimes {
+		return fmt.Sprintf("%d runs, %s to %s", row.count, row.runs[0].In(location).Format(handoffTimeLayout),
+			row.last.In(location).Format(handoffTimeLayout))
+	}
+
+	times := make([]string, 0, len(row.runs))
+	for _, run := range row.runs {
+		times = append(times, run.In(location).Format(handoffTimeLayout))
+	}
+
+	return strings.Join(times, ", ")
+}
+
+// escapeTableCell keeps a pipe in a job name or owner from splitting a
+// Markdown table cell
+func escapeTableCell(text string) string {
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+// runTonight prints a handoff report of the jobs of a crontab, or of the
+// workspace when no crontab is given, that run from --from, now by default,
+// up to --to, 12 hours later by default
+func runTonight(args []string, stdout, stderr io.Writer) error {
+	var start, end, workspacePath, configPath, timezone string
+
+	flags := flag.NewFlagSet("tonight", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&start, "from", "", "start of the window, e.g. 2025-01-01 18:00, now by default")
+	flags.StringVar(&end, "to", "", "end of the window, as a time or a duration after --from, 12h by default")
+	flags.StringVar(&workspacePath, "workspace", defaultWorkspacePath(), "workspace to report on when no crontab is given")
+	flags.StringVar(&configPath, "config", defaultConfigPath(), "config file with risk rules")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone of the window and the crontab")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) > 1 {
+		return fmt.Errorf("%w: crontab-guru tonight [--from TIME] [--to TIME] [--workspace FILE] [CRONTAB]", ErrUsage)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	from := time.Now().In(location)
+	if start != "" {
+		var ok bool
+		if from, ok = parseLayoutTime(start, location); !ok {
+			return fmt.Errorf("%w: --from %q is not a time like 2006-01-02 15:04", ErrUsage, start)
+		}
+	}
+
+	to := from.Add(defaultShiftLength)
+	if end != "" {
+		if to, err = parseRangeEnd(end, from, location); err != nil {
+			return err
+		}
+	}
+
+	if to.Before(from) {
+		return fmt.Errorf("%w: --to is before --from", ErrUsage)
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	rules := cfg.RiskRules
+	if rules == nil {
+		rules = defaultRiskRules
+	}
+
+	var jobs []handoffJob
+
+	if len(paths) == 1 {
+		text, err := readCrontab(paths[0])
+		if err != nil {
+			return err
+		}
+
+		if jobs, err = crontabHandoffJobs(text, location); err != nil {
+			return fmt.Errorf("%s: %w", paths[0], err)
+		}
+	} else {
+		ws, err := loadWorkspace(workspacePath)
+		if err != nil {
+			return err
+		}
+
+		if jobs, err = workspaceHandoffJobs(ws, location); err != nil {
+			return fmt.Errorf("%s: %w", workspacePath, err)
+		}
+	}
+
+	report, err := renderHandoff(jobs, rules, from, to.In(location))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, report)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRenderHandoff verifies that jobs running in the window are listed by
+// their first run with owners and risk badges, frequent jobs by their count,
+// and the rest as not running.
+func TestRenderHandoff(t *testing.T) {
+	t.Parallel()
+
+	ws := workspace{Entries: []workspaceEntry{
+		{Name: "report", Schedule: "0 9 * * 1-5", Owner: "finance"},
+		{Name: "poll", Schedule: "*/5 * * * *"},
+		{Name: "backup", Schedule: "@daily", Owner: "ops|dba"},
+		{Name: "tokyo", Schedule: "0 9 * * *", Timezone: "Asia/Tokyo"},
+	}}
+
+	jobs, err := workspaceHandoffJobs(ws, time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	from := time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	report, err := renderHandoff(jobs, defaultRiskRules, from, from.Add(defaultShiftLength))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"**Scheduled jobs, Mon 2025-03-10 18:00 UTC to Tue 2025-03-11 06:00 UTC**\n\n3 of 4 jobs run 146 times.\n",
+		"| 144 runs, Mon 18:00 to Tue 05:55 | poll | `*/5 * * * *` | - | medium risk: runs at least every 5 minutes, runs overnight |\n" +
+			"| Tue 00:00 | backup | `0 0 * * *` | ops\\|dba | medium risk: runs overnight |\n" +
+			"| Tue 00:00 | tokyo | `0 9 * * *` | - | low risk |\n",
+		"\nNot running: report.\n",
+	}
+
+	for _, part := range expected {
+		if !strings.Contains(report, part) {
+			t.Errorf("Expected %q in the report:\n%s", part, report)
+		}
+	}
+
+	if _, err := workspaceHandoffJobs(workspace{Entries: []workspaceEntry{{Name: "bad", Schedule: "61 * * * *"}}}, time.UTC); err == nil {
+		t.Error("Expected an invalid entry to be reported")
+	}
+}
+
+// TestRunTonight verifies the report of a crontab file over a window given
+// by --from and --to.
+func TestRunTonight(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crontab")
+	if err := os.WriteFile(path, []byte("MAILTO=ops\n0 2 * * * backup.sh\n0 9 * * * report.sh\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.json")
+
+	var stdout bytes.Buffer
+
+	args := []string{"--config", missing, "--timezone", "UTC", "--from", "2025-03-10 22:00", "--to", "8h", path}
+	if err := runTonight(args, &stdout, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "| Tue 02:00 | backup.sh | `0 2 * * *` | - | medium risk") ||
+		!strings.Contains(stdout.String(), "Not running: report.sh.") {
+		t.Errorf("Expected the backup in the report, got:\n%s", stdout.String())
+	}
+
+	if err := runTonight([]string{"--from", "tonight", path}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage for an unreadable --from, got %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	watchInterval   = time.Second            // How often the dashboard refreshes
+	watchTimeLayout = "Mon 2006-01-02 15:04" // Layout of the last and next runs
+	watchClockTitle = "15:04:05 MST"         // Layout of the clock in the title
+)
+
+// watchTick refreshes the dashboard
+type watchTick time.Time
+
+// watchDashboard shows the jobs of a crontab with their last and next runs
+// and a countdown to the next, refreshing every second. It only reads.
+type watchDashboard struct {
+	title        string       // Heading naming the crontab
+	jobs         []browserJob // Jobs in crontab order
+	descriptions []string     // Description of each job's schedule
+	now          time.Time    // Time of the last refresh
+}
+
+// newWatchDashboard shows the jobs of a job browser read-only
+func newWatchDashboard(browser *jobBrowser, now time.Time) *watchDashboard {
+	return &watchDashboard{title: browser.title, jobs: browser.jobs, descriptions: browser.descriptions, now: now}
+}
+
+// watchTickCmd schedules the next refresh
+func watchTickCmd() tea.Cmd {
+	return tea.Tick(watchInterval, func(t time.Time) tea.Msg {
+		return watchTick(t)
+	})
+}
+
+// lastAndNextRun returns the latest run at or before now and the first one
+// after it, each zero when there is none
+func lastAndNextRun(job browserJob, now time.Time) (time.Time, time.Time) {
+	schedule, err := standardParser.Parse(job.expr)
+	if err != nil {
+		return time.Time{}, time.Time{}
+	}
+
+	now = now.In(job.location)
+
+	var last time.Time
+	if runs := previousTimes(schedule, now, 1); len(runs) == 1 {
+		last = runs[0]
+	}
+
+	return last, schedule.Next(now)
+}
+
+// formatCountdown formats the time left until a run, e.g. "02:03:04" or
+// "3d 02:03:04"
+func formatCountdown(left time.Duration) string {
+	seconds := int(left.Round(time.Second).Seconds())
+	hours, minutes := seconds/3600, seconds/60%60
+
+	if hours >= hoursPerDay {
+		return fmt.Sprintf("%dd %02d:%02d:%02d", hours/hoursPerDay, hours%hoursPerDay, minutes, seconds%60)
+	}
+
+	return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds%60)
+}
+
+// Init starts the refresh ticks
+func (d *watchDashboard) Init() tea.Cmd {
+	return watchTickCmd()
+}
+
+// Update refreshes on each tick and quits on q, esc, or ctrl+c
+func (d *watchDashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case watchTick:
+		d.now = time.Time(msg)
+
+		return d, watchTickCmd()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return d, tea.Quit
+		}
+	}
+
+	return d, nil
+}
+
+// View renders a row per job, highlighting the jobs that run next
+func (d *watchDashboard) View() string {
+	var builder strings.Builder
+
+	builder.WriteString(titleStyle.Render(fmt.Sprintf("crontab guru: watching %s  %s", d.title,
+		d.now.Format(watchClockTitle))) + "\n")
+
+	rows := [][]string{{"SCHEDULE", "LAST RUN", "NEXT RUN", "IN", "DESCRIPTION", "COMMAND"}}
+	nexts := make([]time.Time, len(d.jobs))
+
+	var soonest time.Time
+
+	for index, job := range d.jobs {
+		last, next := lastAndNextRun(job, d.now)
+		row := []string{job.expr, "-", "-", "-", d.descriptions[index], job.name}
+
+		if !last.IsZero() {
+			row[1] = last.Format(watchTimeLayout)
+		}
+
+		if !next.IsZero() {
+			row[2], row[3] = next.Format(watchTimeLayout), formatCountdown(next.Sub(d.now))
+
+			if soonest.IsZero() || next.Before(soonest) {
+				soonest = next
+			}
+		}
+
+		rows = append(rows, row)
+		nexts[index] = next
+	}
+
+	widths := make([]int, len(rows[0]))
+
+	for _, row := range rows {
+		for column, cell := range row {
+			widths[column] = max(widths[column], len(cell))
+		}
+	}
+
+	for index, row := range rows {
+		cells := make([]string, 0, len(row))
+		for column, cell := range row {
+			cells = append(cells, fmt.Sprintf("%-*s", widths[column], cell))
+		}
+
+		line := strings.TrimRight(strings.Join(cells, "  "), " ")
+
+		switch {
+		case index == 0:
+			builder.WriteString(helpStyle.Render("  "+line) + "\n")
+		case !soonest.IsZero() && nexts[index-1].Equal(soonest):
+			builder.WriteString(focusedLabelStyle.Render("> "+line) + "\n")
+		default:
+			builder.WriteString(labelStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	builder.WriteString("\n" + helpStyle.Render("q: quit"))
+
+	return builder.String()
+}
+
+// runWatch shows a crontab as a dashboard of last and next runs that
+// refreshes every second, reading a file, or stdin for "-", or loading a
+// crontab the way edit does
+func runWatch(args []string, stdout, stderr io.Writer) error {
+	var (
+		target   crontabTarget
+		timezone string
+	)
+
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&target.user, "user", "", "watch this user's crontab through sudo")
+	flags.StringVar(&target.host, "host", "", "watch the crontab on this host through ssh, e.g. deploy@server")
+	flags.StringVar(&timezone, "timezone", "Local", "IANA time zone the crontab runs in")
+
+	paths, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) > 1 || (len(paths) == 1 && target != (crontabTarget{})) {
+		return fmt.Errorf("%w: crontab-guru watch [--user USER] [--host USER@HOST] [CRONTAB]", ErrUsage)
+	}
+
+	if err := target.validate(); err != nil {
+		return err
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("%w: timezone %q", ErrInvalidValue, timezone)
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("%w: crontab-guru watch needs a terminal", ErrUsage)
+	}
+
+	var text string
+
+	name := target.String()
+	if len(paths) == 1 {
+		name = paths[0]
+		text, err = readCrontab(paths[0])
+	} else {
+		text, err = loadCrontab(target, execRunner)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	browser, _, err := newCrontabBrowser(target, text)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	browser.title = name
+
+	if len(browser.jobs) == 0 {
+		fmt.Fprintf(stdout, "no jobs in %s\n", browser.title)
+
+		return nil
+	}
+
+	for index := range browser.jobs {
+		browser.jobs[index].location = location
+	}
+
+	// A crontab piped in on stdin leaves the keys to come from the terminal
+	var options []tea.ProgramOption
+	if !isatty.IsTerminal(os.Stdin.Fd()) {
+		options = append(options, tea.WithInputTTY())
+	}
+
+	if _, err := tea.NewProgram(newWatchDashboard(browser, time.Now()), options...).Run(); err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	return nil
+}
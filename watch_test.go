@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// TestLastAndNextRun verifies the runs around now, including a last run
+// years back and an invalid schedule.
+func TestLastAndNextRun(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 15, 12, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		last time.Time
+		next time.Time
+	}{
+		{"*/5 * * * *", time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC), time.Date(2026, time.October, 15, 12, 5, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, time.October, 15, 2, 0, 0, 0, time.UTC), time.Date(2026, time.October, 16, 2, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"61 * * * *", time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		last, next := lastAndNextRun(browserJob{expr: tt.expr, location: time.UTC}, now)
+		if !last.Equal(tt.last) || !next.Equal(tt.next) {
+			t.Errorf("lastAndNextRun(%q) = %v, %v, expected %v, %v", tt.expr, last, next, tt.last, tt.next)
+		}
+	}
+}
+
+// TestFormatCountdown verifies countdowns under and over a day.
+func TestFormatCountdown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[time.Duration]string{
+		4*time.Minute + 29*time.Second + 600*time.Millisecond: "00:04:30",
+		13*time.Hour + 59*time.Minute + 30*time.Second:        "13:59:30",
+		75*time.Hour + 2*time.Minute + 3*time.Second:          "3d 03:02:03",
+	}
+
+	for left, expected := range tests {
+		if got := formatCountdown(left); got != expected {
+			t.Errorf("formatCountdown(%v) = %q, expected %q", left, got, expected)
+		}
+	}
+}
+
+// TestWatchDashboard verifies that ticks refresh the countdown, that the
+// job due next is highlighted, and that q quits.
+func TestWatchDashboard(t *testing.T) {
+	t.Parallel()
+
+	browser, _, err := newCrontabBrowser(crontabTarget{}, "0 2 * * * backup.sh\n*/5 * * * * poll.sh\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for index := range browser.jobs {
+		browser.jobs[index].location = time.UTC
+	}
+
+	dashboard := newWatchDashboard(browser, time.Date(2026, time.October, 15, 12, 0, 30, 0, time.UTC))
+
+	view := dashboard.View()
+	for _, want := range []string{
+		"crontab guru: watching your crontab  12:00:30 UTC",
+		"0 2 * * *    Thu 2026-10-15 02:00  Fri 2026-10-16 02:00  13:59:30  At 02:00 AM",
+		"> */5 * * * *  Thu 2026-10-15 12:00  Thu 2026-10-15 12:05  00:04:30",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q:\n%s", want, view)
+		}
+	}
+
+	if _, cmd := dashboard.Update(watchTick(time.Date(2026, time.October, 15, 12, 0, 31, 0, time.UTC))); cmd == nil {
+		t.Error("Expected a tick to schedule the next one")
+	}
+
+	if !strings.Contains(dashboard.View(), "00:04:29") {
+		t.Errorf("Expected the countdown to move on a tick:\n%s", dashboard.View())
+	}
+
+	if _, cmd := dashboard.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd == nil {
+		t.Error("Expected q to quit")
+	}
+}
+
+// TestRunWatchUsage verifies that a file cannot be combined with --user or
+// --host and that unsafe names are rejected.
+func TestRunWatchUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{
+		{"--user", "www-data", "crontab.txt"},
+		{"a.txt", "b.txt"},
+		{"--host", "-oProxyCommand=x"},
+	} {
+		if err := runWatch(args, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+			t.Errorf("runWatch(%q) = %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cronparser "github.com/robfig/cron/v3"
+)
+
+// recurringWindow is a schedule whose runs each open a window that stays
+// open for a duration, such as a maintenance window from 02:00 for 2h
+type recurringWindow struct {
+	spec     cronSpec            // Schedule the windows open on
+	schedule cronparser.Schedule // Parsed schedule, without the year field
+	duration time.Duration       // How long each window stays open
+}
+
+// inYear reports whether a run falls in a year the spec's year field
+// selects, always true for dialects without one
+func (w recurringWindow) inYear(run time.Time) bool {
+	if w.spec.year == "" {
+		return true
+	}
+
+	matched, err := yearMatches(w.spec.year, run.Year())
+
+	return err == nil && matched
+}
+
+// openAt returns when the window holding at opened, and whether at is in
+// one. Windows longer than the time between runs overlap, so the latest
+// run is the one that decides.
+func (w recurringWindow) openAt(at time.Time) (time.Time, bool) {
+	runs := previousTimes(w.schedule, at, 1)
+	if len(runs) == 0 || !w.inYear(runs[0]) || !at.Before(runs[0].Add(w.duration)) {
+		return time.Time{}, false
+	}
+
+	return runs[0], true
+}
+
+// nextOpening returns when the next window after at opens, zero when none
+// does within maxNextRuns runs
+func (w recurringWindow) nextOpening(at time.Time) time.Time {
+	for _, run := range nextTimes(w.schedule, at, time.Time{}, maxNextRuns) {
+		if w.inYear(run) {
+			return run
+		}
+	}
+
+	return time.Time{}
+}
+
+// runWindow reads an expression and a duration as a recurring window, and
+// exits successfully when a time, now unless --at is given, falls inside
+// one, and with ErrNoMatch otherwise. Either way it says when the window
+// closes or next opens.
+func runWindow(args []string, stdout, stderr io.Writer) error {
+	var (
+		from, seed, timezone, at string
+		duration                 time.Duration
+	)
+
+	flags := flag.NewFlagSet("window", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.DurationVar(&duration, "duration", 0, "how long each window stays open, e.g. 2h")
+	flags.StringVar(&at, "at", "", "time to check, e.g. 2025-06-01T03:00:00Z, now by default")
+	flags.StringVar(&from, "dialect", string(dialectStandard), "dialect of the expression")
+	flags.StringVar(&seed, "seed", "", "Jenkins job name used to resolve H tokens")
+	flags.StringVar(&timezone, "timezone", "", "IANA time zone the schedule is read in, local by default")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 || duration <= 0 {
+		return fmt.Errorf("%w: crontab-guru window --duration DURATION [--at TIME] [--timezone ZONE] EXPRESSION", ErrUsage)
+	}
+
+	query, err := readServeQuery(strings.Join(positional, " "), from, seed, timezone, time.Local)
+	if err != nil {
+		return err
+	}
+
+	instant := time.Now().In(query.location)
+	if at != "" {
+		var ok bool
+		if instant, ok = parseLayoutTime(at, query.location); !ok {
+			return fmt.Errorf("%w: --at %q is not a time like 2025-06-01T03:00:00Z", ErrUsage, at)
+		}
+
+		instant = instant.In(query.location)
+	}
+
+	schedule, err := specSchedule(query.spec)
+	if err != nil {
+		return err
+	}
+
+	window := recurringWindow{spec: query.spec, schedule: schedule, duration: duration}
+
+	if opened, ok := window.openAt(instant); ok {
+		closes := opened.Add(duration)
+		fmt.Fprintf(stdout, "inside the window from %s to %s, closing %s\n",
+			opened.Format(time.RFC3339), closes.Format(time.RFC3339), formatRelative(closes.Sub(instant)))
+
+		return nil
+	}
+
+	if next := window.nextOpening(instant); next.IsZero() {
+		fmt.Fprintln(stdout, "outside the window, which does not open again")
+	} else {
+		fmt.Fprintf(stdout, "outside the window, which next opens at %s, %s\n",
+			next.Format(time.RFC3339), formatRelative(next.Sub(instant)))
+	}
+
+	return ErrNoMatch
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestRunWindow verifies that a time inside a window opened by a run
+// matches, up to but not including its close, that overlapping windows
+// count, and that a time outside one is ErrNoMatch.
+func TestRunWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected error
+	}{
+		{[]string{"--duration", "2h", "--at", "2025-06-01T02:00:00Z", "--timezone", "UTC", "0 2 * * *"}, nil},
+		{[]string{"--duration", "2h", "--at", "2025-06-01T03:59:59Z", "--timezone", "UTC", "0 2 * * *"}, nil},
+		{[]string{"--duration", "2h", "--at", "2025-06-01T04:00:00Z", "--timezone", "UTC", "0 2 * * *"}, ErrNoMatch},
+		{[]string{"--duration", "2h", "--at", "2025-06-01T01:59:00Z", "--timezone", "UTC", "0 2 * * *"}, ErrNoMatch},
+		{[]string{"--duration", "3h", "--at", "2025-06-01 23:30", "--timezone", "Europe/Lisbon", "0 22 * * *"}, nil},
+		{[]string{"--duration", "90m", "--at", "2025-06-01T03:20:00Z", "--timezone", "UTC", "0 * * * *"}, nil},
+		{[]string{"--duration", "1h", "--at", "2026-06-01T12:30:00Z", "--timezone", "UTC", "--dialect", "quartz", "0 0 12 * * ? 2025"}, ErrNoMatch},
+		{[]string{"--at", "2025-06-01T02:00:00Z", "0 2 * * *"}, ErrUsage},
+		{[]string{"--duration", "2h", "--at", "soon", "0 2 * * *"}, ErrUsage},
+		{[]string{"--duration", "2h", "61 * * * *"}, ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		if err := runWindow(test.args, io.Discard, io.Discard); !errors.Is(err, test.expected) {
+			t.Errorf("runWindow(%q) = %v, expected %v", test.args, err, test.expected)
+		}
+	}
+}
+
+// TestRunWindowMessages verifies that window says when the window closes, or
+// when the next one opens.
+func TestRunWindowMessages(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	_ = runWindow([]string{"--duration", "2h", "--at", "2025-06-01T03:00:00Z", "--timezone", "UTC", "0 2 * * *"}, &stdout, io.Discard)
+	_ = runWindow([]string{"--duration", "2h", "--at", "2025-06-01T05:00:00Z", "--timezone", "UTC", "0 2 * * *"}, &stdout, io.Discard)
+
+	expected := "inside the window from 2025-06-01T02:00:00Z to 2025-06-01T04:00:00Z, closing in 1h\n" +
+		"outside the window, which next opens at 2025-06-02T02:00:00Z, in 21h\n"
+	if stdout.String() != expected {
+		t.Errorf("Unexpected output %q, expected %q", stdout.String(), expected)
+	}
+}
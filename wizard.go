@@ -0,0 +1,342 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	wizardInputWidth = 12 // Width of the input typed answers go in
+
+	wizardMinute  = "minute"  // Which minute past the hour
+	wizardEvery   = "every"   // Every how many minutes
+	wizardTime    = "time"    // Time of day as HH:MM
+	wizardWeekday = "weekday" // Days of the week
+	wizardDay     = "day"     // Day of the month
+	wizardMonth   = "month"   // Month of the year
+)
+
+// wizardChoice is an answer picked from a list, with the value it gives
+// the field the question is about
+type wizardChoice struct {
+	label string // Answer as shown
+	value string // Field value, e.g. "MON-FRI"
+}
+
+// wizardFrequency is an answer to "How often?" and the questions it leads to
+type wizardFrequency struct {
+	label     string   // Answer as shown
+	questions []string // Questions asked next, see wizardQuestions
+}
+
+//nolint:gochecknoglobals
+var (
+	// Answers to the first question; the last goes straight to the fields
+	wizardFrequencies = []wizardFrequency{
+		{label: "Every few minutes", questions: []string{wizardEvery}},
+		{label: "Every hour", questions: []string{wizardMinute}},
+		{label: "Every day", questions: []string{wizardTime}},
+		{label: "Every week", questions: []string{wizardWeekday, wizardTime}},
+		{label: "Every month", questions: []string{wizardDay, wizardTime}},
+		{label: "Every year", questions: []string{wizardMonth, wizardDay, wizardTime}},
+		{label: "Something else: go straight to the fields"},
+	}
+
+	// Prompts of the questions after "How often?"
+	wizardQuestions = map[string]string{
+		wizardEvery:   "Every how many minutes? (1-59)",
+		wizardMinute:  "At how many minutes past the hour? (0-59)",
+		wizardTime:    "At what time? (HH:MM, 24-hour clock, e.g. 14:30)",
+		wizardWeekday: "On which days?",
+		wizardDay:     "On which day of the month? (1-31)",
+		wizardMonth:   "In which month?",
+	}
+
+	// Fields set by the questions answered from a list
+	wizardChoiceFields = map[string]int{wizardWeekday: fieldIndexWeekday, wizardMonth: fieldIndexMonth}
+
+	// Answers to the questions picked from a list rather than typed
+	wizardChoices = map[string][]wizardChoice{
+		wizardWeekday: {
+			{"Monday to Friday", "MON-FRI"},
+			{"Saturday and Sunday", "SAT,SUN"},
+			{"Monday", "MON"},
+			{"Tuesday", "TUE"},
+			{"Wednesday", "WED"},
+			{"Thursday", "THU"},
+			{"Friday", "FRI"},
+			{"Saturday", "SAT"},
+			{"Sunday", "SUN"},
+		},
+		wizardMonth: {
+			{"January", "JAN"},
+			{"February", "FEB"},
+			{"March", "MAR"},
+			{"April", "APR"},
+			{"May", "MAY"},
+			{"June", "JUN"},
+			{"July", "JUL"},
+			{"August", "AUG"},
+			{"September", "SEP"},
+			{"October", "OCT"},
+			{"November", "NOV"},
+			{"December", "DEC"},
+		},
+	}
+)
+
+// wizard asks how often and when a job should run, one question at a time,
+// building the expression from the answers
+type wizard struct {
+	questions []string        // Questions left, the current one first; nil while asking how often
+	fields    []string        // Expression built so far, nil when the fields are to be kept as they are
+	cursor    int             // Index of the highlighted answer in a list
+	input     textinput.Model // Input typed answers go in
+	problem   string          // Why the last typed answer was not taken
+}
+
+// openWizard starts the wizard from "How often?"
+func (m *model) openWizard() {
+	input := textinput.New()
+	input.Width = wizardInputWidth
+	input.CharLimit = 0 // Too long answers are reported by answer instead of cut off
+
+	m.wizard = &wizard{fields: []string{"0", "0", "*", "*", "*"}, input: input}
+}
+
+// choices lists the answers of the current question, nil when it is typed
+func (w *wizard) choices() []string {
+	var labels []string
+
+	if w.questions == nil {
+		for _, frequency := range wizardFrequencies {
+			labels = append(labels, frequency.label)
+		}
+
+		return labels
+	}
+
+	for _, choice := range wizardChoices[w.questions[0]] {
+		labels = append(labels, choice.label)
+	}
+
+	return labels
+}
+
+// prompt is the current question
+func (w *wizard) prompt() string {
+	if w.questions == nil {
+		return "How often should the job run?"
+	}
+
+	return wizardQuestions[w.questions[0]]
+}
+
+// parseWizardNumber reads a typed whole number between low and high
+func parseWizardNumber(answer string, low, high int) (int, error) {
+	number, err := strconv.Atoi(strings.TrimSpace(answer))
+	if err != nil || number < low || number > high {
+		return 0, fmt.Errorf("%w: enter a number from %d to %d", ErrInvalidValue, low, high)
+	}
+
+	return number, nil
+}
+
+// answer applies a typed answer to the fields it sets
+func (w *wizard) answer(question, answer string) error {
+	switch question {
+	case wizardEvery:
+		every, err := parseWizardNumber(answer, 1, fieldRanges[fieldIndexMinute].max)
+		if err != nil {
+			return err
+		}
+
+		w.fields[fieldIndexMinute], w.fields[fieldIndexHour] = "*/"+strconv.Itoa(every), "*"
+		if every == 1 {
+			w.fields[fieldIndexMinute] = "*"
+		}
+	case wizardMinute:
+		minute, err := parseWizardNumber(answer, 0, fieldRanges[fieldIndexMinute].max)
+		if err != nil {
+			return err
+		}
+
+		w.fields[fieldIndexMinute], w.fields[fieldIndexHour] = strconv.Itoa(minute), "*"
+	case wizardDay:
+		day, err := parseWizardNumber(answer, 1, fieldRanges[fieldIndexDay].max)
+		if err != nil {
+			return err
+		}
+
+		w.fields[fieldIndexDay] = strconv.Itoa(day)
+	case wizardTime:
+		at, err := time.Parse("15:04", strings.TrimSpace(answer))
+		if err != nil {
+			return fmt.Errorf("%w: enter a time like 09:00 or 14:30", ErrInvalidValue)
+		}
+
+		w.fields[fieldIndexMinute], w.fields[fieldIndexHour] = strconv.Itoa(at.Minute()), strconv.Itoa(at.Hour())
+	}
+
+	return nil
+}
+
+// confirm takes the highlighted or typed answer and moves to the next
+// question, reporting whether the wizard is done
+func (w *wizard) confirm() bool {
+	if w.questions == nil {
+		w.questions = wizardFrequencies[w.cursor].questions
+		w.cursor = 0
+
+		if len(w.questions) == 0 {
+			w.fields = nil
+
+			return true
+		}
+
+		return false
+	}
+
+	question := w.questions[0]
+
+	if choices := wizardChoices[question]; choices != nil {
+		w.fields[wizardChoiceFields[question]] = choices[w.cursor].value
+	} else if err := w.answer(question, w.input.Value()); err != nil {
+		w.problem = err.Error()
+
+		return false
+	}
+
+	w.questions = w.questions[1:]
+	w.cursor = 0
+	w.problem = ""
+	w.input.SetValue("")
+
+	return len(w.questions) == 0
+}
+
+// handleWizardKey answers the wizard's questions; esc leaves it for the
+// fields as they are
+func (m *model) handleWizardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	w := m.wizard
+	choices := w.choices()
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.wizard = nil
+
+		return m, nil
+	case "up":
+		if choices != nil {
+			w.cursor = max(0, w.cursor-1)
+		}
+
+		return m, nil
+	case "down":
+		if choices != nil {
+			w.cursor = min(len(choices)-1, w.cursor+1)
+		}
+
+		return m, nil
+	case "enter":
+		if !w.confirm() {
+			if w.choices() == nil {
+				return m, w.input.Focus()
+			}
+
+			return m, nil
+		}
+
+		m.wizard = nil
+		if w.fields != nil {
+			m.setExpression(strings.Join(w.fields, " "))
+		}
+
+		return m, m.setFocus(0)
+	}
+
+	if choices != nil {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	w.input, cmd = w.input.Update(msg)
+
+	return m, cmd
+}
+
+// wizardLines shows the current question with its answers or input, and the
+// expression built so far with its description
+func (m *model) wizardLines() []string {
+	w := m.wizard
+	lines := []string{w.prompt(), ""}
+
+	if choices := w.choices(); choices != nil {
+		for index, choice := range choices {
+			marker := "  "
+			if index == w.cursor {
+				marker = "> "
+			}
+
+			lines = append(lines, marker+choice)
+		}
+	} else {
+		lines = append(lines, w.input.View())
+	}
+
+	if w.problem != "" {
+		lines = append(lines, "", w.problem)
+	}
+
+	if w.questions != nil {
+		expr := strings.Join(w.fields, " ")
+		if description, err := describeExpression(expr); err == nil {
+			expr += "  " + description
+		}
+
+		lines = append(lines, "", "so far: "+expr)
+	}
+
+	return lines
+}
+
+// renderWizard renders the wizard in place of the fields
+func (m *model) renderWizard() string {
+	lines := m.wizardLines()
+
+	var builder strings.Builder
+
+	for index, line := range lines {
+		style := previewStyle
+
+		switch {
+		case index == 0:
+			style = focusedLabelStyle
+		case strings.HasPrefix(line, "> "):
+			style = focusedLabelStyle
+		case line == m.wizard.problem:
+			style = conflictStyle
+		case strings.HasPrefix(line, "so far: "):
+			style = infoStyle
+		}
+
+		builder.WriteString(m.place(style.Render(line)) + "\n")
+	}
+
+	help := "up/down: choose · enter: next · esc: skip to the fields"
+	builder.WriteString(m.place(helpStyle.Render(help)) + "\n")
+
+	return builder.String()
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// answerWizard picks the answer index places down the list, or types it,
+// and confirms it.
+func answerWizard(m *model, down int, typed string) {
+	for range down {
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	if typed != "" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(typed)})
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+}
+
+// TestWizard verifies that each frequency asks its questions and builds
+// the expression from the answers, then hands over to the fields.
+func TestWizard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		answers  func(m *model)
+		expected string
+	}{
+		{"every few minutes", func(m *model) { answerWizard(m, 0, ""); answerWizard(m, 0, "15") }, "*/15 * * * *"},
+		{"every hour", func(m *model) { answerWizard(m, 1, ""); answerWizard(m, 0, "5") }, "5 * * * *"},
+		{"every weekday", func(m *model) { answerWizard(m, 3, ""); answerWizard(m, 0, ""); answerWizard(m, 0, "9:30") }, "30 9 * * MON-FRI"},
+		{"every year", func(m *model) {
+			answerWizard(m, 5, "")
+			answerWizard(m, 11, "")
+			answerWizard(m, 0, "25")
+			answerWizard(m, 0, "07:00")
+		}, "0 7 25 DEC *"},
+	}
+
+	for _, test := range tests {
+		m := initialModel()
+		m.applyStartup(options{mode: modeWizard})
+
+		if !strings.Contains(m.View(), "How often should the job run?") {
+			t.Fatalf("Expected the wizard at startup, got:\n%s", m.View())
+		}
+
+		test.answers(m)
+
+		if m.wizard != nil || m.buildCronExpression() != test.expected {
+			t.Errorf("%s: expected %q in the fields, got %q", test.name, test.expected, m.buildCronExpression())
+		}
+	}
+}
+
+// TestWizardLongAnswer verifies that an answer longer than the input is
+// kept whole and reported, rather than cut off and taken.
+func TestWizardLongAnswer(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.openWizard()
+	answerWizard(m, 1, "")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("0,10,20,30,40,50")})
+
+	if value := m.wizard.input.Value(); value != "0,10,20,30,40,50" {
+		t.Fatalf("Expected the whole answer in the input, got %q", value)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.wizard == nil || m.wizard.problem == "" {
+		t.Errorf("Expected the answer reported and asked again, got %q", m.buildCronExpression())
+	}
+}
+
+// TestWizardProblems verifies that answers out of range are explained and
+// asked again, and that the fields are left alone when skipped.
+func TestWizardProblems(t *testing.T) {
+	t.Parallel()
+
+	m := initialModel()
+	m.setExpression("1 2 3 4 5")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g"), Alt: true})
+
+	answerWizard(m, 2, "")
+	answerWizard(m, 0, "25:00")
+
+	if m.wizard == nil || !strings.Contains(m.View(), "enter a time like 09:00 or 14:30") {
+		t.Fatalf("Expected the time asked again, got:\n%s", m.View())
+	}
+
+	m.plain = true
+	if view := m.View(); !strings.Contains(view, "At what time?") || !strings.Contains(view, "so far: 0 0 * * *") {
+		t.Errorf("Expected the question and expression so far in the plain view, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.wizard != nil || m.buildCronExpression() != "1 2 3 4 5" {
+		t.Errorf("Expected esc to leave the fields alone, got %q", m.buildCronExpression())
+	}
+
+	m.openWizard()
+	answerWizard(m, len(wizardFrequencies)-1, "")
+
+	if m.wizard != nil || m.buildCronExpression() != "1 2 3 4 5" {
+		t.Errorf("Expected the last answer to go straight to the fields, got %q", m.buildCronExpression())
+	}
+}
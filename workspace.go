@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	workspaceFileName = "workspace.json" // Default workspace file inside the config directory
+)
+
+// ErrInvalidWorkspace is returned when a workspace file or entry is invalid
+var ErrInvalidWorkspace = errors.New("invalid workspace") //nolint:gochecknoglobals
+
+// workspaceEntry is one named job tracked in a workspace
+type workspaceEntry struct {
+	Name     string `json:"name"`               // Unique name of the job
+	Schedule string `json:"schedule"`           // Standard cron expression or macro
+	Command  string `json:"command,omitempty"`  // Command the job runs
+	Timezone string `json:"timezone,omitempty"` // IANA time zone the schedule is read in, "" for local time
+	Owner    string `json:"owner,omitempty"`    // Person or team responsible for the job
+	Group    string `json:"group,omitempty"`    // Heading the job is listed under, "" for none
+}
+
+// workspace is a set of jobs saved together in one file
+type workspace struct {
+	Entries []workspaceEntry `json:"entries"` // Jobs in display order
+}
+
+// defaultWorkspacePath returns the workspace file under the user config directory
+func defaultWorkspacePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return workspaceFileName
+	}
+
+	return filepath.Join(dir, configDirName, workspaceFileName)
+}
+
+// loadWorkspace reads a workspace file. A missing file is an empty workspace.
+func loadWorkspace(path string) (workspace, error) {
+	var ws workspace
+
+	data, err := os.ReadFile(path) //nolint:gosec // The path is the user's own workspace file
+	if errors.Is(err, fs.ErrNotExist) {
+		return ws, nil
+	}
+
+	if err != nil {
+		return ws, fmt.Errorf("%w: %w", ErrInvalidWorkspace, err)
+	}
+
+	if err := json.Unmarshal(data, &ws); err != nil {
+		return ws, fmt.Errorf("%w: %s: %w", ErrInvalidWorkspace, path, err)
+	}
+
+	return ws, nil
+}
+
+// saveWorkspace writes a workspace file, creating its directory if needed
+func saveWorkspace(path string, ws workspace) error {
+	data, err := json.MarshalIndent(ws, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWorkspace, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to save workspace: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to save workspace: %w", err)
+	}
+
+	return nil
+}
+
+// find returns the index of the entry with the given name, or -1
+func (ws *workspace) find(name string) int {
+	for index, entry := range ws.Entries {
+		if strings.EqualFold(entry.Name, name) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// validateEntry checks an entry's name, schedule, and time zone
+func validateEntry(entry workspaceEntry) error {
+	if strings.TrimSpace(entry.Name) == "" {
+		return fmt.Errorf("%w: missing name", ErrInvalidWorkspace)
+	}
+
+	if strings.TrimSpace(entry.Schedule) == "" {
+		return fmt.Errorf("%w: missing schedule", ErrInvalidWorkspace)
+	}
+
+	fields, err := splitRawExpression(entry.Schedule)
+	if err != nil {
+		return err
+	}
+
+	if err := validateStandardFields(fields); err != nil {
+		return err
+	}
+
+	if entry.Timezone != "" {
+		if _, err := time.LoadLocation(entry.Timezone); err != nil {
+			return fmt.Errorf("%w: timezone %q", ErrInvalidWorkspace, entry.Timezone)
+		}
+	}
+
+	return nil
+}
+
+// writeWorkspaceCrontab writes the entries as crontab lines in display order,
+// starting each group with its heading as a section comment and naming each
+// job in a comment above it. Entries cron cannot run are left as comments.
+func writeWorkspaceCrontab(writer io.Writer, ws workspace) error {
+	var builder strings.Builder
+
+	for index, entry := range ws.Entries {
+		if index == 0 || entry.Group != ws.Entries[index-1].Group {
+			if index > 0 {
+				builder.WriteString("\n")
+			}
+
+			if entry.Group != "" {
+				fmt.Fprintf(&builder, "# %s\n", entry.Group)
+			}
+		}
+
+		name := entry.Name
+		if entry.Timezone != "" {
+			name += ", in " + entry.Timezone
+		}
+
+		fmt.Fprintf(&builder, "# %s\n", name)
+
+		err := validateEntry(entry)
+		fields, _ := splitRawExpression(entry.Schedule) // Checked by validateEntry
+
+		switch {
+		case err != nil:
+			fmt.Fprintf(&builder, "# skipped: %v\n", err)
+		case entry.Command == "":
+			fmt.Fprintf(&builder, "# %s (no command)\n", strings.Join(fields, " "))
+		default:
+			fmt.Fprintf(&builder, "%s %s\n", strings.Join(fields, " "), entry.Command)
+		}
+	}
+
+	if _, err := io.WriteString(writer, builder.String()); err != nil {
+		return fmt.Errorf("failed to write crontab: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	daysPerWeek       = 7                      // Days counted for the runs_per_week column
+	nextRunTimeFormat = "2006-01-02 15:04 MST" // Format of the next_run column
+	defaultCSVColumns = "name,schedule,description,runs_per_week,next_run,owner,warnings"
+)
+
+// entryDetails is everything known about a workspace entry at a point in time
+type entryDetails struct {
+	entry       workspaceEntry // The entry itself
+	description string         // Human-readable schedule
+	runsPerWeek int            // Runs in the week after the reference time
+	nextRun     time.Time      // Next run in the entry's time zone
+	warnings    []string       // Problems and risk reasons worth flagging
+}
+
+// csvColumnNames lists every column in the order help shows them
+//
+//nolint:gochecknoglobals
+var csvColumnNames = []string{
+	"name", "schedule", "command", "timezone", "owner", "group", "description", "runs_per_week", "next_run", "warnings",
+}
+
+// csvColumns maps column names to the value each writes for an entry
+//
+//nolint:gochecknoglobals
+var csvColumns = map[string]func(entryDetails) string{
+	"name":          func(d entryDetails) string { return d.entry.Name },
+	"schedule":      func(d entryDetails) string { return d.entry.Schedule },
+	"command":       func(d entryDetails) string { return d.entry.Command },
+	"timezone":      func(d entryDetails) string { return d.entry.Timezone },
+	"owner":         func(d entryDetails) string { return d.entry.Owner },
+	"group":         func(d entryDetails) string { return d.entry.Group },
+	"description":   func(d entryDetails) string { return d.description },
+	"runs_per_week": func(d entryDetails) string { return strconv.Itoa(d.runsPerWeek) },
+	"next_run": func(d entryDetails) string {
+		if d.nextRun.IsZero() {
+			return ""
+		}
+
+		return d.nextRun.Format(nextRunTimeFormat)
+	},
+	"warnings": func(d entryDetails) string { return strings.Join(d.warnings, "; ") },
+}
+
+// describeEntry computes the description, frequency, next run, and warnings
+// of an entry. Invalid entries get a warning instead of an error so one bad
+// entry does not hide the rest.
+func describeEntry(entry workspaceEntry, rules []riskRule, now time.Time) entryDetails {
+	details := entryDetails{entry: entry}
+
+	if err := validateEntry(entry); err != nil {
+		details.warnings = append(details.warnings, err.Error())
+
+		return details
+	}
+
+	fields, _ := splitRawExpression(entry.Schedule) // Validated above
+	expr := strings.Join(fields, " ")
+
+	location := time.Local
+	if entry.Timezone != "" {
+		location, _ = time.LoadLocation(entry.Timezone) // Validated above
+	}
+
+	schedule, err := standardParser.Parse(expr)
+	if err != nil {
+		details.warnings = append(details.warnings, err.Error())
+
+		return details
+	}
+
+	if description, err := describeExpression(expr); err == nil {
+		details.description = description
+	}
+
+	start := now.In(location)
+	details.nextRun = schedule.Next(start)
+
+	details.runsPerWeek = newRunCounter(cronSpec{fields: fields}, schedule).count(start, start.AddDate(0, 0, daysPerWeek))
+
+	if assessment := assessRisk(expr, rules); assessment.level > riskLow {
+		details.warnings = append(details.warnings, assessment.label())
+	}
+
+	return details
+}
+
+// parseCSVColumns splits a comma-separated column list, rejecting unknown names
+func parseCSVColumns(list string) ([]string, error) {
+	var columns []string
+
+	for column := range strings.SplitSeq(list, ",") {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if _, ok := csvColumns[column]; !ok {
+			return nil, fmt.Errorf("%w: unknown column %q (expected any of %s)", ErrUsage, column, strings.Join(csvColumnNames, ","))
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// writeWorkspaceCSV writes a header row and one row per entry
+func writeWorkspaceCSV(writer io.Writer, ws workspace, columns []string, rules []riskRule, now time.Time) error {
+	records := csv.NewWriter(writer)
+
+	if err := records.Write(columns); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, entry := range ws.Entries {
+		details := describeEntry(entry, rules, now)
+		record := make([]string, 0, len(columns))
+
+		for _, column := range columns {
+			record = append(record, csvColumns[column](details))
+		}
+
+		if err := records.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	records.Flush()
+
+	if err := records.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return nil
+}
+
+// runExportWorkspace writes the workspace entries as CSV for spreadsheets, or
+// as a crontab with a section comment per group
+func runExportWorkspace(args []string, stdout, stderr io.Writer) error {
+	var path, format, columnList, configPath string
+
+	flags := flag.NewFlagSet("export-workspace", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&path, "workspace", defaultWorkspacePath(), "workspace file to export")
+	flags.StringVar(&format, "format", "csv", "output format: csv or crontab")
+	flags.StringVar(&columnList, "columns", defaultCSVColumns, "comma-separated columns to write")
+	flags.StringVar(&configPath, "config", defaultConfigPath(), "config file with risk rules")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 0 || (format != "csv" && format != "crontab") {
+		return fmt.Errorf("%w: crontab-guru export-workspace [--workspace FILE] [--format csv|crontab] [--columns LIST]", ErrUsage)
+	}
+
+	columns, err := parseCSVColumns(columnList)
+	if err != nil {
+		return err
+	}
+
+	if format == "crontab" {
+		ws, err := loadWorkspace(path)
+		if err != nil {
+			return err
+		}
+
+		return writeWorkspaceCrontab(stdout, ws)
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	rules := cfg.RiskRules
+	if rules == nil {
+		rules = defaultRiskRules
+	}
+
+	ws, err := loadWorkspace(path)
+	if err != nil {
+		return err
+	}
+
+	return writeWorkspaceCSV(stdout, ws, columns, rules, time.Now())
+}
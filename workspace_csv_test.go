@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestWriteWorkspaceCSV verifies the values written for each column,
+// including next runs in the entry's time zone and warnings.
+func TestWriteWorkspaceCSV(t *testing.T) {
+	t.Parallel()
+
+	ws := workspace{Entries: []workspaceEntry{
+		{Name: "backup", Schedule: "30 2 * * *", Timezone: "Europe/Lisbon", Owner: "ops"},
+		{Name: "ping", Schedule: "* * * * *", Owner: "noc"},
+		{Name: "broken", Schedule: "61 * * * *"},
+	}}
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	columns := strings.Split(defaultCSVColumns, ",")
+
+	var buffer bytes.Buffer
+
+	if err := writeWorkspaceCSV(&buffer, ws, columns, defaultRiskRules, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected valid CSV, got %v", err)
+	}
+
+	expected := [][]string{
+		columns,
+		{"backup", "30 2 * * *", "At 02:30 AM", "7", "2025-01-02 02:30 WET", "ops", "medium risk: runs overnight"},
+		{"ping", "* * * * *", "Every minute", "10080", "", "noc", "high risk: runs every minute"},
+		{"broken", "61 * * * *", "", "0", "", "", `invalid value in field: minute "61"`},
+	}
+
+	for row := range expected {
+		for column := range expected[row] {
+			// The next run of an entry without a time zone depends on the local zone
+			if row == 2 && column == 4 {
+				continue
+			}
+
+			if records[row][column] != expected[row][column] {
+				t.Errorf("Row %d column %s = %q, expected %q", row, columns[column], records[row][column], expected[row][column])
+			}
+		}
+	}
+}
+
+// TestRunExportWorkspaceColumns verifies column selection and rejection of unknown columns.
+func TestRunExportWorkspaceColumns(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	wsPath := filepath.Join(dir, workspaceFileName)
+	configPath := filepath.Join(dir, "missing.json")
+
+	ws := workspace{Entries: []workspaceEntry{{Name: "backup", Schedule: "@daily", Owner: "ops"}}}
+	if err := saveWorkspace(wsPath, ws); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	args := []string{"export-workspace", "--workspace", wsPath, "--config", configPath, "--columns", "owner, NAME"}
+	if err := runCommand(args, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if stdout.String() != "owner,name\nops,backup\n" {
+		t.Errorf("Unexpected CSV %q", stdout.String())
+	}
+
+	args = []string{"export-workspace", "--workspace", wsPath, "--config", configPath, "--columns", "name,color"}
+	if err := runCommand(args, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage for an unknown column, got %v", err)
+	}
+
+	stdout.Reset()
+
+	args = []string{"export-workspace", "--workspace", wsPath, "--format", "crontab"}
+	if err := runCommand(args, &stdout, &stderr); err != nil || stdout.String() != "# backup\n# 0 0 * * * (no command)\n" {
+		t.Errorf("Unexpected crontab %q, %v", stdout.String(), err)
+	}
+
+	args = []string{"export-workspace", "--workspace", wsPath, "--format", "yaml"}
+	if err := runCommand(args, &stdout, &stderr); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage for an unknown format, got %v", err)
+	}
+}
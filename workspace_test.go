@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// TestWorkspaceRoundTrip verifies saving and loading a workspace, and that a
+// missing file is an empty workspace.
+func TestWorkspaceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", workspaceFileName)
+
+	ws, err := loadWorkspace(path)
+	if err != nil || len(ws.Entries) != 0 {
+		t.Fatalf("Expected an empty workspace, got %+v, %v", ws, err)
+	}
+
+	ws.Entries = append(ws.Entries, workspaceEntry{Name: "backup", Schedule: "30 2 * * *", Owner: "ops"})
+
+	if err := saveWorkspace(path, ws); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	loaded, err := loadWorkspace(path)
+	if err != nil || len(loaded.Entries) != 1 || loaded.Entries[0] != ws.Entries[0] {
+		t.Errorf("Expected the entry to round-trip, got %+v, %v", loaded, err)
+	}
+
+	if loaded.find("BACKUP") != 0 || loaded.find("report") != -1 {
+		t.Error("Expected names to be found case-insensitively")
+	}
+
+	if err := os.WriteFile(path, []byte("["), 0o600); err != nil {
+		t.Fatalf("Failed to write workspace: %v", err)
+	}
+
+	if _, err := loadWorkspace(path); !errors.Is(err, ErrInvalidWorkspace) {
+		t.Errorf("Expected ErrInvalidWorkspace for a corrupt file, got %v", err)
+	}
+}
+
+// TestValidateEntry verifies the checks on names, schedules, and time zones.
+func TestValidateEntry(t *testing.T) {
+	t.Parallel()
+
+	valid := workspaceEntry{Name: "backup", Schedule: "@daily", Timezone: "Europe/Lisbon"}
+	if err := validateEntry(valid); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		entry    workspaceEntry
+		expected error
+	}{
+		{workspaceEntry{Schedule: "* * * * *"}, ErrInvalidWorkspace},
+		{workspaceEntry{Name: "x"}, ErrInvalidWorkspace},
+		{workspaceEntry{Name: "x", Schedule: "* * *"}, ErrFieldCount},
+		{workspaceEntry{Name: "x", Schedule: "0 24 * * *"}, ErrInvalidValue},
+		{workspaceEntry{Name: "x", Schedule: "* * * * *", Timezone: "Nowhere/Town"}, ErrInvalidWorkspace},
+	}
+
+	for _, tt := range tests {
+		if err := validateEntry(tt.entry); !errors.Is(err, tt.expected) {
+			t.Errorf("validateEntry(%+v) expected %v, got %v", tt.entry, tt.expected, err)
+		}
+	}
+}
+
+// TestWriteWorkspaceCrontab verifies that groups start with a section
+// comment, that entries are named in comments, and that entries cron cannot
+// run are left as comments.
+func TestWriteWorkspaceCrontab(t *testing.T) {
+	t.Parallel()
+
+	ws := workspace{Entries: []workspaceEntry{
+		{Name: "backup-db", Schedule: "@daily", Command: "backup-db.sh", Group: "Backups"},
+		{Name: "backup-files", Schedule: "30 2 * * *", Command: "backup-files.sh", Timezone: "Europe/Lisbon", Group: "Backups"},
+		{Name: "report", Schedule: "0 9 * * 1", Group: "Reports"},
+		{Name: "broken", Schedule: "61 * * * *", Command: "broken.sh"},
+	}}
+
+	var buffer bytes.Buffer
+
+	if err := writeWorkspaceCrontab(&buffer, ws); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `# Backups
+# backup-db
+0 0 * * * backup-db.sh
+# backup-files, in Europe/Lisbon
+30 2 * * * backup-files.sh
+
+# Reports
+# report
+# 0 9 * * 1 (no command)
+
+# broken
+# skipped: invalid value in field: minute "61"
+`
+	if buffer.String() != expected {
+		t.Errorf("Unexpected crontab:\n%s\nexpected:\n%s", buffer.String(), expected)
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	workspaceInputWidth = 40 // Width of the rename and heading inputs
+
+	renamingEntry  = "name"    // The input renames the selected entry
+	settingHeading = "heading" // The input sets the selected entry's heading
+)
+
+// trashedEntry is a deleted workspace entry and where it was
+type trashedEntry struct {
+	entry workspaceEntry // The entry as it was deleted
+	index int            // Position it is restored to
+}
+
+// workspaceBrowser organizes a workspace: entries are renamed inline, moved
+// up and down, grouped under headings, and deleted to a trash they can be
+// restored from until the browser closes, and written back on w
+type workspaceBrowser struct {
+	path        string          // Workspace file the entries are written back to
+	ws          workspace       // Entries in their current order
+	cursor      int             // Index of the selected entry
+	trash       []trashedEntry  // Deleted entries, most recently deleted last
+	showTrash   bool            // Whether the trash is listed instead of the entries
+	trashCursor int             // Index into trash of the selected deleted entry
+	input       textinput.Model // Inline input for a new name or heading
+	editing     string          // What the input sets, "" while browsing
+	changed     bool            // Whether anything differs from the file
+	save        bool            // Whether w was pressed to keep the changes
+	confirmQuit bool            // Whether quitting was asked for once with unsaved changes
+	status      string          // Result of the last action
+}
+
+// newWorkspaceBrowser lists a workspace's entries under their headings
+func newWorkspaceBrowser(path string, ws workspace) *workspaceBrowser {
+	input := textinput.New()
+	input.CharLimit = 0 // Names and headings are kept whole, however long
+	input.Width = workspaceInputWidth
+
+	return &workspaceBrowser{path: path, ws: ws, input: input}
+}
+
+// Init starts the browser with nothing to do
+func (b *workspaceBrowser) Init() tea.Cmd {
+	return nil
+}
+
+// startInput opens the inline input on the selected entry's name or heading
+func (b *workspaceBrowser) startInput(editing, value string) tea.Cmd {
+	b.editing = editing
+	b.status = ""
+	b.input.SetValue(value)
+	b.input.CursorEnd()
+
+	return tea.Batch(b.input.Focus(), textinput.Blink)
+}
+
+// finishInput applies the inline input to the selected entry. A name must be
+// unique; an empty heading takes the entry out of its group.
+func (b *workspaceBrowser) finishInput() {
+	entry := &b.ws.Entries[b.cursor]
+	value := strings.TrimSpace(b.input.Value())
+
+	switch b.editing {
+	case renamingEntry:
+		if value == "" {
+			b.status = "not renamed: the name is empty"
+
+			return
+		}
+
+		if other := b.ws.find(value); other != -1 && other != b.cursor {
+			b.status = fmt.Sprintf("not renamed: %q is already in the workspace", value)
+
+			return
+		}
+
+		if value != entry.Name {
+			b.status = fmt.Sprintf("renamed %s to %s", entry.Name, value)
+			entry.Name = value
+			b.changed = true
+		}
+	case settingHeading:
+		if value != entry.Group {
+			entry.Group = value
+			b.changed = true
+		}
+	}
+}
+
+// move moves the selected entry one place up or down. An entry at the edge
+// of its group moves into the neighboring group instead, so each group stays
+// together under one heading.
+func (b *workspaceBrowser) move(delta int) {
+	target := b.cursor + delta
+	if target < 0 || target >= len(b.ws.Entries) {
+		return
+	}
+
+	entries := b.ws.Entries
+	if entries[target].Group != entries[b.cursor].Group {
+		entries[b.cursor].Group = entries[target].Group
+	} else {
+		entries[b.cursor], entries[target] = entries[target], entries[b.cursor]
+		b.cursor = target
+	}
+
+	b.changed = true
+	b.status = ""
+}
+
+// deleteEntry moves the selected entry to the trash
+func (b *workspaceBrowser) deleteEntry() {
+	entry := b.ws.Entries[b.cursor]
+
+	b.trash = append(b.trash, trashedEntry{entry: entry, index: b.cursor})
+	b.ws.Entries = slices.Delete(b.ws.Entries, b.cursor, b.cursor+1)
+	b.cursor = min(b.cursor, max(0, len(b.ws.Entries)-1))
+	b.changed = true
+	b.status = fmt.Sprintf("deleted %s: t to view the trash", entry.Name)
+}
+
+// restoreEntry puts the selected deleted entry back where it was, unless
+// another entry has taken its name since
+func (b *workspaceBrowser) restoreEntry() {
+	if len(b.trash) == 0 {
+		return
+	}
+
+	trashed := b.trash[b.trashCursor]
+	if b.ws.find(trashed.entry.Name) != -1 {
+		b.status = fmt.Sprintf("not restored: %q is already in the workspace", trashed.entry.Name)
+
+		return
+	}
+
+	b.cursor = min(trashed.index, len(b.ws.Entries))
+	b.ws.Entries = slices.Insert(b.ws.Entries, b.cursor, trashed.entry)
+	b.trash = slices.Delete(b.trash, b.trashCursor, b.trashCursor+1)
+	b.trashCursor = min(b.trashCursor, max(0, len(b.trash)-1))
+	b.status = "restored " + trashed.entry.Name
+
+	if len(b.trash) == 0 {
+		b.showTrash = false
+	}
+}
+
+// updateTrash handles keys while the trash is listed
+func (b *workspaceBrowser) updateTrash(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The trash is listed newest first, so up moves toward later deletions
+	switch key.String() {
+	case "up", "k":
+		b.trashCursor = min(len(b.trash)-1, b.trashCursor+1)
+	case "down", "j":
+		b.trashCursor = max(0, b.trashCursor-1)
+	case "u", "enter":
+		b.restoreEntry()
+	case "t", "esc":
+		b.showTrash = false
+	case "ctrl+c":
+		return b, tea.Quit
+	}
+
+	return b, nil
+}
+
+// Update handles browsing keys, or passes keys to the open input
+func (b *workspaceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+
+	if b.editing != "" {
+		if ok && (key.String() == "enter" || key.String() == "esc") {
+			if key.String() == "enter" {
+				b.finishInput()
+			}
+
+			b.editing = ""
+			b.input.Blur()
+
+			return b, nil
+		}
+
+		var cmd tea.Cmd
+
+		b.input, cmd = b.input.Update(msg)
+
+		return b, cmd
+	}
+
+	if !ok {
+		return b, nil
+	}
+
+	if b.showTrash {
+		return b.updateTrash(key)
+	}
+
+	if key.String() != "q" && key.String() != "esc" {
+		b.confirmQuit = false
+	}
+
+	// With every entry in the trash, only the trash, saving, and quitting are left
+	if len(b.ws.Entries) == 0 && !slices.Contains([]string{"t", "w", "q", "esc", "ctrl+c"}, key.String()) {
+		return b, nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		b.cursor = max(0, b.cursor-1)
+	case "down", "j":
+		b.cursor = min(len(b.ws.Entries)-1, b.cursor+1)
+	case "alt+up", "K":
+		b.move(-1)
+	case "alt+down", "J":
+		b.move(1)
+	case "r":
+		return b, b.startInput(renamingEntry, b.ws.Entries[b.cursor].Name)
+	case "g":
+		return b, b.startInput(settingHeading, b.ws.Entries[b.cursor].Group)
+	case "d":
+		b.deleteEntry()
+	case "t":
+		if len(b.trash) == 0 {
+			b.status = "the trash is empty"
+
+			return b, nil
+		}
+
+		b.showTrash = true
+		b.trashCursor = len(b.trash) - 1
+	case "w":
+		if !b.changed {
+			b.status = "no changes to save"
+
+			return b, nil
+		}
+
+		b.save = true
+
+		return b, tea.Quit
+	case "ctrl+c":
+		return b, tea.Quit
+	case "q", "esc":
+		if b.changed && !b.confirmQuit {
+			b.confirmQuit = true
+			b.status = "unsaved changes: w to save them, q again discards them"
+
+			return b, nil
+		}
+
+		return b, tea.Quit
+	}
+
+	return b, nil
+}
+
+// View renders the entries under their headings, with the inline input in
+// place of the name or heading being edited
+func (b *workspaceBrowser) View() string {
+	var builder strings.Builder
+
+	if b.showTrash {
+		builder.WriteString(titleStyle.Render("crontab guru: deleted from "+b.path) + "\n")
+
+		for index, trashed := range slices.Backward(b.trash) {
+			row := fmt.Sprintf("%s  %s", trashed.entry.Name, trashed.entry.Schedule)
+			if index == b.trashCursor {
+				builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+			} else {
+				builder.WriteString(labelStyle.Render("  "+row) + "\n")
+			}
+		}
+
+		if b.status != "" {
+			builder.WriteString("\n" + conflictStyle.Render(b.status) + "\n")
+		}
+
+		builder.WriteString("\n" + helpStyle.Render("u: restore · t: back to the entries"))
+
+		return builder.String()
+	}
+
+	builder.WriteString(titleStyle.Render("crontab guru: workspace "+b.path) + "\n")
+
+	width := 0
+	for _, entry := range b.ws.Entries {
+		width = max(width, len(entry.Name))
+	}
+
+	for index, entry := range b.ws.Entries {
+		startsGroup := index == 0 || entry.Group != b.ws.Entries[index-1].Group
+
+		switch {
+		case index == b.cursor && b.editing == settingHeading:
+			builder.WriteString("\n" + peekStyle.Render(b.input.View()) + "\n")
+		case startsGroup && entry.Group != "":
+			builder.WriteString("\n" + peekStyle.Render(entry.Group) + "\n")
+		case startsGroup && index > 0:
+			// Ungrouped entries before the first heading need none
+			builder.WriteString("\n" + peekStyle.Render("(no heading)") + "\n")
+		}
+
+		name := fmt.Sprintf("%-*s", width, entry.Name)
+		if index == b.cursor && b.editing == renamingEntry {
+			name = b.input.View()
+		}
+
+		row := fmt.Sprintf("%s  %s", name, entry.Schedule)
+		if entry.Command != "" {
+			row += "  " + entry.Command
+		}
+
+		if index == b.cursor {
+			builder.WriteString(focusedLabelStyle.Render("> "+row) + "\n")
+		} else {
+			builder.WriteString(labelStyle.Render("  "+row) + "\n")
+		}
+	}
+
+	if b.status != "" {
+		builder.WriteString("\n" + conflictStyle.Render(b.status) + "\n")
+	}
+
+	help := fmt.Sprintf("r: rename · g: heading · alt+up/down: move · d: delete · t: trash (%d) · w: save and quit · q: quit",
+		len(b.trash))
+	if b.editing != "" {
+		help = "enter: apply · esc: cancel"
+	}
+
+	builder.WriteString("\n" + helpStyle.Render(help))
+
+	return builder.String()
+}
+
+// runWorkspace opens the workspace organizer and saves the entries on w
+func runWorkspace(args []string, stdout, stderr io.Writer) error {
+	var path string
+
+	flags := flag.NewFlagSet("workspace", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&path, "workspace", defaultWorkspacePath(), "workspace file to organize")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 0 {
+		return fmt.Errorf("%w: crontab-guru workspace [--workspace FILE]", ErrUsage)
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("%w: crontab-guru workspace needs a terminal", ErrUsage)
+	}
+
+	ws, err := loadWorkspace(path)
+	if err != nil {
+		return err
+	}
+
+	if len(ws.Entries) == 0 {
+		fmt.Fprintf(stdout, "no entries in %s\n", path)
+
+		return nil
+	}
+
+	final, err := tea.NewProgram(newWorkspaceBrowser(path, ws)).Run()
+	if err != nil {
+		return fmt.Errorf("app execution failed: %w", err)
+	}
+
+	result, ok := final.(*workspaceBrowser)
+	if !ok || !result.save {
+		return nil
+	}
+
+	if err := saveWorkspace(path, result.ws); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "saved %s\n", path)
+
+	return nil
+}
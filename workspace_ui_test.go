@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Andre Nogueira
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cockroachdb/errors"
+)
+
+// sendWorkspaceKey sends a key to the workspace browser
+func sendWorkspaceKey(t *testing.T, b *workspaceBrowser, msg tea.KeyMsg) tea.Cmd {
+	t.Helper()
+
+	newModel, cmd := b.Update(msg)
+	if newModel != b {
+		t.Fatalf("Expected the same browser, got %T", newModel)
+	}
+
+	return cmd
+}
+
+// replaceInput clears the inline input and types text into it
+func replaceInput(t *testing.T, b *workspaceBrowser, text string) {
+	t.Helper()
+
+	b.input.SetValue("")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+// testWorkspace has two grouped entries and one without a heading
+func testWorkspace() workspace {
+	return workspace{Entries: []workspaceEntry{
+		{Name: "backup-db", Schedule: "0 2 * * *", Group: "Backups"},
+		{Name: "backup-files", Schedule: "30 2 * * *", Group: "Backups"},
+		{Name: "report", Schedule: "0 9 * * 1"},
+	}}
+}
+
+// entryNames lists the names of a workspace's entries in order
+func entryNames(ws workspace) []string {
+	names := make([]string, 0, len(ws.Entries))
+	for _, entry := range ws.Entries {
+		names = append(names, entry.Name)
+	}
+
+	return names
+}
+
+// TestWorkspaceBrowserRename verifies inline renaming, that esc cancels it,
+// and that empty and duplicate names are refused.
+func TestWorkspaceBrowserRename(t *testing.T) {
+	t.Parallel()
+
+	b := newWorkspaceBrowser("workspace.json", testWorkspace())
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	if b.editing != renamingEntry || b.input.Value() != "backup-db" || !strings.Contains(b.View(), "enter: apply") {
+		t.Fatalf("Expected the name input open on backup-db, got %q %q", b.editing, b.input.Value())
+	}
+
+	replaceInput(t, b, "nightly-db")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEsc})
+
+	if b.ws.Entries[0].Name != "backup-db" || b.changed {
+		t.Errorf("Expected esc to cancel the rename, got %q", b.ws.Entries[0].Name)
+	}
+
+	for _, name := range []string{"  ", "REPORT"} {
+		sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		replaceInput(t, b, name)
+		sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+		if b.ws.Entries[0].Name != "backup-db" || !strings.HasPrefix(b.status, "not renamed") {
+			t.Errorf("Expected %q to be refused, got %q: %s", name, b.ws.Entries[0].Name, b.status)
+		}
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	replaceInput(t, b, " nightly-db ")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.ws.Entries[0].Name != "nightly-db" || !b.changed || b.editing != "" {
+		t.Errorf("Expected backup-db renamed to nightly-db, got %q", b.ws.Entries[0].Name)
+	}
+
+	long := strings.Repeat("nightly-", 200)
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	replaceInput(t, b, long)
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.ws.Entries[0].Name != long {
+		t.Errorf("Expected a long name kept whole, got %d characters", len(b.ws.Entries[0].Name))
+	}
+}
+
+// TestWorkspaceBrowserMove verifies that entries swap within their group and
+// join the neighboring group at its edge, so groups stay together.
+func TestWorkspaceBrowserMove(t *testing.T) {
+	t.Parallel()
+
+	b := newWorkspaceBrowser("workspace.json", testWorkspace())
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown, Alt: true})
+
+	if names := entryNames(b.ws); !slices.Equal(names, []string{"backup-files", "backup-db", "report"}) || b.cursor != 1 {
+		t.Errorf("Expected backup-db moved down, got %q at %d", names, b.cursor)
+	}
+
+	// At the end of its group, backup-db leaves it before passing report
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+
+	if b.ws.Entries[1].Group != "" || b.cursor != 1 {
+		t.Errorf("Expected backup-db to leave Backups in place, got %+v at %d", b.ws.Entries[1], b.cursor)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+
+	if names := entryNames(b.ws); !slices.Equal(names, []string{"backup-files", "report", "backup-db"}) || b.cursor != 2 {
+		t.Errorf("Expected backup-db moved past report, got %q at %d", names, b.cursor)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown, Alt: true})
+
+	if b.cursor != 2 || !b.changed {
+		t.Errorf("Expected the last entry to stay last, got cursor %d", b.cursor)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyUp})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyUp, Alt: true})
+
+	if b.ws.Entries[1].Group != "Backups" || b.cursor != 1 {
+		t.Errorf("Expected report to join Backups, got %+v", b.ws.Entries[1])
+	}
+}
+
+// TestWorkspaceBrowserHeading verifies setting and clearing headings and
+// that the list shows each heading once above its group.
+func TestWorkspaceBrowserHeading(t *testing.T) {
+	t.Parallel()
+
+	b := newWorkspaceBrowser("workspace.json", testWorkspace())
+
+	view := b.View()
+	if strings.Count(view, "Backups") != 1 || !strings.Contains(view, "(no heading)") {
+		t.Errorf("Expected one Backups heading and an ungrouped section:\n%s", view)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	replaceInput(t, b, "Reports")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	view = b.View()
+	if b.ws.Entries[2].Group != "Reports" || !strings.Contains(view, "Reports") || strings.Contains(view, "(no heading)") {
+		t.Errorf("Expected report under Reports, got %+v:\n%s", b.ws.Entries[2], view)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyUp})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	replaceInput(t, b, "")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if b.ws.Entries[1].Group != "" {
+		t.Errorf("Expected an empty heading to clear the group, got %q", b.ws.Entries[1].Group)
+	}
+}
+
+// TestWorkspaceBrowserQuit verifies that w needs a change, saves with one,
+// and that quitting with unsaved changes asks once.
+func TestWorkspaceBrowserQuit(t *testing.T) {
+	t.Parallel()
+
+	b := newWorkspaceBrowser("workspace.json", testWorkspace())
+
+	if cmd := sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}); cmd != nil || b.save {
+		t.Error("Expected w without changes not to quit")
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+
+	if cmd := sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd != nil || !b.confirmQuit {
+		t.Error("Expected q with unsaved changes to ask first")
+	}
+
+	if cmd := sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd == nil || b.save {
+		t.Error("Expected a second q to quit without saving")
+	}
+
+	if cmd := sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}); cmd == nil || !b.save {
+		t.Error("Expected w to save and quit")
+	}
+}
+
+// TestWorkspaceBrowserTrash verifies that deleted entries can be restored to
+// where they were, but not over an entry that has taken their name.
+func TestWorkspaceBrowserTrash(t *testing.T) {
+	t.Parallel()
+
+	b := newWorkspaceBrowser("workspace.json", testWorkspace())
+	del := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, del)
+
+	if names := entryNames(b.ws); !slices.Equal(names, []string{"backup-db", "report"}) || b.cursor != 1 || !b.changed {
+		t.Errorf("Expected backup-files deleted, got %q at %d", names, b.cursor)
+	}
+
+	sendWorkspaceKey(t, b, del)
+	sendWorkspaceKey(t, b, del)
+
+	if len(b.ws.Entries) != 0 || len(b.trash) != 3 {
+		t.Fatalf("Expected every entry in the trash, got %+v", b.ws.Entries)
+	}
+
+	if sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")}) != nil || b.editing != "" {
+		t.Error("Expected no rename without entries")
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	if view := b.View(); !b.showTrash || strings.Index(view, "backup-db") > strings.Index(view, "backup-files") {
+		t.Fatalf("Expected the trash newest first, got:\n%s", view)
+	}
+
+	// Restore backup-files, then report, which goes back after it
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyDown})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+
+	if names := entryNames(b.ws); !slices.Equal(names, []string{"backup-files", "report"}) {
+		t.Errorf("Expected backup-files and report restored, got %q", names)
+	}
+
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	replaceInput(t, b, "backup-db")
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyEnter})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	sendWorkspaceKey(t, b, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+
+	if len(b.trash) != 1 || !strings.HasPrefix(b.status, "not restored") {
+		t.Errorf("Expected backup-db not restored over the renamed entry, got %q", b.status)
+	}
+}
+
+// TestRunWorkspaceUsage verifies that stray arguments are rejected.
+func TestRunWorkspaceUsage(t *testing.T) {
+	t.Parallel()
+
+	if err := runWorkspace([]string{"extra"}, io.Discard, io.Discard); !errors.Is(err, ErrUsage) {
+		t.Errorf("Expected ErrUsage, got %v", err)
+	}
+}